@@ -0,0 +1,25 @@
+# Rectify a signed zone on demand:
+#   terraform apply -invoke=action.poweradmin_rectify_zone.example_com
+action "poweradmin_rectify_zone" "example_com" {
+  config {
+    zone_id = poweradmin_zone.example_com.id
+  }
+}
+
+# Or rectify after every change to a record set of the zone
+resource "poweradmin_rrset" "web" {
+  zone_id = poweradmin_zone.example_com.id
+  name    = "www"
+  type    = "A"
+
+  records = [
+    { content = "192.0.2.10" },
+  ]
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.poweradmin_rectify_zone.example_com]
+    }
+  }
+}
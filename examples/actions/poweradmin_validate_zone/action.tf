@@ -0,0 +1,26 @@
+# Validate a zone on demand:
+#   terraform apply -invoke=action.poweradmin_validate_zone.example_com
+action "poweradmin_validate_zone" "example_com" {
+  config {
+    zone_id          = poweradmin_zone.example_com.id
+    fail_on_warnings = true
+  }
+}
+
+# Or validate after every change to the zone's mail records
+resource "poweradmin_rrset" "mx" {
+  zone_id = poweradmin_zone.example_com.id
+  name    = "@"
+  type    = "MX"
+
+  records = [
+    { content = "mail.example.com.", priority = 10 },
+  ]
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.poweradmin_validate_zone.example_com]
+    }
+  }
+}
@@ -0,0 +1,13 @@
+# Stop the run before any changes when the DNS control plane is unhealthy
+data "poweradmin_api_status" "this" {
+  lifecycle {
+    postcondition {
+      condition     = self.reachable && self.authenticated
+      error_message = "Poweradmin API is not healthy: ${coalesce(self.error, "unknown error")}"
+    }
+  }
+}
+
+output "poweradmin_latency_ms" {
+  value = data.poweradmin_api_status.this.latency_ms
+}
@@ -0,0 +1,13 @@
+# Refuse to run with anything but the DNS automation service account
+data "poweradmin_current_user" "this" {
+  lifecycle {
+    postcondition {
+      condition     = self.username == "terraform-dns" && !self.is_admin
+      error_message = "Expected to run as the non-admin terraform-dns account, but authenticated as ${self.username}."
+    }
+  }
+}
+
+output "poweradmin_permission_template" {
+  value = data.poweradmin_current_user.this.perm_templ_name
+}
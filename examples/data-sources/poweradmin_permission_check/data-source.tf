@@ -0,0 +1,18 @@
+# Fail while planning, naming what is missing, when the credentials cannot
+# create and edit the zones this configuration manages
+data "poweradmin_permission_check" "dns" {
+  permissions     = ["zone_master_add", "zone_content_edit_own", "zone_meta_edit_own"]
+  fail_on_missing = true
+}
+
+# Or only report them and decide in a check block
+data "poweradmin_permission_check" "secondaries" {
+  permissions = ["zone_slave_add"]
+}
+
+check "secondary_zone_permissions" {
+  assert {
+    condition     = data.poweradmin_permission_check.secondaries.all_granted
+    error_message = "SLAVE zones cannot be created: ${data.poweradmin_permission_check.secondaries.username} lacks ${join(", ", data.poweradmin_permission_check.secondaries.missing)}."
+  }
+}
@@ -0,0 +1,13 @@
+# List every permission template
+data "poweradmin_permission_templates" "all" {}
+
+# Look up template IDs by name, e.g. for poweradmin_user.perm_templ
+locals {
+  permission_template_ids = {
+    for t in data.poweradmin_permission_templates.all.templates : t.name => t.id
+  }
+}
+
+output "permission_template_names" {
+  value = keys(local.permission_template_ids)
+}
@@ -15,6 +15,12 @@ data "poweradmin_records" "a_records" {
   type    = "A"
 }
 
+# List disabled records for review
+data "poweradmin_records" "disabled" {
+  zone_id       = poweradmin_zone.example_com.id
+  disabled_only = true
+}
+
 # Output total record count
 output "total_records" {
   value       = length(data.poweradmin_records.all.records)
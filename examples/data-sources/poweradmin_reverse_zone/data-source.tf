@@ -0,0 +1,12 @@
+# Find the reverse zone holding the PTR record for a server address
+data "poweradmin_reverse_zone" "web" {
+  ip_address = "192.0.2.10"
+}
+
+resource "poweradmin_record" "web_ptr" {
+  zone_id = data.poweradmin_reverse_zone.web.id
+  name    = data.poweradmin_reverse_zone.web.ptr_name
+  type    = "PTR"
+  content = "web.example.com."
+  ttl     = 3600
+}
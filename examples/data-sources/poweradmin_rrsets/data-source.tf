@@ -15,6 +15,33 @@ data "poweradmin_rrsets" "a_records" {
   type    = "A"
 }
 
+# Filter RRSets by name (all types at www)
+data "poweradmin_rrsets" "www" {
+  zone_id = poweradmin_zone.example_com.id
+  name    = "www"
+}
+
+# Filter RRSets by a name pattern (ACME challenge TXT records)
+data "poweradmin_rrsets" "acme_challenges" {
+  zone_id    = poweradmin_zone.example_com.id
+  type       = "TXT"
+  name_regex = "^_acme-challenge\\."
+}
+
+# Read a very large zone in slices of 500 RRSets (here: the second slice)
+data "poweradmin_rrsets" "slice" {
+  zone_id  = poweradmin_zone.example_com.id
+  page     = 2
+  per_page = 500
+}
+
+# Stop after the first 10 matching RRSets
+data "poweradmin_rrsets" "sample" {
+  zone_id   = poweradmin_zone.example_com.id
+  type      = "A"
+  max_items = 10
+}
+
 # Output the total number of RRSets
 output "total_rrsets" {
   value       = length(data.poweradmin_rrsets.all_records.rrsets)
@@ -36,3 +63,9 @@ output "a_record_ips" {
   ])
   description = "List of all A record IP addresses"
 }
+
+# Import blocks adopting every RRSet of the zone, for
+# `terraform plan -generate-config-out=rrsets.tf`
+output "import_blocks" {
+  value = data.poweradmin_rrsets.all_records.import_blocks
+}
@@ -0,0 +1,12 @@
+data "poweradmin_supermasters" "all" {}
+
+# Fail the run when an expected provisioning source is missing
+check "partner_supermaster" {
+  assert {
+    condition = contains(
+      [for s in data.poweradmin_supermasters.all.supermasters : s.ip],
+      "192.0.2.1",
+    )
+    error_message = "The partner supermaster 192.0.2.1 is not configured."
+  }
+}
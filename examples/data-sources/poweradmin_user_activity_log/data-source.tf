@@ -0,0 +1,16 @@
+# All activity of one user during the first quarter
+data "poweradmin_user_activity_log" "alice_q1" {
+  username = "alice"
+  since    = "2026-01-01T00:00:00Z"
+  until    = "2026-04-01T00:00:00Z"
+  limit    = 500
+}
+
+# Output a compliance report
+output "alice_q1_activity" {
+  value = [
+    for entry in data.poweradmin_user_activity_log.alice_q1.entries :
+    "${entry.created_at} ${entry.event}"
+  ]
+  description = "Activity of alice in Q1 2026"
+}
@@ -8,6 +8,12 @@ data "poweradmin_zone" "by_id" {
   id = "123"
 }
 
+# Read a zone together with its RRSets in one data source
+data "poweradmin_zone" "with_contents" {
+  name           = "example.com"
+  include_rrsets = true
+}
+
 # Use zone data in a resource
 resource "poweradmin_record" "www" {
   zone_id = data.poweradmin_zone.example.id
@@ -25,3 +31,11 @@ output "zone_type" {
 output "zone_description" {
   value = data.poweradmin_zone.example.description
 }
+
+output "zone_owner" {
+  value = lookup(data.poweradmin_zone.example.tags, "owner", null)
+}
+
+output "zone_mx_rrsets" {
+  value = [for r in data.poweradmin_zone.with_contents.rrsets : r if r.type == "MX"]
+}
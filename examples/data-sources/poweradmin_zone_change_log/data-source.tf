@@ -0,0 +1,14 @@
+# Read the 50 most recent changes to a zone
+data "poweradmin_zone_change_log" "example" {
+  zone_id = poweradmin_zone.example_com.id
+  limit   = 50
+}
+
+# Output a simple audit trail
+output "zone_audit_trail" {
+  value = [
+    for entry in data.poweradmin_zone_change_log.example.entries :
+    "${entry.created_at} ${entry.user}: ${entry.event}"
+  ]
+  description = "Recent changes to example.com"
+}
@@ -0,0 +1,19 @@
+# Read the server's DNS defaults
+data "poweradmin_zone_defaults" "server" {}
+
+# Use the server's default nameservers for an apex NS RRSet
+resource "poweradmin_rrset" "apex_ns" {
+  zone_id = poweradmin_zone.example_com.id
+  name    = "@"
+  type    = "NS"
+  ttl     = data.poweradmin_zone_defaults.server.default_ttl
+
+  records = [
+    for ns in data.poweradmin_zone_defaults.server.nameservers : { content = ns }
+  ]
+}
+
+# Fail early if the server does not allow CAA records
+output "caa_allowed" {
+  value = contains(data.poweradmin_zone_defaults.server.record_types, "CAA")
+}
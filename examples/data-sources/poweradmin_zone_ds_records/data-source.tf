@@ -0,0 +1,23 @@
+# Read the SHA-256 DS records of a signed zone
+data "poweradmin_zone_ds_records" "example" {
+  zone_id     = poweradmin_zone.example_com.id
+  digest_type = 2
+}
+
+# DS records to paste into the registrar's delegation form
+output "ds_records" {
+  value       = data.poweradmin_zone_ds_records.example.ds
+  description = "DS records for example.com"
+}
+
+# Registrars that ask for the fields separately
+output "ds_fields" {
+  value = [
+    for ds in data.poweradmin_zone_ds_records.example.ds_records : {
+      key_tag     = ds.key_tag
+      algorithm   = ds.algorithm
+      digest_type = ds.digest_type
+      digest      = ds.digest
+    }
+  ]
+}
@@ -0,0 +1,16 @@
+# Read the SOA of a zone as separate fields
+data "poweradmin_zone_soa" "example" {
+  zone_id = poweradmin_zone.example_com.id
+}
+
+output "zone_serial" {
+  value = data.poweradmin_zone_soa.example.serial
+}
+
+# Fail the plan when the negative caching TTL is longer than an hour
+check "soa_minimum" {
+  assert {
+    condition     = data.poweradmin_zone_soa.example.minimum <= 3600
+    error_message = "The SOA minimum (negative caching TTL) of example.com is over an hour."
+  }
+}
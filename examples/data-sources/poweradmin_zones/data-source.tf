@@ -0,0 +1,14 @@
+# List every zone visible to the authenticated caller
+data "poweradmin_zones" "all" {}
+
+# List only one tenant's signed MASTER zones
+data "poweradmin_zones" "tenant" {
+  account  = "customer-001"
+  owner_id = poweradmin_user.tenant_admin.id
+  type     = "MASTER"
+  dnssec   = true
+}
+
+output "tenant_zone_names" {
+  value = [for z in data.poweradmin_zones.tenant.zones : z.name]
+}
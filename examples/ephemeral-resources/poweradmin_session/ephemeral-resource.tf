@@ -0,0 +1,22 @@
+# Log in for the duration of the run and configure an aliased provider with
+# the session token; the session is revoked when the run ends
+ephemeral "poweradmin_session" "this" {
+  username = var.poweradmin_username
+  password = var.poweradmin_password
+}
+
+provider "poweradmin" {
+  alias   = "session"
+  api_url = "https://dns.example.com"
+  api_key = ephemeral.poweradmin_session.this.token
+}
+
+resource "poweradmin_record" "www" {
+  provider = poweradmin.session
+
+  zone_id = 1
+  name    = "www.example.com"
+  type    = "A"
+  content = "192.0.2.10"
+  ttl     = 3600
+}
@@ -0,0 +1,11 @@
+resource "poweradmin_rrset" "caa" {
+  zone_id = poweradmin_zone.example_com.id
+  name    = "@"
+  type    = "CAA"
+
+  records = [
+    { content = provider::poweradmin::caa_record(0, "issue", "letsencrypt.org") },
+    { content = provider::poweradmin::caa_record(0, "issuewild", ";") },
+    { content = provider::poweradmin::caa_record(0, "iodef", "mailto:security@example.com") },
+  ]
+}
@@ -0,0 +1,13 @@
+resource "poweradmin_rrset" "dkim" {
+  zone_id = poweradmin_zone.example_com.id
+  name    = "mail2026._domainkey"
+  type    = "TXT"
+
+  records = [
+    {
+      content = provider::poweradmin::dkim_record(file("${path.module}/dkim/mail2026.pub"), {
+        hash_algorithms = ["sha256"]
+      })
+    },
+  ]
+}
@@ -0,0 +1,16 @@
+resource "poweradmin_rrset" "dmarc" {
+  zone_id = poweradmin_zone.example_com.id
+  name    = "_dmarc"
+  type    = "TXT"
+
+  records = [
+    {
+      content = provider::poweradmin::dmarc_record({
+        policy = "reject"
+        rua    = "mailto:dmarc-reports@example.com"
+        pct    = 100
+        adkim  = "s"
+      })
+    },
+  ]
+}
@@ -0,0 +1,7 @@
+# One reverse zone per /24 covered by the office network
+resource "poweradmin_zone" "office_reverse" {
+  for_each = toset(provider::poweradmin::reverse_zone_from_cidr("10.20.0.0/22"))
+
+  name = each.value
+  type = "MASTER"
+}
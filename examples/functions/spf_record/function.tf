@@ -0,0 +1,17 @@
+resource "poweradmin_rrset" "spf" {
+  zone_id = poweradmin_zone.example_com.id
+  name    = "@"
+  type    = "TXT"
+
+  records = [
+    {
+      content = provider::poweradmin::spf_record({
+        ip4     = ["192.0.2.0/24"]
+        ip6     = ["2001:db8::/32"]
+        mx      = true
+        include = ["_spf.google.com"]
+        all     = "fail"
+      })
+    },
+  ]
+}
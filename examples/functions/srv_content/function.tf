@@ -0,0 +1,16 @@
+resource "poweradmin_rrset" "sip" {
+  zone_id = poweradmin_zone.example_com.id
+  name    = "_sip._udp"
+  type    = "SRV"
+
+  records = [
+    {
+      priority = 10
+      content  = provider::poweradmin::srv_content(60, 5060, "sip1.example.com.")
+    },
+    {
+      priority = 10
+      content  = provider::poweradmin::srv_content(40, 5060, "sip2.example.com.")
+    },
+  ]
+}
@@ -0,0 +1,11 @@
+resource "poweradmin_rrset" "soa" {
+  zone_id = poweradmin_zone.example_com.id
+  name    = "@"
+  type    = "SOA"
+
+  records = [
+    {
+      content = "ns1.example.com hostmaster.example.com ${provider::poweradmin::zone_serial(2, "2026-10-16")} 10800 3600 604800 3600"
+    },
+  ]
+}
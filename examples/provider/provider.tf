@@ -4,6 +4,21 @@ provider "poweradmin" {
   api_key = var.poweradmin_api_key
 }
 
+# Example reading an API key rotated by an external agent; the file is
+# re-read and the request replayed once when the API answers 401
+# provider "poweradmin" {
+#   api_url      = "https://dns.example.com"
+#   api_key_file = "/run/secrets/poweradmin-api-key"
+# }
+
+# Example reading the API key from a HashiCorp Vault KV v2 secret, with
+# VAULT_ADDR and VAULT_TOKEN set in the environment
+# provider "poweradmin" {
+#   api_url             = "https://dns.example.com"
+#   vault_api_key_path  = "secret/data/poweradmin"
+#   vault_api_key_field = "api_key"
+# }
+
 # Example using Basic Authentication
 # provider "poweradmin" {
 #   api_url  = "https://dns.example.com"
@@ -11,6 +26,20 @@ provider "poweradmin" {
 #   password = var.poweradmin_password
 # }
 
+# Example reading the URL and credentials of the "staging" profile from
+# ~/.config/poweradmin/credentials.toml
+# provider "poweradmin" {
+#   profile = "staging"
+# }
+
+# Example with a second app server to fail over to when the first one
+# cannot be reached
+# provider "poweradmin" {
+#   api_url           = "https://dns1.example.com"
+#   failover_api_urls = ["https://dns2.example.com"]
+#   api_key           = var.poweradmin_api_key
+# }
+
 # Example specifying API version (optional - defaults to v2)
 # provider "poweradmin" {
 #   api_url     = "https://dns.example.com"
@@ -20,7 +49,16 @@ provider "poweradmin" {
 
 # Example for development with insecure TLS (not recommended for production)
 # provider "poweradmin" {
-#   api_url  = "http://localhost:8080"
-#   api_key  = "test-key"
-#   insecure = true
+#   api_url         = "https://localhost:8443"
+#   api_key         = "test-key"
+#   tls_skip_verify = true
+# }
+
+# Example reaching the API by address through an SNI-routing proxy, with a
+# TLS 1.3-only policy
+# provider "poweradmin" {
+#   api_url         = "https://10.0.0.5"
+#   api_key         = var.poweradmin_api_key
+#   tls_server_name = "dns.example.com"
+#   tls_min_version = "1.3"
 # }
@@ -0,0 +1,2 @@
+# Import a DNSSEC key by zone_id/key_id
+terraform import poweradmin_dnssec_key.ksk 10/1
@@ -0,0 +1,29 @@
+# Sign a zone with a KSK and a ZSK
+resource "poweradmin_dnssec_key" "ksk" {
+  zone_id   = poweradmin_zone.example_com.id
+  key_type  = "ksk"
+  algorithm = "ECDSAP256SHA256"
+}
+
+resource "poweradmin_dnssec_key" "zsk" {
+  zone_id   = poweradmin_zone.example_com.id
+  key_type  = "zsk"
+  algorithm = "ECDSAP256SHA256"
+}
+
+# ZSK rollover (pre-publish):
+# 1. Add the new key inactive so its DNSKEY is published ahead of use.
+# 2. After the DNSKEY TTL has passed, set active = true here and
+#    active = false on the old key.
+# 3. Once the old signatures have expired, remove the old key.
+resource "poweradmin_dnssec_key" "zsk_next" {
+  zone_id   = poweradmin_zone.example_com.id
+  key_type  = "zsk"
+  algorithm = "ECDSAP256SHA256"
+  active    = false
+}
+
+output "ksk_ds" {
+  value       = poweradmin_dnssec_key.ksk.ds
+  description = "DS records to publish at the registrar"
+}
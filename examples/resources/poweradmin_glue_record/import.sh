@@ -0,0 +1,2 @@
+# Import glue by zone_id/nameserver
+terraform import poweradmin_glue_record.ns1 123/ns1.example.com
@@ -0,0 +1,30 @@
+# Delegate the zone to nameservers that live inside it
+resource "poweradmin_rrset" "apex_ns" {
+  zone_id = poweradmin_zone.example_com.id
+  name    = "@"
+  type    = "NS"
+  ttl     = 86400
+
+  records = [
+    { content = "ns1.example.com." },
+    { content = "ns2.example.com." },
+  ]
+}
+
+# Glue for the in-zone nameservers (A and AAAA are split automatically)
+resource "poweradmin_glue_record" "ns1" {
+  zone_id    = poweradmin_zone.example_com.id
+  nameserver = "ns1.example.com"
+  addresses  = ["192.0.2.53", "2001:db8::53"]
+
+  depends_on = [poweradmin_rrset.apex_ns]
+}
+
+resource "poweradmin_glue_record" "ns2" {
+  zone_id    = poweradmin_zone.example_com.id
+  nameserver = "ns2.example.com"
+  addresses  = ["198.51.100.53"]
+  ttl        = 86400
+
+  depends_on = [poweradmin_rrset.apex_ns]
+}
@@ -0,0 +1,2 @@
+# Import a permission template item by template_id/permission_name
+terraform import poweradmin_permission_template_item.operators_view_others 3/zone_content_view_others
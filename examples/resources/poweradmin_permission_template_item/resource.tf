@@ -0,0 +1,5 @@
+# Contribute a permission to a centrally owned permission template
+resource "poweradmin_permission_template_item" "operators_view_others" {
+  template_id     = 3
+  permission_name = "zone_content_view_others"
+}
@@ -35,6 +35,26 @@ resource "poweradmin_record" "mail" {
   priority = 10
 }
 
+# Point the zone apex at a load balancer host name; ALIAS is only allowed
+# at the apex
+resource "poweradmin_record" "apex_alias" {
+  zone_id = poweradmin_zone.example_com.id
+  name    = "@"
+  type    = "ALIAS"
+  content = "lb.example.net."
+  ttl     = 300
+}
+
+# Answer with whichever web server is up; the Lua snippet is quoted for
+# PowerDNS automatically
+resource "poweradmin_record" "www_failover" {
+  zone_id = poweradmin_zone.example_com.id
+  name    = "www-failover"
+  type    = "LUA"
+  content = "A ifportup(443, {'192.0.2.1', '192.0.2.2'})"
+  ttl     = 60
+}
+
 # Create a TXT record
 resource "poweradmin_record" "spf" {
   zone_id = poweradmin_zone.example_com.id
@@ -44,12 +64,60 @@ resource "poweradmin_record" "spf" {
   ttl     = 3600
 }
 
+# Send the TXT content quoted (and split into 255-byte strings) without
+# writing the quotes in the configuration
+resource "poweradmin_record" "dkim" {
+  zone_id        = poweradmin_zone.example_com.id
+  name           = "mail._domainkey"
+  type           = "TXT"
+  content        = "v=DKIM1; k=rsa; p=${var.dkim_public_key}"
+  auto_quote_txt = true
+}
+
 # Create a disabled record
 resource "poweradmin_record" "maintenance" {
   zone_id  = poweradmin_zone.example_com.id
   name     = "maintenance"
   type     = "A"
   content  = "192.0.2.200"
-  ttl      = 300
+  ttl      = "5m"
   disabled = true
 }
+
+# Operators may disable this record during incidents; the next apply leaves
+# it disabled instead of re-enabling it
+resource "poweradmin_record" "api" {
+  zone_id                 = poweradmin_zone.example_com.id
+  name                    = "api"
+  type                    = "A"
+  content                 = "192.0.2.30"
+  ignore_external_disable = true
+}
+
+# Keep a verification token out of plan output and provider logs.
+# wait_for_propagation holds the apply until the zone's name servers serve
+# the token, so the ACME validation does not race the change.
+resource "poweradmin_record" "acme_challenge" {
+  zone_id              = poweradmin_zone.example_com.id
+  name                 = "_acme-challenge"
+  type                 = "TXT"
+  secret_content       = var.acme_challenge_token
+  ttl                  = 60
+  wait_for_propagation = true
+}
+
+# Let the most specific existing zone containing the name own the record
+resource "poweradmin_record" "api" {
+  fqdn    = "api.eu.example.com"
+  type    = "A"
+  content = "192.0.2.20"
+}
+
+# Derive the PTR name from the address; the address must fall inside the
+# reverse zone
+resource "poweradmin_record" "web_ptr" {
+  zone_id    = data.poweradmin_reverse_zone.web.id
+  ip_address = "192.0.2.10"
+  type       = "PTR"
+  content    = "web.example.com."
+}
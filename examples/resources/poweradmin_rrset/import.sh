@@ -0,0 +1,9 @@
+# Import an RRSet using zone_id/name/type format
+terraform import poweradmin_rrset.www 123/www/A
+
+# The zone may also be given by name, and the record name fully qualified,
+# as in the import_id of the poweradmin_rrsets data source
+terraform import poweradmin_rrset.mail example.com/@/MX
+
+# To adopt a whole zone, write the import_blocks of the poweradmin_rrsets
+# data source to a file and run terraform plan -generate-config-out=rrsets.tf
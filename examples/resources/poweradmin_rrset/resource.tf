@@ -1,4 +1,6 @@
-# Create an RRSet with multiple A records (load balancing)
+# Create an RRSet with multiple A records (load balancing); create_ptr adds
+# the reverse record of an address when it is added (requires a matching
+# reverse zone)
 resource "poweradmin_rrset" "web_servers" {
   zone_id = poweradmin_zone.example_com.id
   name    = "www"
@@ -7,9 +9,10 @@ resource "poweradmin_rrset" "web_servers" {
 
   records = [
     {
-      content  = "192.0.2.10"
-      disabled = false
-      priority = 0
+      content    = "192.0.2.10"
+      disabled   = false
+      priority   = 0
+      create_ptr = true
     },
     {
       content  = "192.0.2.11"
@@ -68,10 +71,11 @@ resource "poweradmin_rrset" "ipv6" {
 
 # Create an RRSet with TXT record
 resource "poweradmin_rrset" "spf" {
-  zone_id = poweradmin_zone.example_com.id
-  name    = "@"
-  type    = "TXT"
-  ttl     = 3600
+  zone_id   = poweradmin_zone.example_com.id
+  name      = "@"
+  type      = "TXT"
+  ttl       = 3600
+  exclusive = false
 
   records = [
     {
@@ -102,3 +106,48 @@ resource "poweradmin_rrset" "subdomain_ns" {
     },
   ]
 }
+
+# Take over an RRSet that already exists on the server, replacing its records.
+# Without overwrite = true, create fails and suggests importing it instead.
+resource "poweradmin_rrset" "legacy_mx" {
+  zone_id   = poweradmin_zone.example_com.id
+  name      = "legacy"
+  type      = "MX"
+  overwrite = true
+
+  records = [
+    {
+      content  = "mail1.example.com"
+      priority = 10
+    },
+  ]
+}
+
+# Contribute a verification token to the same apex TXT RRSet as the SPF
+# record above; with exclusive = false on both, neither removes the other's.
+resource "poweradmin_rrset" "site_verification" {
+  zone_id   = poweradmin_zone.example_com.id
+  name      = "@"
+  type      = "TXT"
+  ttl       = 3600
+  exclusive = false
+
+  records = [
+    {
+      content = "google-site-verification=abc123"
+    },
+  ]
+}
+
+# PTR RRSet named after the address it reverses
+resource "poweradmin_rrset" "mail_ptr" {
+  zone_id    = data.poweradmin_reverse_zone.mail.id
+  ip_address = "2001:db8::25"
+  type       = "PTR"
+
+  records = [
+    {
+      content = "mail.example.com."
+    },
+  ]
+}
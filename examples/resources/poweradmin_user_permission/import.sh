@@ -0,0 +1,2 @@
+# Import a user permission by user_id/permission_id
+terraform import poweradmin_user_permission.auditor_view 5/42
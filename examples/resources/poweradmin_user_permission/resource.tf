@@ -0,0 +1,15 @@
+# Grant a single permission to a user managed elsewhere
+data "poweradmin_permission" "view_others" {
+  name = "zone_content_view_others"
+}
+
+resource "poweradmin_user_permission" "auditor_view" {
+  user_id       = poweradmin_user.auditor.id
+  permission_id = data.poweradmin_permission.view_others.id
+}
+
+# Or reference the permission by name
+resource "poweradmin_user_permission" "auditor_users" {
+  user_id         = poweradmin_user.auditor.id
+  permission_name = "user_view_others"
+}
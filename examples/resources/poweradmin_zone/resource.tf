@@ -12,6 +12,15 @@ resource "poweradmin_zone" "slave_example" {
   masters = "192.0.2.1,192.0.2.2"
 }
 
+# Create a SLAVE zone and wait for its first transfer from the masters
+resource "poweradmin_zone" "slave_waited" {
+  name              = "slave-waited.example.com"
+  type              = "SLAVE"
+  masters           = "192.0.2.1"
+  wait_for_transfer = true
+  transfer_timeout  = "2m"
+}
+
 # Create a SLAVE zone with masters on custom ports
 resource "poweradmin_zone" "slave_with_ports" {
   name    = "slave-ports.example.com"
@@ -33,12 +42,56 @@ resource "poweradmin_zone" "slave_mixed" {
   masters = "192.0.2.1:5300,[2001:db8::1]:5300"
 }
 
-# Create a zone with account
+# Set the SOA contact; it is stored as dns\.admin.example.com. in the SOA
+resource "poweradmin_zone" "with_contact" {
+  name             = "contact.example.com"
+  type             = "MASTER"
+  hostmaster_email = "dns.admin@example.com"
+}
+
+# Create a zone with account, warning at apply time if no user has that name
 resource "poweradmin_zone" "customer_zone" {
-  name        = "customer.example.com"
-  type        = "MASTER"
-  account     = "customer-001"
-  description = "Customer DNS zone"
+  name           = "customer.example.com"
+  type           = "MASTER"
+  account        = "customer-001"
+  description    = "Customer DNS zone"
+  verify_account = true
+}
+
+# Tag a zone with its owner and ticket reference
+resource "poweradmin_zone" "tagged_zone" {
+  name = "tagged.example.com"
+  type = "MASTER"
+  tags = {
+    owner       = "platform-team"
+    cost_center = "cc-1234"
+    ticket      = "OPS-567"
+  }
+}
+
+# Hidden master: notify the public secondaries of every change
+resource "poweradmin_zone" "hidden_master" {
+  name = "hidden.example.com"
+  type = "MASTER"
+
+  also_notify = [
+    "198.51.100.10",
+    "[2001:db8::53]:5300",
+  ]
+}
+
+# Records in this zone without a ttl get 5 minutes instead of 3600 seconds
+resource "poweradmin_zone" "short_ttl" {
+  name               = "short-ttl.example.com"
+  type               = "MASTER"
+  default_record_ttl = "5m"
+}
+
+resource "poweradmin_record" "short_ttl_www" {
+  zone_id = poweradmin_zone.short_ttl.id
+  name    = "www"
+  type    = "A"
+  content = "192.0.2.10"
 }
 
 # Create a zone from a template
@@ -47,3 +100,18 @@ resource "poweradmin_zone" "templated_zone" {
   type     = "MASTER"
   template = "default-template"
 }
+
+# Records the template generated, e.g. to avoid colliding with them
+output "templated_zone_template_records" {
+  value = [for rrset in poweradmin_zone.templated_zone.template_rrsets : "${rrset.name} ${rrset.type}"]
+}
+
+# Pass the zone's nameservers to a registrar resource
+output "example_com_nameservers" {
+  value = poweradmin_zone.example_com.nameservers
+}
+
+# Number of records the zone held at the last refresh
+output "example_com_record_count" {
+  value = poweradmin_zone.example_com.record_count
+}
@@ -0,0 +1,2 @@
+# Import a zone owner by zone_id/user_id
+terraform import poweradmin_zone_owner.alice 10/3
@@ -0,0 +1,23 @@
+# Give a zone several owners, one resource per owner
+resource "poweradmin_zone" "example" {
+  name = "example.com"
+  type = "MASTER"
+}
+
+resource "poweradmin_zone_owner" "alice" {
+  zone_id = poweradmin_zone.example.id
+  user_id = 3
+}
+
+# Manage a set of owners; adding or removing a user ID only touches that owner
+variable "example_owner_ids" {
+  type    = set(number)
+  default = [7, 12]
+}
+
+resource "poweradmin_zone_owner" "team" {
+  for_each = var.example_owner_ids
+
+  zone_id = poweradmin_zone.example.id
+  user_id = each.value
+}
@@ -0,0 +1,2 @@
+# Import the transfer ACL of a zone by zone_id
+terraform import poweradmin_zone_transfer_acl.example 123
@@ -0,0 +1,14 @@
+# Allow secondaries to transfer the zone and notify an extra listener
+resource "poweradmin_zone_transfer_acl" "example" {
+  zone_id = poweradmin_zone.example_com.id
+
+  allow_axfr_from = [
+    "192.0.2.0/24",
+    "2001:db8::53",
+  ]
+
+  also_notify = [
+    "192.0.2.10",
+    "[2001:db8::10]:5300",
+  ]
+}
@@ -0,0 +1,152 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &APIStatusDataSource{}
+
+func NewAPIStatusDataSource() datasource.DataSource {
+	return &APIStatusDataSource{}
+}
+
+// APIStatusDataSource defines the data source implementation.
+type APIStatusDataSource struct {
+	client *Client
+}
+
+// APIStatusDataSourceModel describes the data source data model.
+type APIStatusDataSourceModel struct {
+	Reachable     types.Bool   `tfsdk:"reachable"`
+	Authenticated types.Bool   `tfsdk:"authenticated"`
+	StatusCode    types.Int64  `tfsdk:"status_code"`
+	LatencyMs     types.Int64  `tfsdk:"latency_ms"`
+	ServerTime    types.String `tfsdk:"server_time"`
+	UserID        types.Int64  `tfsdk:"user_id"`
+	Username      types.String `tfsdk:"username"`
+	IsAdmin       types.Bool   `tfsdk:"is_admin"`
+	Error         types.String `tfsdk:"error"`
+}
+
+func (d *APIStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_status"
+}
+
+func (d *APIStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Probes the Poweradmin API with the provider's credentials and reports whether it is reachable, whether the credentials are accepted, the authenticated user, the server time and the latency. " +
+			"An unhealthy API does not fail the read; assert on the attributes with a `postcondition` or a `check` block so a pipeline stops before attempting many changes against a broken control plane. " +
+			"The probe is sent once, without retries.",
+
+		Attributes: map[string]schema.Attribute{
+			"reachable": schema.BoolAttribute{
+				MarkdownDescription: "Whether the API answered with any HTTP response",
+				Computed:            true,
+			},
+			"authenticated": schema.BoolAttribute{
+				MarkdownDescription: "Whether the API accepted the credentials",
+				Computed:            true,
+			},
+			"status_code": schema.Int64Attribute{
+				MarkdownDescription: "HTTP status of the probe; `0` when the API was not reachable",
+				Computed:            true,
+			},
+			"latency_ms": schema.Int64Attribute{
+				MarkdownDescription: "Time in milliseconds until the API answered, or until the request failed",
+				Computed:            true,
+			},
+			"server_time": schema.StringAttribute{
+				MarkdownDescription: "Time reported by the API server, from the response timestamp or the HTTP `Date` header; null when the API was not reachable",
+				Computed:            true,
+			},
+			"user_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the authenticated user; null when the server does not report it",
+				Computed:            true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Username of the authenticated user; null when the server does not report it",
+				Computed:            true,
+			},
+			"is_admin": schema.BoolAttribute{
+				MarkdownDescription: "Whether the authenticated user is an administrator; null when the server does not report it",
+				Computed:            true,
+			},
+			"error": schema.StringAttribute{
+				MarkdownDescription: "Why the API is unreachable or rejected the credentials; null when it is healthy",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *APIStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *APIStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Probing API status")
+
+	status := d.client.GetAPIStatus(ctx)
+	data := apiStatusModel(status)
+
+	tflog.Debug(ctx, "Probed API status", map[string]interface{}{
+		"reachable":     status.Reachable,
+		"authenticated": status.Authenticated,
+		"latency":       status.Latency.String(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// apiStatusModel converts a probe result to the data source model.
+func apiStatusModel(status *APIStatus) APIStatusDataSourceModel {
+	data := APIStatusDataSourceModel{
+		Reachable:     types.BoolValue(status.Reachable),
+		Authenticated: types.BoolValue(status.Authenticated),
+		StatusCode:    types.Int64Value(int64(status.StatusCode)),
+		LatencyMs:     types.Int64Value(status.Latency.Milliseconds()),
+		ServerTime:    types.StringNull(),
+		UserID:        types.Int64Null(),
+		Username:      types.StringNull(),
+		IsAdmin:       types.BoolNull(),
+		Error:         types.StringNull(),
+	}
+	if status.ServerTime != "" {
+		data.ServerTime = types.StringValue(status.ServerTime)
+	}
+	if status.User != nil {
+		data.UserID = types.Int64Value(int64(status.User.UserID))
+		data.Username = types.StringValue(status.User.Username)
+		data.IsAdmin = types.BoolValue(status.User.IsAdmin)
+	}
+	if status.Error != "" {
+		data.Error = types.StringValue(status.Error)
+	}
+	return data
+}
@@ -0,0 +1,115 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestGetAPIStatus(t *testing.T) {
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	down.Close()
+
+	tests := []struct {
+		name              string
+		handler           http.HandlerFunc
+		baseURL           string
+		wantReachable     bool
+		wantAuthenticated bool
+		wantUser          string
+		wantServerTime    string
+		wantError         string
+	}{
+		{
+			name: "healthy",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/v2/users/me" {
+					t.Errorf("unexpected path %s", r.URL.Path)
+				}
+				serveFixture(t, w, http.StatusOK, "user_get")
+			},
+			wantReachable:     true,
+			wantAuthenticated: true,
+			wantUser:          "jane",
+			wantServerTime:    "2026-10-16T09:12:44+00:00",
+		},
+		{
+			name: "rejected credentials",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				serveFixture(t, w, http.StatusUnauthorized, "error_unauthorized")
+			},
+			wantReachable:  true,
+			wantServerTime: "2026-10-16T09:12:44+00:00",
+			wantError:      "Invalid API key",
+		},
+		{
+			name: "server without the endpoint",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Date", "Fri, 16 Oct 2026 09:12:44 GMT")
+				w.WriteHeader(http.StatusNotFound)
+			},
+			wantReachable:     true,
+			wantAuthenticated: true,
+			wantServerTime:    "2026-10-16T09:12:44Z",
+		},
+		{
+			name:      "unreachable",
+			handler:   func(w http.ResponseWriter, r *http.Request) {},
+			baseURL:   down.URL,
+			wantError: "connection refused",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, tt.handler)
+			if tt.baseURL != "" {
+				client.BaseURL = tt.baseURL
+			}
+			status := client.GetAPIStatus(context.Background())
+
+			if status.Reachable != tt.wantReachable || status.Authenticated != tt.wantAuthenticated {
+				t.Errorf("reachable, authenticated = %v, %v, want %v, %v", status.Reachable, status.Authenticated, tt.wantReachable, tt.wantAuthenticated)
+			}
+			model := apiStatusModel(status)
+			if got := model.Username.ValueString(); got != tt.wantUser {
+				t.Errorf("username = %q, want %q", got, tt.wantUser)
+			}
+			if got := model.ServerTime.ValueString(); got != tt.wantServerTime {
+				t.Errorf("server_time = %q, want %q", got, tt.wantServerTime)
+			}
+			if tt.wantError == "" && !model.Error.IsNull() {
+				t.Errorf("unexpected error %q", model.Error.ValueString())
+			}
+			if !strings.Contains(model.Error.ValueString(), tt.wantError) {
+				t.Errorf("error = %q, want it to contain %q", model.Error.ValueString(), tt.wantError)
+			}
+		})
+	}
+}
+
+func TestAccAPIStatusDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+data "poweradmin_api_status" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.poweradmin_api_status.test", "reachable", "true"),
+					resource.TestCheckResourceAttr("data.poweradmin_api_status.test", "authenticated", "true"),
+					resource.TestCheckResourceAttrSet("data.poweradmin_api_status.test", "latency_ms"),
+					resource.TestCheckResourceAttrSet("data.poweradmin_api_status.test", "server_time"),
+				),
+			},
+		},
+	})
+}
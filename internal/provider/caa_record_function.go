@@ -0,0 +1,124 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &CAARecordFunction{}
+
+func NewCAARecordFunction() function.Function {
+	return &CAARecordFunction{}
+}
+
+// CAARecordFunction builds the content of a CAA record.
+type CAARecordFunction struct{}
+
+func (f *CAARecordFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "caa_record"
+}
+
+func (f *CAARecordFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build a CAA record value",
+		MarkdownDescription: "Validates a CAA property and returns the record content, e.g. `0 issue \"letsencrypt.org\"`. " +
+			"Supported tags: `issue`, `issuewild`, `issuemail`, `issuevmc`, `iodef`, `contactemail` and `contactphone`. " +
+			"`iodef` values must be `mailto:`, `http:` or `https:` URIs. The value is quoted, with embedded quotes and backslashes escaped.",
+		Parameters: []function.Parameter{
+			function.Int64Parameter{
+				Name:                "flags",
+				MarkdownDescription: "Flags byte (0-255); use `128` to mark the property critical",
+			},
+			function.StringParameter{
+				Name:                "tag",
+				MarkdownDescription: "Property tag, e.g. `issue`",
+			},
+			function.StringParameter{
+				Name:                "value",
+				MarkdownDescription: "Property value, e.g. `letsencrypt.org` or `;` to forbid issuance",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *CAARecordFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var flags int64
+	var tag, value string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &flags, &tag, &value))
+	if resp.Error != nil {
+		return
+	}
+
+	if flags < 0 || flags > 255 {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("flags must be between 0 and 255, got %d", flags))
+		return
+	}
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if err := validateCAATag(tag); err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+	if err := validateCAAValue(tag, value); err != nil {
+		resp.Error = function.NewArgumentFuncError(2, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, formatCAARecord(flags, tag, value)))
+}
+
+// caaTags are the property tags registered with IANA.
+var caaTags = map[string]bool{
+	"issue":        true,
+	"issuewild":    true,
+	"issuemail":    true,
+	"issuevmc":     true,
+	"iodef":        true,
+	"contactemail": true,
+	"contactphone": true,
+}
+
+func validateCAATag(tag string) error {
+	if !caaTags[tag] {
+		return fmt.Errorf("%q is not a supported CAA tag (issue, issuewild, issuemail, issuevmc, iodef, contactemail, contactphone)", tag)
+	}
+	return nil
+}
+
+func validateCAAValue(tag, value string) error {
+	switch tag {
+	case "issue", "issuewild", "issuemail", "issuevmc":
+		// issuer-domain-name [; parameters], where an empty issuer forbids issuance
+		issuer := strings.TrimSpace(strings.SplitN(value, ";", 2)[0])
+		if issuer != "" && (!strings.Contains(issuer, ".") || strings.ContainsAny(issuer, " \t\"")) {
+			return fmt.Errorf("%q is not a valid issuer domain", issuer)
+		}
+		if issuer == "" && !strings.Contains(value, ";") {
+			return fmt.Errorf("value must name an issuer, or be \";\" to forbid issuance")
+		}
+	case "iodef":
+		lower := strings.ToLower(value)
+		if !strings.HasPrefix(lower, "mailto:") && !strings.HasPrefix(lower, "http:") && !strings.HasPrefix(lower, "https:") {
+			return fmt.Errorf("%q must be a mailto:, http: or https: URI", value)
+		}
+	default:
+		if strings.TrimSpace(value) == "" {
+			return fmt.Errorf("value must not be empty")
+		}
+	}
+	return nil
+}
+
+// formatCAARecord renders CAA content with the value as a quoted string.
+func formatCAARecord(flags int64, tag, value string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value)
+	return fmt.Sprintf("%d %s \"%s\"", flags, tag, escaped)
+}
@@ -0,0 +1,54 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCAARecordFunctionRun(t *testing.T) {
+	tests := []struct {
+		name    string
+		flags   int64
+		tag     string
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{"issue", 0, "issue", "letsencrypt.org", `0 issue "letsencrypt.org"`, false},
+		{"issue with parameters", 0, "issue", "letsencrypt.org; validationmethods=dns-01", `0 issue "letsencrypt.org; validationmethods=dns-01"`, false},
+		{"forbid wildcard", 0, "issuewild", ";", `0 issuewild ";"`, false},
+		{"critical iodef", 128, "IODEF", "mailto:security@example.com", `128 iodef "mailto:security@example.com"`, false},
+		{"escapes quotes", 0, "contactphone", `+1 "555"`, `0 contactphone "+1 \"555\""`, false},
+		{"flags out of range", 256, "issue", "letsencrypt.org", "", true},
+		{"unknown tag", 0, "issuer", "letsencrypt.org", "", true},
+		{"empty issue", 0, "issue", "", "", true},
+		{"bad issuer", 0, "issue", "not a domain", "", true},
+		{"bad iodef", 0, "iodef", "security@example.com", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{
+				types.Int64Value(tt.flags), types.StringValue(tt.tag), types.StringValue(tt.value),
+			})}
+			resp := function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+			NewCAARecordFunction().Run(context.Background(), req, &resp)
+
+			if (resp.Error != nil) != tt.wantErr {
+				t.Fatalf("Run() error = %v, wantErr %v", resp.Error, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := resp.Result.Value().(types.String).ValueString(); got != tt.want {
+				t.Errorf("Run() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -10,13 +10,23 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"html"
 	"io"
+	"net"
 	"net/http"
+	"net/netip"
 	"net/url"
+	"os"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -29,7 +39,65 @@ type Client struct {
 	Password   string
 	APIVersion string // "v2" for Poweradmin 4.1.0+
 
+	// ReloadAPIKey, when set, is called after a 401 response to fetch a
+	// fresh API key; the failed request is replayed once if the key changed.
+	ReloadAPIKey func(ctx context.Context) (string, error)
+	authMu       sync.RWMutex // guards APIKey once requests run concurrently
+	// APIPathPrefix replaces the default "/api/{APIVersion}" path segment
+	// between BaseURL and each endpoint when non-empty.
+	APIPathPrefix string
+
+	// CacheZoneReads serves RRSet reads from one listing per zone per run.
+	CacheZoneReads bool
+	// ReadOnly refuses every request that could change server state.
+	ReadOnly bool
+	// ConflictRetryTimeout is how long requests failing with a 409 conflict
+	// or a "zone is locked" error are retried before giving up; 0 disables.
+	ConflictRetryTimeout time.Duration
+	// Retry retries requests failing with transient HTTP statuses.
+	Retry RetryPolicy
+	// UserAgent is sent with every request when non-empty.
+	UserAgent string
+	// LogCurlCommands logs a redacted curl command reproducing each request.
+	LogCurlCommands bool
+	// DNSCheckServers, as host:port, are queried for DNS-based verification
+	// instead of each zone's own name servers when non-empty.
+	DNSCheckServers []string
+	// RRSetSizeWarningThreshold is the record count above which planning an
+	// RRSet warns; 0 disables the warning.
+	RRSetSizeWarningThreshold int
+	// AutoQuoteTXT quotes unquoted TXT content before sending it, unless a
+	// resource sets auto_quote_txt itself.
+	AutoQuoteTXT bool
+	// ForbidLUARecords refuses to plan new or changed LUA records.
+	ForbidLUARecords bool
+	// SlowRequestThreshold is the duration above which an API call is logged
+	// as slow; 0 disables the warning.
+	SlowRequestThreshold time.Duration
+	// FailoverURLs are base URLs of further servers of the same installation,
+	// tried in order when BaseURL cannot be reached.
+	FailoverURLs []string
+	// PowerDNSAPIURL and PowerDNSAPIKey, when set, address the PowerDNS API
+	// that metadata, DNSSEC key, notify and rectify operations fall back to
+	// when the Poweradmin API lacks their endpoints.
+	PowerDNSAPIURL string
+	PowerDNSAPIKey string
+
+	retryBaseDelay time.Duration // first backoff delay; defaultRetryBaseDelay when zero
+
 	zoneNames sync.Map // zone ID (int64) → zone name, memoized for name normalization
+	zoneTypes sync.Map // zone ID (int64) → last seen zone type, for record placement checks
+	// zone ID (int64) → default record TTL in seconds, memoized for planning
+	zoneRecordTTLs sync.Map
+
+	rrsetCache zoneRRSetCache
+	zoneList   listCache[Zone]
+	userList   listCache[User]
+	breaker    circuitBreaker
+	endpoints  endpointHealth
+
+	slowRequests    atomic.Int64 // API calls that exceeded SlowRequestThreshold
+	slowRequestTime atomic.Int64 // their total duration, in nanoseconds
 }
 
 // APIResponse represents a standard Poweradmin API response.
@@ -74,7 +142,7 @@ type Pagination struct {
 }
 
 // NewClient creates a new Poweradmin API client.
-func NewClient(config *PoweradminProviderModel) (*Client, error) {
+func NewClient(ctx context.Context, config *PoweradminProviderModel) (*Client, error) {
 	if config.ApiUrl.IsNull() || config.ApiUrl.ValueString() == "" {
 		return nil, fmt.Errorf("api_url is required")
 	}
@@ -98,6 +166,98 @@ func NewClient(config *PoweradminProviderModel) (*Client, error) {
 		apiVersion = config.ApiVersion.ValueString()
 	}
 
+	var apiPathPrefix string
+	if !config.ApiPathPrefix.IsNull() && config.ApiPathPrefix.ValueString() != "" {
+		apiPathPrefix, err = normalizeAPIPathPrefix(config.ApiPathPrefix.ValueString())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	conflictRetryTimeout := defaultConflictRetryTimeout
+	if !config.ConflictRetryTimeout.IsNull() && config.ConflictRetryTimeout.ValueString() != "" {
+		conflictRetryTimeout, err = time.ParseDuration(config.ConflictRetryTimeout.ValueString())
+		if err != nil || conflictRetryTimeout < 0 {
+			return nil, fmt.Errorf("invalid conflict_retry_timeout %q: must be a non-negative duration such as 30s or 2m", config.ConflictRetryTimeout.ValueString())
+		}
+	}
+
+	var dnsCheckServers []string
+	if !config.DNSCheckServers.IsNull() && !config.DNSCheckServers.IsUnknown() {
+		for _, element := range config.DNSCheckServers.Elements() {
+			value, ok := element.(types.String)
+			if !ok || value.IsNull() || value.IsUnknown() {
+				return nil, fmt.Errorf("invalid dns_check_servers: entries must be known strings")
+			}
+			server, err := normalizeDNSCheckServer(value.ValueString())
+			if err != nil {
+				return nil, err
+			}
+			dnsCheckServers = append(dnsCheckServers, server)
+		}
+	}
+
+	circuitBreakerThreshold := defaultCircuitBreakerThreshold
+	if !config.CircuitBreakerThreshold.IsNull() && !config.CircuitBreakerThreshold.IsUnknown() {
+		if config.CircuitBreakerThreshold.ValueInt64() < 0 {
+			return nil, fmt.Errorf("invalid circuit_breaker_threshold %d: must be 0 or greater", config.CircuitBreakerThreshold.ValueInt64())
+		}
+		circuitBreakerThreshold = int(config.CircuitBreakerThreshold.ValueInt64())
+	}
+
+	var retry RetryPolicy
+	if config.Retry != nil {
+		if retry, err = newRetryPolicy(config.Retry); err != nil {
+			return nil, err
+		}
+	}
+
+	rrsetSizeWarningThreshold := defaultRRSetSizeWarningThreshold
+	if !config.RRSetSizeWarningThreshold.IsNull() && !config.RRSetSizeWarningThreshold.IsUnknown() {
+		if config.RRSetSizeWarningThreshold.ValueInt64() < 0 {
+			return nil, fmt.Errorf("invalid rrset_size_warning_threshold %d: must be 0 or greater", config.RRSetSizeWarningThreshold.ValueInt64())
+		}
+		rrsetSizeWarningThreshold = int(config.RRSetSizeWarningThreshold.ValueInt64())
+	}
+
+	slowRequestThreshold := defaultSlowRequestThreshold
+	if !config.SlowRequestThreshold.IsNull() && config.SlowRequestThreshold.ValueString() != "" {
+		slowRequestThreshold, err = time.ParseDuration(config.SlowRequestThreshold.ValueString())
+		if err != nil || slowRequestThreshold < 0 {
+			return nil, fmt.Errorf("invalid slow_request_threshold %q: must be a non-negative duration such as 5s or 500ms", config.SlowRequestThreshold.ValueString())
+		}
+	}
+
+	var failoverURLs []string
+	if !config.FailoverAPIURLs.IsNull() && !config.FailoverAPIURLs.IsUnknown() {
+		for _, element := range config.FailoverAPIURLs.Elements() {
+			value, ok := element.(types.String)
+			if !ok || value.IsNull() || value.IsUnknown() {
+				return nil, fmt.Errorf("invalid failover_api_urls: entries must be known strings")
+			}
+			failoverURL := strings.TrimRight(value.ValueString(), "/")
+			parsed, err := url.Parse(failoverURL)
+			if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+				return nil, fmt.Errorf("invalid failover_api_urls entry %q: must be an http(s) URL with a host, e.g. https://dns2.example.com", failoverURL)
+			}
+			if failoverURL != baseURL && !slices.Contains(failoverURLs, failoverURL) {
+				failoverURLs = append(failoverURLs, failoverURL)
+			}
+		}
+	}
+
+	var powerDNSAPIURL string
+	if !config.PowerDNSAPIURL.IsNull() && config.PowerDNSAPIURL.ValueString() != "" {
+		powerDNSAPIURL = strings.TrimRight(config.PowerDNSAPIURL.ValueString(), "/")
+		parsed, err := url.Parse(powerDNSAPIURL)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return nil, fmt.Errorf("invalid powerdns_api_url %q: must be an http(s) URL with a host, e.g. http://127.0.0.1:8081", powerDNSAPIURL)
+		}
+		if config.PowerDNSAPIKey.ValueString() == "" {
+			return nil, fmt.Errorf("powerdns_api_key is required when powerdns_api_url is set")
+		}
+	}
+
 	// Create HTTP client with timeout and TLS config
 	httpClient := &http.Client{
 		Timeout: 30 * time.Second,
@@ -109,32 +269,68 @@ func NewClient(config *PoweradminProviderModel) (*Client, error) {
 		},
 	}
 
-	// Configure TLS if insecure mode is enabled. This is an explicit, opt-in
-	// escape hatch (insecure = true) for self-signed or internal endpoints;
-	// it is off by default. TLS 1.2 is still enforced so a skipped-verify
-	// connection cannot be downgraded to an older protocol.
-	if !config.Insecure.IsNull() && config.Insecure.ValueBool() {
+	tlsConfig, err := newTLSConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	protocols, err := httpProtocols(config.HTTPVersion.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig != nil || protocols != nil {
 		// Clone the default transport so proxy settings and sane defaults survive
 		defaultTransport, ok := http.DefaultTransport.(*http.Transport)
 		if !ok {
 			defaultTransport = &http.Transport{Proxy: http.ProxyFromEnvironment}
 		}
 		transport := defaultTransport.Clone()
-		transport.TLSClientConfig = &tls.Config{
-			InsecureSkipVerify: true, //nolint:gosec // G402: opt-in via insecure provider attribute
-			MinVersion:         tls.VersionTLS12,
-		}
+		transport.TLSClientConfig = tlsConfig
+		transport.Protocols = protocols
 		httpClient.Transport = transport
 	}
 
 	client := &Client{
-		BaseURL:    baseURL,
-		HTTPClient: httpClient,
-		APIVersion: apiVersion,
+		BaseURL:        baseURL,
+		HTTPClient:     httpClient,
+		APIVersion:     apiVersion,
+		APIPathPrefix:  apiPathPrefix,
+		CacheZoneReads: config.CacheZoneReads.ValueBool(),
+		ReadOnly:       config.ReadOnly.ValueBool(),
+
+		ConflictRetryTimeout: conflictRetryTimeout,
+		Retry:                retry,
+		DNSCheckServers:      dnsCheckServers,
+		LogCurlCommands:      config.LogCurlCommands.ValueBool(),
+
+		RRSetSizeWarningThreshold: rrsetSizeWarningThreshold,
+		AutoQuoteTXT:              config.AutoQuoteTXT.ValueBool(),
+		ForbidLUARecords:          config.ForbidLUARecords.ValueBool(),
+		FailoverURLs:              failoverURLs,
+		SlowRequestThreshold:      slowRequestThreshold,
+
+		PowerDNSAPIURL: powerDNSAPIURL,
+		PowerDNSAPIKey: config.PowerDNSAPIKey.ValueString(),
 	}
+	client.breaker.threshold = circuitBreakerThreshold
 
 	// Set authentication
-	if !config.ApiKey.IsNull() && config.ApiKey.ValueString() != "" {
+	if !config.ApiKeyFile.IsNull() && config.ApiKeyFile.ValueString() != "" {
+		keyFile := config.ApiKeyFile.ValueString()
+		client.ReloadAPIKey = func(context.Context) (string, error) { return readAPIKeyFile(keyFile) }
+		if client.APIKey, err = readAPIKeyFile(keyFile); err != nil {
+			return nil, err
+		}
+	} else if !config.VaultAPIKeyPath.IsNull() && config.VaultAPIKeyPath.ValueString() != "" {
+		secretPath := config.VaultAPIKeyPath.ValueString()
+		field := defaultVaultAPIKeyField
+		if config.VaultAPIKeyField.ValueString() != "" {
+			field = config.VaultAPIKeyField.ValueString()
+		}
+		client.ReloadAPIKey = func(ctx context.Context) (string, error) { return readVaultAPIKey(ctx, secretPath, field) }
+		if client.APIKey, err = readVaultAPIKey(ctx, secretPath, field); err != nil {
+			return nil, err
+		}
+	} else if !config.ApiKey.IsNull() && config.ApiKey.ValueString() != "" {
 		client.APIKey = config.ApiKey.ValueString()
 	} else if !config.Username.IsNull() && config.Username.ValueString() != "" {
 		client.Username = config.Username.ValueString()
@@ -142,38 +338,203 @@ func NewClient(config *PoweradminProviderModel) (*Client, error) {
 			client.Password = config.Password.ValueString()
 		}
 	} else {
-		return nil, fmt.Errorf("either api_key or username/password must be provided")
+		return nil, fmt.Errorf("either api_key, api_key_file, vault_api_key_path or username/password must be provided")
 	}
 
 	return client, nil
 }
 
-// buildURL constructs the full URL for an API endpoint.
-// Uses /api/{version}/ where version is v2 (Poweradmin 4.1.0+).
-func (c *Client) buildURL(path string) string {
+// readAPIKeyFile reads an API key kept in a file, such as one rotated by a
+// secrets agent, ignoring surrounding whitespace.
+func readAPIKeyFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read api_key_file: %w", err)
+	}
+	key := strings.TrimSpace(string(data))
+	if key == "" {
+		return "", fmt.Errorf("api_key_file %q is empty", path)
+	}
+	return key, nil
+}
+
+// normalizeAPIPathPrefix validates an api_path_prefix value and returns it
+// with a single leading slash and no trailing slash, except for "/" itself.
+func normalizeAPIPathPrefix(prefix string) (string, error) {
+	if strings.Contains(prefix, "://") {
+		return "", fmt.Errorf("invalid api_path_prefix %q: must be a path such as /api/v2, not a URL; set the host in api_url", prefix)
+	}
+	if strings.ContainsAny(prefix, "?# \t") {
+		return "", fmt.Errorf("invalid api_path_prefix %q: must be a plain path such as /api/v2", prefix)
+	}
+	return "/" + strings.Trim(prefix, "/"), nil
+}
+
+// buildUserAgent returns the User-Agent sent to the API: the provider name
+// and version, the Terraform version, then the TF_APPEND_USER_AGENT
+// environment variable and the configured suffix, when set.
+func buildUserAgent(providerVersion, terraformVersion, suffix string) string {
+	if providerVersion == "" {
+		providerVersion = "dev"
+	}
+	parts := []string{"terraform-provider-poweradmin/" + providerVersion}
+	if terraformVersion != "" {
+		parts = append(parts, "Terraform/"+terraformVersion)
+	}
+	for _, extra := range []string{os.Getenv("TF_APPEND_USER_AGENT"), suffix} {
+		if extra = strings.TrimSpace(extra); extra != "" {
+			parts = append(parts, extra)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// reachabilityTimeout bounds the probe Configure runs before deferring.
+const reachabilityTimeout = 5 * time.Second
+
+// checkReachable reports whether any API endpoint answers at all. Any HTTP
+// response counts; only transport failures such as DNS errors or refused and
+// timed-out connections are errors.
+func (c *Client) checkReachable(ctx context.Context) error {
+	var err error
+	for _, baseURL := range c.endpointOrder() {
+		if err = c.probe(ctx, baseURL); err == nil {
+			return nil
+		}
+		c.endpoints.markDown(baseURL)
+	}
+	return err
+}
+
+// probe sends a HEAD request to baseURL within reachabilityTimeout.
+func (c *Client) probe(ctx context.Context, baseURL string) error {
+	ctx, cancel := context.WithTimeout(ctx, reachabilityTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, baseURL, nil)
+	if err != nil {
+		return err
+	}
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// normalizeDNSCheckServer validates a dns_check_servers entry and returns it
+// as host:port, adding port 53 when none is given.
+func normalizeDNSCheckServer(server string) (string, error) {
+	server = strings.TrimSpace(server)
+	if server == "" || strings.Contains(server, "://") || strings.ContainsAny(server, "/ \t") {
+		return "", fmt.Errorf("invalid dns_check_servers entry %q: must be an IP address or host name with an optional port, such as 192.0.2.53 or ns1.example.com:5353", server)
+	}
+	// Bare IPv6 addresses contain colons but no port
+	if addr, err := netip.ParseAddr(server); err == nil {
+		return net.JoinHostPort(addr.String(), "53"), nil
+	}
+	host, port, err := net.SplitHostPort(server)
+	if err != nil {
+		if strings.Contains(server, ":") {
+			return "", fmt.Errorf("invalid dns_check_servers entry %q: %w", server, err)
+		}
+		return net.JoinHostPort(server, "53"), nil
+	}
+	if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 || host == "" {
+		return "", fmt.Errorf("invalid dns_check_servers entry %q: must be host:port with a port between 1 and 65535", server)
+	}
+	return server, nil
+}
+
+// buildURL constructs the full URL for an API endpoint on the server at
+// baseURL.
+// Uses /api/{version}/ where version is v2 (Poweradmin 4.1.0+), unless
+// APIPathPrefix overrides it.
+func (c *Client) buildURL(baseURL, path string) string {
 	// Remove leading slash if present
 	path = strings.TrimLeft(path, "/")
 
+	if c.APIPathPrefix != "" {
+		return fmt.Sprintf("%s%s/%s", baseURL, strings.TrimSuffix(c.APIPathPrefix, "/"), path)
+	}
+
 	// Use dynamic API version prefix
-	return fmt.Sprintf("%s/api/%s/%s", c.BaseURL, c.APIVersion, path)
+	return fmt.Sprintf("%s/api/%s/%s", baseURL, c.APIVersion, path)
 }
 
-// doRequest executes an HTTP request with authentication and returns the response.
+// doRequest executes an HTTP request with authentication and returns the
+// response, failing over to the next endpoint when one cannot be reached.
 func (c *Client) doRequest(ctx context.Context, method, path string, body interface{}) (*http.Response, error) {
-	url := c.buildURL(path)
+	if method != http.MethodGet {
+		if c.ReadOnly && path != sessionLoginPath {
+			return nil, &readOnlyError{Method: method, Path: path}
+		}
+		c.invalidateZoneCache(path)
+	}
 
-	var reqBody io.Reader
+	var jsonBody []byte
 	if body != nil {
-		jsonBody, err := json.Marshal(body)
+		var err error
+		jsonBody, err = json.Marshal(body)
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal request body: %w", err)
 		}
-		reqBody = bytes.NewBuffer(jsonBody)
 		tflog.Debug(ctx, "Request body", map[string]interface{}{
 			"body": string(jsonBody),
 		})
 	}
 
+	var lastErr error
+	for _, baseURL := range c.endpointOrder() {
+		req, err := c.newRequest(ctx, method, c.buildURL(baseURL, path), jsonBody)
+		if err != nil {
+			return nil, err
+		}
+
+		tflog.Debug(ctx, "Making API request", map[string]interface{}{
+			"method":      method,
+			"url":         req.URL.String(),
+			"api_version": c.APIVersion,
+		})
+		if c.LogCurlCommands {
+			tflog.Info(ctx, "Equivalent curl command", map[string]interface{}{
+				"curl": c.curlCommand(req, jsonBody),
+			})
+		}
+
+		resp, err := c.HTTPClient.Do(req)
+		if err == nil {
+			c.endpoints.markUp(baseURL)
+			return resp, nil
+		}
+		lastErr = fmt.Errorf("request failed: %w", err)
+		if !isConnectionError(ctx, err) {
+			return nil, lastErr
+		}
+		c.endpoints.markDown(baseURL)
+		if !canFailOver(method, err) {
+			return nil, lastErr
+		}
+		if len(c.FailoverURLs) > 0 {
+			tflog.Warn(ctx, "API endpoint unreachable, failing over", map[string]interface{}{
+				"endpoint": baseURL,
+				"error":    err.Error(),
+			})
+		}
+	}
+	return nil, lastErr
+}
+
+// newRequest creates a request with the JSON headers and authentication
+// every API call carries.
+func (c *Client) newRequest(ctx context.Context, method, url string, jsonBody []byte) (*http.Request, error) {
+	var reqBody io.Reader
+	if jsonBody != nil {
+		reqBody = bytes.NewReader(jsonBody)
+	}
 	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
@@ -182,29 +543,165 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body interf
 	// Set headers
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
 
 	// Add authentication
-	if c.APIKey != "" {
+	if apiKey := c.apiKey(); apiKey != "" {
 		// Prefer API key authentication
-		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.APIKey))
-		req.Header.Set("X-API-Key", c.APIKey)
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", apiKey))
+		req.Header.Set("X-API-Key", apiKey)
 	} else if c.Username != "" {
 		// Fall back to basic auth
 		req.SetBasicAuth(c.Username, c.Password)
 	}
+	return req, nil
+}
 
-	tflog.Debug(ctx, "Making API request", map[string]interface{}{
-		"method":      method,
-		"url":         url,
-		"api_version": c.APIVersion,
-	})
+// tlsMinVersions maps tls_min_version values to TLS protocol versions.
+var tlsMinVersions = map[string]uint16{
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
 
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+// newTLSConfig builds the TLS settings of API connections from
+// tls_skip_verify (or the deprecated insecure), tls_min_version and
+// tls_server_name; nil when all are unset, keeping Go's defaults. Skipping
+// verification is an explicit, opt-in escape hatch for self-signed or
+// internal endpoints; TLS 1.2 stays the floor so a skipped-verify connection
+// cannot also be downgraded to an older protocol.
+func newTLSConfig(config *PoweradminProviderModel) (*tls.Config, error) {
+	skipVerify := config.TLSSkipVerify.ValueBool() || config.Insecure.ValueBool()
+	minVersion := uint16(tls.VersionTLS12)
+	if version := config.TLSMinVersion.ValueString(); version != "" {
+		var ok bool
+		if minVersion, ok = tlsMinVersions[version]; !ok {
+			return nil, fmt.Errorf("invalid tls_min_version %q: must be 1.2 or 1.3", version)
+		}
 	}
+	serverName := strings.TrimSuffix(config.TLSServerName.ValueString(), ".")
+	if !skipVerify && config.TLSMinVersion.ValueString() == "" && serverName == "" {
+		return nil, nil
+	}
+	return &tls.Config{
+		InsecureSkipVerify: skipVerify, //nolint:gosec // G402: opt-in via tls_skip_verify provider attribute
+		MinVersion:         minVersion,
+		ServerName:         serverName,
+	}, nil
+}
 
-	return resp, nil
+// httpProtocols returns the HTTP versions the transport may use for an
+// http_version value: "1.1" only HTTP/1.1, for proxies that mishandle
+// HTTP/2, and "2" only HTTP/2, including unencrypted HTTP/2 for http://
+// URLs. nil for "" or "auto" keeps Go's default of negotiating HTTP/2 over
+// TLS and falling back to HTTP/1.1.
+func httpProtocols(version string) (*http.Protocols, error) {
+	protocols := new(http.Protocols)
+	switch version {
+	case "", "auto":
+		return nil, nil
+	case "1.1":
+		protocols.SetHTTP1(true)
+	case "2":
+		protocols.SetHTTP2(true)
+		protocols.SetUnencryptedHTTP2(true)
+	default:
+		return nil, fmt.Errorf("invalid http_version %q: must be auto, 1.1 or 2", version)
+	}
+	return protocols, nil
+}
+
+// redactedValue replaces secrets in logged curl commands.
+const redactedValue = "<redacted>"
+
+// curlCommand renders a request as a curl command line with credentials and
+// password fields of the body redacted, so users can reproduce and report
+// API-side problems without leaking secrets.
+func (c *Client) curlCommand(req *http.Request, body []byte) string {
+	args := []string{"curl", "-X", req.Method, shellQuote(req.URL.String())}
+	if transport, ok := c.HTTPClient.Transport.(*http.Transport); ok && transport.TLSClientConfig != nil {
+		if transport.TLSClientConfig.InsecureSkipVerify {
+			args = append(args, "--insecure")
+		}
+		if transport.TLSClientConfig.MinVersion == tls.VersionTLS13 {
+			args = append(args, "--tlsv1.3")
+		}
+	}
+	if transport, ok := c.HTTPClient.Transport.(*http.Transport); ok && transport.Protocols != nil {
+		switch {
+		case transport.Protocols.HTTP1():
+			args = append(args, "--http1.1")
+		case transport.Protocols.UnencryptedHTTP2():
+			args = append(args, "--http2-prior-knowledge")
+		}
+	}
+
+	names := make([]string, 0, len(req.Header))
+	for name := range req.Header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		value := req.Header.Get(name)
+		switch name {
+		case "Authorization":
+			scheme, _, _ := strings.Cut(value, " ")
+			if scheme == "Basic" {
+				args = append(args, "-u", shellQuote(c.Username+":"+redactedValue))
+				continue
+			}
+			value = scheme + " " + redactedValue
+		case "X-Api-Key":
+			value = redactedValue
+		}
+		args = append(args, "-H", shellQuote(name+": "+value))
+	}
+
+	if len(body) > 0 {
+		args = append(args, "--data", shellQuote(string(redactJSONSecrets(body))))
+	}
+	return strings.Join(args, " ")
+}
+
+// redactJSONSecrets replaces the values of password-like keys anywhere in a
+// JSON document; bodies that are not JSON objects are returned unchanged.
+func redactJSONSecrets(body []byte) []byte {
+	var doc interface{}
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return body
+	}
+	var walk func(v interface{})
+	walk = func(v interface{}) {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			for key, value := range v {
+				lower := strings.ToLower(key)
+				if strings.Contains(lower, "password") || strings.Contains(lower, "secret") || lower == "api_key" || lower == "token" {
+					v[key] = redactedValue
+					continue
+				}
+				walk(value)
+			}
+		case []interface{}:
+			for _, value := range v {
+				walk(value)
+			}
+		}
+	}
+	walk(doc)
+	var redacted bytes.Buffer
+	encoder := json.NewEncoder(&redacted)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(doc); err != nil {
+		return body
+	}
+	return bytes.TrimSuffix(redacted.Bytes(), []byte("\n"))
+}
+
+// shellQuote quotes s for POSIX shells.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
 }
 
 // maxResponseBytes caps how much of an API response body is read; Poweradmin
@@ -232,6 +729,9 @@ func (c *Client) parseResponse(ctx context.Context, resp *http.Response, result
 
 	// Handle non-2xx status codes
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		if !json.Valid(body) {
+			return &apiHTTPError{StatusCode: resp.StatusCode, Message: nonJSONResponseMessage(resp, body)}
+		}
 		msg := string(body)
 		var apiResp APIResponse
 		if err := json.Unmarshal(body, &apiResp); err == nil {
@@ -240,7 +740,16 @@ func (c *Client) parseResponse(ctx context.Context, resp *http.Response, result
 		return &apiHTTPError{StatusCode: resp.StatusCode, Message: msg}
 	}
 
+	// Some endpoints answer a successful update or delete with an empty
+	// body instead of 204; there is nothing to unmarshal.
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+
 	// Parse response
+	if !json.Valid(body) {
+		return fmt.Errorf("failed to parse API response: %s", nonJSONResponseMessage(resp, body))
+	}
 	var apiResp APIResponse
 	if err := json.Unmarshal(body, &apiResp); err != nil {
 		return fmt.Errorf("failed to parse API response: %w", err)
@@ -260,49 +769,334 @@ func (c *Client) parseResponse(ctx context.Context, resp *http.Response, result
 	return nil
 }
 
-// Get performs a GET request.
-func (c *Client) Get(ctx context.Context, path string, result interface{}) error {
-	resp, err := c.doRequest(ctx, http.MethodGet, path, nil)
+// maxSummaryLength caps the excerpt of a non-JSON response quoted in errors.
+const maxSummaryLength = 200
+
+var (
+	htmlTitlePattern = regexp.MustCompile(`(?is)<title[^>]*>(.*?)</title>`)
+	htmlTagPattern   = regexp.MustCompile(`(?s)<[^>]*>`)
+)
+
+// nonJSONResponseMessage summarizes a response that is not JSON, typically an
+// HTML error page from a proxy, an authentication portal or a PHP fatal
+// error, as the status line plus the page title or first line of text,
+// instead of quoting the whole body.
+func nonJSONResponseMessage(resp *http.Response, body []byte) string {
+	text := strings.TrimSpace(string(body))
+	isHTML := strings.Contains(strings.ToLower(resp.Header.Get("Content-Type")), "html") || strings.HasPrefix(text, "<")
+
+	var summary string
+	if isHTML {
+		if m := htmlTitlePattern.FindStringSubmatch(text); m != nil {
+			summary = m[1]
+		} else {
+			summary = firstLine(htmlTagPattern.ReplaceAllString(text, "\n"))
+		}
+		summary = html.UnescapeString(summary)
+	} else {
+		summary = firstLine(text)
+	}
+	summary = strings.Join(strings.Fields(summary), " ")
+	if r := []rune(summary); len(r) > maxSummaryLength {
+		summary = string(r[:maxSummaryLength]) + "..."
+	}
+
+	kind := "a non-JSON response"
+	switch {
+	case text == "":
+		kind = "an empty response"
+	case isHTML:
+		kind = "an HTML page"
+	}
+	msg := fmt.Sprintf("received %s instead of JSON (%s)", kind, resp.Status)
+	if summary != "" && !strings.EqualFold(summary, resp.Status) {
+		msg += fmt.Sprintf(": %q", summary)
+	}
+	return msg + ". Check that api_url points at Poweradmin and that no proxy, load balancer or login portal " +
+		"is answering in its place; run with TF_LOG=DEBUG to see the full response"
+}
+
+// firstLine returns the first non-blank line of s.
+func firstLine(s string) string {
+	for _, line := range strings.Split(s, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			return line
+		}
+	}
+	return ""
+}
+
+// Conflict retry defaults: concurrent applies against one zone usually clear
+// within seconds, so back off quickly and cap the delay.
+const (
+	defaultConflictRetryTimeout = 30 * time.Second
+	defaultRetryBaseDelay       = 500 * time.Millisecond
+	maxRetryDelay               = 5 * time.Second
+)
+
+// RetryPolicy retries requests whose method and HTTP status are listed, up
+// to MaxAttempts attempts within MaxElapsedTime. The zero value retries none.
+type RetryPolicy struct {
+	Statuses       []int
+	Methods        []string
+	MaxAttempts    int
+	MaxElapsedTime time.Duration
+}
+
+// Retry block defaults: statuses proxies and rate limiters return while the
+// server recovers, and only methods safe to repeat.
+var (
+	defaultRetryStatuses = []int{http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout}
+	defaultRetryMethods  = []string{http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete}
+)
+
+const (
+	defaultRetryMaxAttempts    = 5
+	defaultRetryMaxElapsedTime = time.Minute
+)
+
+// newRetryPolicy validates the retry block, filling in defaults for unset
+// attributes.
+func newRetryPolicy(config *RetryPolicyModel) (RetryPolicy, error) {
+	policy := RetryPolicy{
+		Statuses:       defaultRetryStatuses,
+		Methods:        defaultRetryMethods,
+		MaxAttempts:    defaultRetryMaxAttempts,
+		MaxElapsedTime: defaultRetryMaxElapsedTime,
+	}
+	if !config.Statuses.IsNull() && !config.Statuses.IsUnknown() {
+		policy.Statuses = nil
+		for _, element := range config.Statuses.Elements() {
+			value, ok := element.(types.Int64)
+			if !ok || value.IsNull() || value.IsUnknown() || value.ValueInt64() < 400 || value.ValueInt64() > 599 {
+				return RetryPolicy{}, fmt.Errorf("invalid retry statuses %s: entries must be HTTP error statuses between 400 and 599", config.Statuses)
+			}
+			policy.Statuses = append(policy.Statuses, int(value.ValueInt64()))
+		}
+	}
+	if !config.Methods.IsNull() && !config.Methods.IsUnknown() {
+		policy.Methods = nil
+		for _, element := range config.Methods.Elements() {
+			value, ok := element.(types.String)
+			method := strings.ToUpper(value.ValueString())
+			switch method {
+			case http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+			default:
+				ok = false
+			}
+			if !ok || value.IsUnknown() {
+				return RetryPolicy{}, fmt.Errorf("invalid retry methods %s: entries must be one of GET, HEAD, POST, PUT, PATCH or DELETE", config.Methods)
+			}
+			policy.Methods = append(policy.Methods, method)
+		}
+	}
+	if !config.MaxAttempts.IsNull() && !config.MaxAttempts.IsUnknown() {
+		if config.MaxAttempts.ValueInt64() < 1 {
+			return RetryPolicy{}, fmt.Errorf("invalid retry max_attempts %d: must be at least 1", config.MaxAttempts.ValueInt64())
+		}
+		policy.MaxAttempts = int(config.MaxAttempts.ValueInt64())
+	}
+	if !config.MaxElapsedTime.IsNull() && config.MaxElapsedTime.ValueString() != "" {
+		elapsed, err := time.ParseDuration(config.MaxElapsedTime.ValueString())
+		if err != nil || elapsed <= 0 {
+			return RetryPolicy{}, fmt.Errorf("invalid retry max_elapsed_time %q: must be a positive duration such as 30s or 2m", config.MaxElapsedTime.ValueString())
+		}
+		policy.MaxElapsedTime = elapsed
+	}
+	return policy, nil
+}
+
+// retries reports whether the policy retries a request with method that
+// failed with err.
+func (p RetryPolicy) retries(method string, err error) bool {
+	var apiErr *apiHTTPError
+	if !errors.As(err, &apiErr) || !slices.Contains(p.Methods, method) {
+		return false
+	}
+	return slices.Contains(p.Statuses, apiErr.StatusCode)
+}
+
+// defaultRRSetSizeWarningThreshold is generous for round-robin and TXT
+// verification sets while catching runaway generated configuration.
+const defaultRRSetSizeWarningThreshold = 100
+
+// request sends a request through the circuit breaker, failing fast while
+// the API is known to be down.
+func (c *Client) request(ctx context.Context, method, path string, body interface{}, result interface{}) error {
+	probe, err := c.breaker.allow()
 	if err != nil {
 		return err
 	}
-	return c.parseResponse(ctx, resp, result)
+	err = c.requestWithRetries(ctx, method, path, body, result)
+	c.breaker.record(err, probe)
+	return err
+}
+
+// requestWithRetries sends a request and parses its response, retrying
+// conflict and zone-locked errors with exponential backoff until
+// ConflictRetryTimeout and statuses selected by the retry policy within its
+// limits, and replaying a request rejected with 401 once after reloading the
+// API key.
+func (c *Client) requestWithRetries(ctx context.Context, method, path string, body interface{}, result interface{}) error {
+	start := time.Now()
+	deadline := start.Add(c.ConflictRetryTimeout)
+	delay := c.retryBaseDelay
+	if delay <= 0 {
+		delay = defaultRetryBaseDelay
+	}
+	reauthenticated := false
+	for attempt := 1; ; attempt++ {
+		sentKey := c.apiKey()
+		sent := time.Now()
+		resp, err := c.doRequest(ctx, method, path, body)
+		if err == nil {
+			err = c.parseResponse(ctx, resp, result)
+		}
+		c.checkSlowRequest(ctx, method, path, time.Since(sent))
+		if !reauthenticated && c.reauthenticate(ctx, err, sentKey) {
+			reauthenticated = true
+			continue
+		}
+		if err == nil || !c.shouldRetry(method, err, attempt, start, deadline, delay) {
+			return err
+		}
+
+		tflog.Warn(ctx, "API request failed, retrying", map[string]interface{}{
+			"method":  method,
+			"path":    path,
+			"attempt": attempt,
+			"delay":   delay.String(),
+			"error":   err.Error(),
+		})
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, maxRetryDelay)
+	}
+}
+
+// defaultSlowRequestThreshold flags calls far slower than a healthy
+// Poweradmin answers, well before the 30 second client timeout.
+const defaultSlowRequestThreshold = 5 * time.Second
+
+// checkSlowRequest logs a warning when an API call took longer than
+// SlowRequestThreshold, with the running count and total time of slow calls
+// so a pattern across an apply is visible from any one warning.
+func (c *Client) checkSlowRequest(ctx context.Context, method, path string, elapsed time.Duration) {
+	if c.SlowRequestThreshold <= 0 || elapsed <= c.SlowRequestThreshold {
+		return
+	}
+	count := c.slowRequests.Add(1)
+	total := time.Duration(c.slowRequestTime.Add(int64(elapsed)))
+	tflog.Warn(ctx, "Slow API request", map[string]interface{}{
+		"method":              method,
+		"path":                path,
+		"duration":            elapsed.Round(time.Millisecond).String(),
+		"threshold":           c.SlowRequestThreshold.String(),
+		"slow_requests_total": count,
+		"slow_requests_time":  total.Round(time.Millisecond).String(),
+	})
+}
+
+// shouldRetry reports whether a request failing with err on the given
+// attempt is retried after delay: conflicts until the conflict deadline,
+// statuses selected by the retry policy until its attempt and time limits.
+func (c *Client) shouldRetry(method string, err error, attempt int, start, conflictDeadline time.Time, delay time.Duration) bool {
+	next := time.Now().Add(delay)
+	if isRetryableConflict(err) && !next.After(conflictDeadline) {
+		return true
+	}
+	return c.Retry.retries(method, err) && attempt < c.Retry.MaxAttempts && !next.After(start.Add(c.Retry.MaxElapsedTime))
+}
+
+// apiKey returns the API key currently used for requests.
+func (c *Client) apiKey() string {
+	c.authMu.RLock()
+	defer c.authMu.RUnlock()
+	return c.APIKey
+}
+
+// authMethod names how requests authenticate: "api_key" or "basic".
+func (c *Client) authMethod() string {
+	if c.apiKey() != "" {
+		return "api_key"
+	}
+	return "basic"
+}
+
+// reauthenticate reloads the API key after a 401 response and reports
+// whether it differs from sentKey, i.e. whether replaying can succeed.
+func (c *Client) reauthenticate(ctx context.Context, err error, sentKey string) bool {
+	var apiErr *apiHTTPError
+	if c.ReloadAPIKey == nil || !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnauthorized {
+		return false
+	}
+	key, reloadErr := c.ReloadAPIKey(ctx)
+	if reloadErr != nil {
+		tflog.Warn(ctx, "Could not reload API key after 401 response", map[string]interface{}{
+			"error": reloadErr.Error(),
+		})
+		return false
+	}
+
+	if key == sentKey {
+		return false
+	}
+	c.authMu.Lock()
+	c.APIKey = key
+	c.authMu.Unlock()
+	tflog.Info(ctx, "API key rejected with 401; replaying request with reloaded key")
+	return true
+}
+
+// isRetryableConflict reports whether err is a transient conflict: a 423
+// response, Poweradmin's "zone is locked" message on any status other than
+// an authentication failure, or a 409 that is not about the object already
+// existing (retrying those cannot succeed). Other messages mentioning a lock,
+// e.g. a locked account, are not retried.
+func isRetryableConflict(err error) bool {
+	var apiErr *apiHTTPError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	switch apiErr.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return false
+	case http.StatusLocked:
+		return true
+	}
+	msg := strings.ToLower(apiErr.Message)
+	if strings.Contains(msg, "zone is locked") || strings.Contains(msg, "zone locked") {
+		return true
+	}
+	return apiErr.StatusCode == http.StatusConflict && !strings.Contains(msg, "exist") && !IsCNAMEConflictError(err)
+}
+
+// Get performs a GET request.
+func (c *Client) Get(ctx context.Context, path string, result interface{}) error {
+	return c.request(ctx, http.MethodGet, path, nil, result)
 }
 
 // Post performs a POST request.
 func (c *Client) Post(ctx context.Context, path string, body interface{}, result interface{}) error {
-	resp, err := c.doRequest(ctx, http.MethodPost, path, body)
-	if err != nil {
-		return err
-	}
-	return c.parseResponse(ctx, resp, result)
+	return c.request(ctx, http.MethodPost, path, body, result)
 }
 
 // Put performs a PUT request.
 func (c *Client) Put(ctx context.Context, path string, body interface{}, result interface{}) error {
-	resp, err := c.doRequest(ctx, http.MethodPut, path, body)
-	if err != nil {
-		return err
-	}
-	return c.parseResponse(ctx, resp, result)
+	return c.request(ctx, http.MethodPut, path, body, result)
 }
 
 // Delete performs a DELETE request.
 func (c *Client) Delete(ctx context.Context, path string) error {
-	resp, err := c.doRequest(ctx, http.MethodDelete, path, nil)
-	if err != nil {
-		return err
-	}
-	return c.parseResponse(ctx, resp, nil)
+	return c.request(ctx, http.MethodDelete, path, nil, nil)
 }
 
 // DeleteWithBody sends a DELETE request with a JSON body.
 func (c *Client) DeleteWithBody(ctx context.Context, path string, body interface{}) error {
-	resp, err := c.doRequest(ctx, http.MethodDelete, path, body)
-	if err != nil {
-		return err
-	}
-	return c.parseResponse(ctx, resp, nil)
+	return c.request(ctx, http.MethodDelete, path, body, nil)
 }
 
 // apiHTTPError is a non-2xx API response carrying the status code, so callers
@@ -316,6 +1110,36 @@ func (e *apiHTTPError) Error() string {
 	return fmt.Sprintf("API error (HTTP %d): %s", e.StatusCode, e.Message)
 }
 
+// readOnlyError is returned instead of sending a write while the provider
+// is configured with read_only = true.
+type readOnlyError struct {
+	Method string
+	Path   string
+}
+
+func (e *readOnlyError) Error() string {
+	return fmt.Sprintf("the provider is configured with read_only = true and refuses to change Poweradmin (%s %s); "+
+		"remove read_only from the provider block to apply changes", e.Method, e.Path)
+}
+
+// IsReadOnlyError checks if an error is a write refused by read_only mode.
+func IsReadOnlyError(err error) bool {
+	var roErr *readOnlyError
+	return errors.As(err, &roErr)
+}
+
+// IsCNAMEConflictError checks if an error is the API refusing a write
+// because a CNAME would share its name with other records.
+func IsCNAMEConflictError(err error) bool {
+	var apiErr *apiHTTPError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode < 400 || apiErr.StatusCode >= 500 {
+		return false
+	}
+	msg := strings.ToLower(apiErr.Message)
+	return strings.Contains(msg, "cname") &&
+		(strings.Contains(msg, "exist") || strings.Contains(msg, "conflict"))
+}
+
 // IsNotFoundError checks if an error is a 404 Not Found API response.
 func IsNotFoundError(err error) bool {
 	var apiErr *apiHTTPError
@@ -0,0 +1,109 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// errCircuitOpen is returned without contacting the API while the circuit
+// breaker is open.
+var errCircuitOpen = errors.New("circuit breaker open")
+
+// circuitBreakerCooldown is how long requests fail fast once the breaker
+// opens; afterwards a single probe request is sent, and its outcome closes or
+// reopens the breaker.
+const circuitBreakerCooldown = 30 * time.Second
+
+// defaultCircuitBreakerThreshold tolerates a short outage that retries ride
+// out, while stopping an apply of hundreds of resources early.
+const defaultCircuitBreakerThreshold = 10
+
+// circuitBreaker fails requests fast after threshold consecutive hard
+// errors (transport failures and 5xx responses), so resources sharing one
+// Client stop retrying a failing API independently. A zero threshold
+// disables it.
+type circuitBreaker struct {
+	threshold int
+	cooldown  time.Duration // circuitBreakerCooldown when zero
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+	probing   bool // a probe request is in flight after the cooldown
+	lastErr   error
+}
+
+// allow returns an error while the breaker is open. Once the cooldown has
+// passed it admits one probe request, reported by probe, and keeps failing
+// the others fast until the probe is recorded.
+func (b *circuitBreaker) allow() (probe bool, err error) {
+	if b.threshold <= 0 {
+		return false, nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.failures < b.threshold {
+		return false, nil
+	}
+	if !b.probing && !time.Now().Before(b.openUntil) {
+		b.probing = true
+		return true, nil
+	}
+	// Kept identical while the breaker stays open, so Terraform shows the
+	// diagnostics of all failing resources as one
+	return false, fmt.Errorf("%w after %d consecutive API failures; last error: %v", errCircuitOpen, b.threshold, b.lastErr)
+}
+
+// record counts the outcome of a request that was sent: hard errors open
+// the breaker at the threshold, anything else closes it. A failed probe
+// reopens the breaker for another cooldown; a canceled one lets the next
+// request probe instead.
+func (b *circuitBreaker) record(err error, probe bool) {
+	if b.threshold <= 0 {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if probe {
+		b.probing = false
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+	}
+	if !isHardAPIError(err) {
+		b.failures = 0
+		return
+	}
+	b.failures++
+	b.lastErr = err
+	if b.failures >= b.threshold {
+		cooldown := b.cooldown
+		if cooldown <= 0 {
+			cooldown = circuitBreakerCooldown
+		}
+		b.openUntil = time.Now().Add(cooldown)
+	}
+}
+
+// isHardAPIError reports whether err means the API itself is failing rather
+// than rejecting this request: the server could not be reached or answered
+// with a 5xx status. A locked zone is a busy API, not a failing one.
+func isHardAPIError(err error) bool {
+	if err == nil || errors.Is(err, errCircuitOpen) || isRetryableConflict(err) {
+		return false
+	}
+	var apiErr *apiHTTPError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode >= http.StatusInternalServerError
+	}
+	var urlErr *url.Error
+	return errors.As(err, &urlErr) && !errors.Is(err, context.Canceled)
+}
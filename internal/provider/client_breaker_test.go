@@ -0,0 +1,115 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	calls := 0
+	failing := true
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if failing {
+			respondError(t, w, http.StatusInternalServerError, "database unavailable")
+			return
+		}
+		respondJSON(t, w, nil)
+	})
+	client.ConflictRetryTimeout = 0
+	client.breaker.threshold = 3
+	client.breaker.cooldown = 50 * time.Millisecond
+
+	for i := 0; i < 3; i++ {
+		if err := client.Get(context.Background(), "zones", nil); err == nil || errors.Is(err, errCircuitOpen) {
+			t.Fatalf("request %d: expected the API error, got %v", i+1, err)
+		}
+	}
+
+	err := client.Get(context.Background(), "zones", nil)
+	if !errors.Is(err, errCircuitOpen) {
+		t.Fatalf("expected the breaker to open, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "3 consecutive API failures") || !strings.Contains(err.Error(), "database unavailable") {
+		t.Errorf("expected the threshold and last error in %q", err)
+	}
+	if again := client.Get(context.Background(), "zones", nil); again == nil || again.Error() != err.Error() {
+		t.Errorf("expected the same error while open, so diagnostics deduplicate, got %v", again)
+	}
+	if calls != 3 {
+		t.Errorf("expected no request while open, got %d calls", calls)
+	}
+
+	// After the cooldown one probe is sent; its failure reopens the breaker
+	time.Sleep(60 * time.Millisecond)
+	if err := client.Get(context.Background(), "zones", nil); err == nil || errors.Is(err, errCircuitOpen) {
+		t.Fatalf("expected the probe to reach the API, got %v", err)
+	}
+	if err := client.Get(context.Background(), "zones", nil); !errors.Is(err, errCircuitOpen) {
+		t.Fatalf("expected a failed probe to reopen the breaker, got %v", err)
+	}
+	if calls != 4 {
+		t.Errorf("expected only the probe to be sent, got %d calls", calls)
+	}
+
+	// A successful probe closes the breaker
+	time.Sleep(60 * time.Millisecond)
+	failing = false
+	if err := client.Get(context.Background(), "zones", nil); err != nil {
+		t.Fatalf("expected the probe to succeed after the cooldown, got %v", err)
+	}
+	if client.breaker.failures != 0 {
+		t.Errorf("expected success to reset the failure count, got %d", client.breaker.failures)
+	}
+	if err := client.Get(context.Background(), "zones", nil); err != nil {
+		t.Errorf("expected requests to resume, got %v", err)
+	}
+}
+
+func TestCircuitBreaker_SingleProbe(t *testing.T) {
+	b := &circuitBreaker{threshold: 1, cooldown: time.Millisecond}
+	b.record(&apiHTTPError{StatusCode: http.StatusBadGateway, Message: "bad gateway"}, false)
+	time.Sleep(5 * time.Millisecond)
+
+	probe, err := b.allow()
+	if !probe || err != nil {
+		t.Fatalf("expected a probe after the cooldown, got %v, %v", probe, err)
+	}
+	if probe, err := b.allow(); probe || !errors.Is(err, errCircuitOpen) {
+		t.Errorf("expected other requests to fail fast during the probe, got %v, %v", probe, err)
+	}
+
+	// A canceled probe decides nothing; the next request probes instead
+	b.record(context.Canceled, true)
+	if probe, err := b.allow(); !probe || err != nil {
+		t.Errorf("expected a new probe after a canceled one, got %v, %v", probe, err)
+	}
+}
+
+func TestIsHardAPIError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"server error", &apiHTTPError{StatusCode: http.StatusBadGateway, Message: "bad gateway"}, true},
+		{"client error", &apiHTTPError{StatusCode: http.StatusNotFound, Message: "not found"}, false},
+		{"zone locked", &apiHTTPError{StatusCode: http.StatusServiceUnavailable, Message: "zone is locked"}, false},
+		{"read only", &readOnlyError{Method: http.MethodPost, Path: "zones"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isHardAPIError(tt.err); got != tt.want {
+				t.Errorf("isHardAPIError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
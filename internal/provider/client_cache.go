@@ -0,0 +1,218 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// zoneRRSetSnapshot is one zone's RRSet list, fetched at most once while it
+// stays in the cache. done is closed when rrsets/err are set.
+type zoneRRSetSnapshot struct {
+	done   chan struct{}
+	rrsets []RRSet
+	err    error
+}
+
+// zoneRRSetCache holds per-zone RRSet snapshots for the lifetime of the
+// provider process, i.e. a single Terraform run.
+type zoneRRSetCache struct {
+	mu    sync.Mutex
+	zones map[int64]*zoneRRSetSnapshot
+}
+
+// snapshot returns the zone's RRSets, fetching them on first use. Concurrent
+// callers for the same zone share one request.
+func (c *zoneRRSetCache) snapshot(ctx context.Context, zoneID int64, fetch func() ([]RRSet, error)) ([]RRSet, error) {
+	c.mu.Lock()
+	if c.zones == nil {
+		c.zones = make(map[int64]*zoneRRSetSnapshot)
+	}
+	snap, ok := c.zones[zoneID]
+	if !ok {
+		snap = &zoneRRSetSnapshot{done: make(chan struct{})}
+		c.zones[zoneID] = snap
+	}
+	c.mu.Unlock()
+
+	if !ok {
+		tflog.Debug(ctx, "Fetching zone RRSets for read cache", map[string]interface{}{
+			"zone_id": zoneID,
+		})
+		snap.rrsets, snap.err = fetch()
+		close(snap.done)
+		if snap.err != nil {
+			// Don't pin a transient failure for the rest of the run
+			c.invalidate(zoneID, snap)
+		}
+		return snap.rrsets, snap.err
+	}
+
+	select {
+	case <-snap.done:
+		return snap.rrsets, snap.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// invalidate drops the zone's snapshot. When only is non-nil the snapshot is
+// dropped only if it is still that one.
+func (c *zoneRRSetCache) invalidate(zoneID int64, only *zoneRRSetSnapshot) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if only != nil && c.zones[zoneID] != only {
+		return
+	}
+	delete(c.zones, zoneID)
+}
+
+// invalidateZoneCache drops the cached snapshot of the zone a write to path
+// touches, so reads after the write see it.
+func (c *Client) invalidateZoneCache(path string) {
+	if !c.CacheZoneReads {
+		return
+	}
+	rest, ok := strings.CutPrefix(strings.TrimLeft(path, "/"), "zones/")
+	if !ok {
+		return
+	}
+	idPart, _, _ := strings.Cut(rest, "/")
+	idPart, _, _ = strings.Cut(idPart, "?")
+	zoneID, err := strconv.ParseInt(idPart, 10, 64)
+	if err != nil {
+		return
+	}
+	c.rrsetCache.invalidate(zoneID, nil)
+}
+
+// GetRRSetCached is GetRRSet served from a per-run snapshot of the zone when
+// cache_zone_reads is enabled. RRSets missing from the snapshot fall back to
+// GetRRSet, so a name spelled differently than the API lists it still
+// resolves and a genuine 404 is still reported as one.
+func (c *Client) GetRRSetCached(ctx context.Context, zoneID int64, name, recordType string) (*RRSet, error) {
+	if !c.CacheZoneReads {
+		return c.GetRRSet(ctx, zoneID, name, recordType)
+	}
+
+	rrsets, err := c.rrsetCache.snapshot(ctx, zoneID, func() ([]RRSet, error) {
+		return c.ListRRSets(ctx, zoneID, "")
+	})
+	if err != nil {
+		if IsNotFoundError(err) {
+			return nil, err
+		}
+		tflog.Debug(ctx, "Zone read cache unavailable, reading RRSet directly", map[string]interface{}{
+			"zone_id": zoneID,
+			"error":   err.Error(),
+		})
+		return c.GetRRSet(ctx, zoneID, name, recordType)
+	}
+
+	// The zone name is only needed to match FQDN against relative names
+	zoneName, _ := c.GetZoneName(ctx, zoneID)
+	for i := range rrsets {
+		if strings.EqualFold(rrsets[i].Type, recordType) && rrsetNamesEqual(rrsets[i].Name, name, zoneName) {
+			rrset := rrsets[i]
+			return &rrset, nil
+		}
+	}
+	return c.GetRRSet(ctx, zoneID, name, recordType)
+}
+
+// rrsetNamesEqual compares owner names in relative, FQDN or "@" form.
+func rrsetNamesEqual(a, b, zoneName string) bool {
+	return canonicalOwnerName(a, zoneName) == canonicalOwnerName(b, zoneName)
+}
+
+func canonicalOwnerName(name, zoneName string) string {
+	name = canonicalDNSName(name)
+	zoneName = canonicalDNSName(zoneName)
+	if name == "" || name == "@" || (zoneName != "" && name == zoneName) {
+		return "@"
+	}
+	if zoneName != "" {
+		name = strings.TrimSuffix(name, "."+zoneName)
+	}
+	return name
+}
+
+// listCache holds a listing, e.g. of all zones, for the lifetime of the
+// provider process, i.e. a single Terraform run.
+type listCache[T any] struct {
+	mu   sync.Mutex
+	snap *listSnapshot[T]
+}
+
+// listSnapshot is one listing, fetched at most once while it stays in the
+// cache. done is closed when items/err are set.
+type listSnapshot[T any] struct {
+	done  chan struct{}
+	items []T
+	err   error
+}
+
+// snapshot returns the listing, fetching it on first use. Concurrent callers
+// share one listing.
+func (c *listCache[T]) snapshot(ctx context.Context, fetch func() ([]T, error)) ([]T, error) {
+	c.mu.Lock()
+	snap := c.snap
+	fetching := snap == nil
+	if fetching {
+		snap = &listSnapshot[T]{done: make(chan struct{})}
+		c.snap = snap
+	}
+	c.mu.Unlock()
+
+	if fetching {
+		snap.items, snap.err = fetch()
+		close(snap.done)
+		if snap.err != nil {
+			c.invalidate(snap)
+		}
+		return snap.items, snap.err
+	}
+
+	select {
+	case <-snap.done:
+		return snap.items, snap.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// invalidate drops the listing. When only is non-nil the listing is dropped
+// only if it is still that one.
+func (c *listCache[T]) invalidate(only *listSnapshot[T]) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if only != nil && c.snap != only {
+		return
+	}
+	c.snap = nil
+}
+
+// ListZonesCached is ListZones served from one listing per run, so resolving
+// the FQDN of every record costs a single listing. Creating or deleting a
+// zone through the client drops the listing, so a zone created in the same
+// apply is found.
+func (c *Client) ListZonesCached(ctx context.Context) ([]Zone, error) {
+	return c.zoneList.snapshot(ctx, func() ([]Zone, error) {
+		return c.ListZones(ctx)
+	})
+}
+
+// ListUsersCached is ListUsers served from one listing per run, so checking
+// the username of every planned user costs a single listing. Creating,
+// updating or deleting a user through the client drops the listing.
+func (c *Client) ListUsersCached(ctx context.Context) ([]User, error) {
+	return c.userList.snapshot(ctx, func() ([]User, error) {
+		return c.ListUsers(ctx)
+	})
+}
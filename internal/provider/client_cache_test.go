@@ -0,0 +1,136 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+// newCachingTestClient serves zone 1 (example.com) with a www A RRSet and
+// counts the requests made per path.
+func newCachingTestClient(t *testing.T) (*Client, map[string]int) {
+	t.Helper()
+	calls := map[string]int{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls[r.Method+" "+r.URL.Path]++
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/zones/1/rrsets":
+			respondJSON(t, w, RRSetListResponse{RRSets: []RRSet{
+				{Name: "www.example.com", Type: "A", TTL: 300, Records: []RRSetRecord{{Content: "192.0.2.1"}}},
+				{Name: "@", Type: "MX", TTL: 3600, Records: []RRSetRecord{{Content: "mail.example.com", Priority: 10}}},
+			}})
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/zones/1":
+			respondJSON(t, w, ZoneResponse{Zone: Zone{ID: 1, Name: "example.com", Type: "MASTER"}})
+		case r.Method == http.MethodPut && r.URL.Path == "/api/v2/zones/1/rrsets":
+			respondJSON(t, w, nil)
+		default:
+			respondError(t, w, http.StatusNotFound, "RRSet not found")
+		}
+	})
+	client.CacheZoneReads = true
+	return client, calls
+}
+
+func TestGetRRSetCached_ListsZoneOnce(t *testing.T) {
+	client, calls := newCachingTestClient(t)
+	ctx := context.Background()
+
+	for _, tc := range []struct{ name, recordType string }{
+		{"www", "A"},
+		{"www.example.com.", "a"},
+		{"@", "MX"},
+		{"example.com", "MX"},
+	} {
+		rrset, err := client.GetRRSetCached(ctx, 1, tc.name, tc.recordType)
+		if err != nil {
+			t.Fatalf("GetRRSetCached(%q, %q) error = %v", tc.name, tc.recordType, err)
+		}
+		if rrset.TTL == 0 {
+			t.Errorf("GetRRSetCached(%q, %q) returned empty RRSet", tc.name, tc.recordType)
+		}
+	}
+	if n := calls["GET /api/v2/zones/1/rrsets"]; n != 1 {
+		t.Errorf("expected 1 zone listing, got %d", n)
+	}
+}
+
+func TestGetRRSetCached_MissFallsBackToGet(t *testing.T) {
+	client, calls := newCachingTestClient(t)
+
+	_, err := client.GetRRSetCached(context.Background(), 1, "missing", "TXT")
+	if !IsNotFoundError(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+	if n := calls["GET /api/v2/zones/1/rrsets/missing/TXT"]; n != 1 {
+		t.Errorf("expected direct lookup on cache miss, got %d", n)
+	}
+}
+
+func TestGetRRSetCached_WriteInvalidatesZone(t *testing.T) {
+	client, calls := newCachingTestClient(t)
+	ctx := context.Background()
+
+	if _, err := client.GetRRSetCached(ctx, 1, "www", "A"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.UpdateRRSet(ctx, 1, map[string]interface{}{"name": "www", "type": "A"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.GetRRSetCached(ctx, 1, "www", "A"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n := calls["GET /api/v2/zones/1/rrsets"]; n != 2 {
+		t.Errorf("expected zone listing to be refetched after a write, got %d listings", n)
+	}
+}
+
+func TestGetRRSetCached_Disabled(t *testing.T) {
+	client, calls := newCachingTestClient(t)
+	client.CacheZoneReads = false
+
+	_, err := client.GetRRSetCached(context.Background(), 1, "www", "A")
+	if !IsNotFoundError(err) {
+		t.Fatalf("expected the direct lookup's not found error, got %v", err)
+	}
+	if n := calls["GET /api/v2/zones/1/rrsets"]; n != 0 {
+		t.Errorf("expected no zone listing with the cache disabled, got %d", n)
+	}
+}
+
+func TestListZonesCached_CreateZoneInvalidates(t *testing.T) {
+	calls := map[string]int{}
+	var zones []Zone
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls[r.Method+" "+r.URL.Path]++
+		switch r.Method {
+		case http.MethodGet:
+			respondJSON(t, w, ZoneListResponse{Zones: zones})
+		case http.MethodPost:
+			zones = append(zones, Zone{ID: 2, Name: "sub.example.com"})
+			respondJSON(t, w, CreateZoneResponse{ZoneID: 2})
+		}
+	})
+	zones = []Zone{{ID: 1, Name: "example.com"}}
+	ctx := context.Background()
+
+	for _, fqdn := range []string{"www.sub.example.com", "mail.sub.example.com"} {
+		zone, _, err := resolveRecordFQDN(ctx, client, fqdn)
+		if err != nil || zone == nil || zone.ID != 1 {
+			t.Fatalf("resolveRecordFQDN(%q) = %+v, %v, want zone 1", fqdn, zone, err)
+		}
+	}
+	if n := calls["GET /api/v2/zones"]; n != 1 {
+		t.Errorf("expected 1 zone listing, got %d", n)
+	}
+
+	if _, err := client.CreateZone(ctx, CreateZoneRequest{Name: "sub.example.com"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	zone, name, err := resolveRecordFQDN(ctx, client, "www.sub.example.com")
+	if err != nil || zone == nil || zone.ID != 2 || name != "www" {
+		t.Errorf("expected the new zone to own the record, got %+v, %q, %v", zone, name, err)
+	}
+}
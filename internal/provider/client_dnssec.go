@@ -0,0 +1,172 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// DNSSECKey represents a DNSSEC key of a zone, as PowerDNS reports its
+// cryptokeys.
+type DNSSECKey struct {
+	ID        int      `json:"id"`
+	KeyType   string   `json:"keytype"` // ksk, zsk or csk
+	Active    bool     `json:"active"`
+	Published bool     `json:"published"`
+	DNSKey    string   `json:"dnskey,omitempty"`
+	DS        []string `json:"ds,omitempty"`
+	Algorithm string   `json:"algorithm,omitempty"`
+	Bits      int      `json:"bits,omitempty"`
+}
+
+// DNSSECKeyListResponse represents the response from listing DNSSEC keys.
+type DNSSECKeyListResponse struct {
+	Keys []DNSSECKey `json:"keys"`
+}
+
+// ListDNSSECKeys lists the DNSSEC keys of a zone.
+func (c *Client) ListDNSSECKeys(ctx context.Context, zoneID int64) ([]DNSSECKey, error) {
+	path := fmt.Sprintf("zones/%d/dnssec/keys", zoneID)
+	var result DNSSECKeyListResponse
+	err := c.withPowerDNSFallback(ctx, "list DNSSEC keys",
+		func() error { return c.Get(ctx, path, &result) },
+		func() error { return c.powerDNSRequest(ctx, http.MethodGet, zoneID, "/cryptokeys", nil, &result.Keys) },
+	)
+	if err != nil {
+		return nil, err
+	}
+	return result.Keys, nil
+}
+
+// DNSSECKeyResponse represents the response for a single DNSSEC key.
+type DNSSECKeyResponse struct {
+	Key DNSSECKey `json:"key"`
+}
+
+// CreateDNSSECKeyRequest represents the request to create a DNSSEC key.
+// The server picks its default algorithm and size when they are omitted.
+type CreateDNSSECKeyRequest struct {
+	KeyType   string `json:"keytype"`
+	Active    bool   `json:"active"`
+	Published bool   `json:"published"`
+	Algorithm string `json:"algorithm,omitempty"`
+	Bits      int    `json:"bits,omitempty"`
+}
+
+// UpdateDNSSECKeyRequest represents the request to activate, deactivate,
+// publish or unpublish a DNSSEC key.
+type UpdateDNSSECKeyRequest struct {
+	Active    *bool `json:"active,omitempty"`
+	Published *bool `json:"published,omitempty"`
+}
+
+// GetDNSSECKey retrieves a DNSSEC key of a zone.
+func (c *Client) GetDNSSECKey(ctx context.Context, zoneID int64, keyID int) (*DNSSECKey, error) {
+	path := fmt.Sprintf("zones/%d/dnssec/keys/%d", zoneID, keyID)
+	var result DNSSECKeyResponse
+	err := c.withPowerDNSFallback(ctx, "get DNSSEC key",
+		func() error { return c.Get(ctx, path, &result) },
+		func() error {
+			return c.powerDNSRequest(ctx, http.MethodGet, zoneID, fmt.Sprintf("/cryptokeys/%d", keyID), nil, &result.Key)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Key, nil
+}
+
+// CreateDNSSECKey generates a DNSSEC key for a zone.
+func (c *Client) CreateDNSSECKey(ctx context.Context, zoneID int64, req CreateDNSSECKeyRequest) (*DNSSECKey, error) {
+	path := fmt.Sprintf("zones/%d/dnssec/keys", zoneID)
+	var result DNSSECKeyResponse
+	err := c.withPowerDNSFallback(ctx, "create DNSSEC key",
+		func() error { return c.Post(ctx, path, req, &result) },
+		func() error { return c.powerDNSRequest(ctx, http.MethodPost, zoneID, "/cryptokeys", req, &result.Key) },
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Key, nil
+}
+
+// UpdateDNSSECKey changes whether a DNSSEC key is active and published.
+func (c *Client) UpdateDNSSECKey(ctx context.Context, zoneID int64, keyID int, req UpdateDNSSECKeyRequest) error {
+	path := fmt.Sprintf("zones/%d/dnssec/keys/%d", zoneID, keyID)
+	return c.withPowerDNSFallback(ctx, "update DNSSEC key",
+		func() error { return c.Put(ctx, path, req, nil) },
+		func() error {
+			return c.powerDNSRequest(ctx, http.MethodPut, zoneID, fmt.Sprintf("/cryptokeys/%d", keyID), req, nil)
+		},
+	)
+}
+
+// DeleteDNSSECKey removes a DNSSEC key from a zone.
+func (c *Client) DeleteDNSSECKey(ctx context.Context, zoneID int64, keyID int) error {
+	path := fmt.Sprintf("zones/%d/dnssec/keys/%d", zoneID, keyID)
+	return c.withPowerDNSFallback(ctx, "delete DNSSEC key",
+		func() error { return c.Delete(ctx, path) },
+		func() error {
+			return c.powerDNSRequest(ctx, http.MethodDelete, zoneID, fmt.Sprintf("/cryptokeys/%d", keyID), nil, nil)
+		},
+	)
+}
+
+// DSRecord is the delegation signer record a parent zone publishes for a
+// key signing key.
+type DSRecord struct {
+	KeyTag     int
+	Algorithm  int
+	DigestType int
+	Digest     string
+}
+
+// String formats the record as DS presentation data.
+func (r DSRecord) String() string {
+	return fmt.Sprintf("%d %d %d %s", r.KeyTag, r.Algorithm, r.DigestType, r.Digest)
+}
+
+// parseDSRecord parses DS presentation data ("keytag algorithm digest_type
+// digest"). Digests split into several words are joined and upper-cased, as
+// registrars expect.
+func parseDSRecord(value string) (DSRecord, error) {
+	fields := strings.Fields(value)
+	if len(fields) < 4 {
+		return DSRecord{}, fmt.Errorf("invalid DS record %q: expected key tag, algorithm, digest type and digest", value)
+	}
+	var numbers [3]int
+	for i := range numbers {
+		n, err := strconv.Atoi(fields[i])
+		if err != nil || n < 0 {
+			return DSRecord{}, fmt.Errorf("invalid DS record %q: %q is not a number", value, fields[i])
+		}
+		numbers[i] = n
+	}
+	return DSRecord{
+		KeyTag:     numbers[0],
+		Algorithm:  numbers[1],
+		DigestType: numbers[2],
+		Digest:     strings.ToUpper(strings.Join(fields[3:], "")),
+	}, nil
+}
+
+// parseDNSKEY splits DNSKEY presentation data ("flags protocol algorithm
+// public_key") into its flags, algorithm and base64 public key.
+func parseDNSKEY(value string) (flags, algorithm int, publicKey string, err error) {
+	fields := strings.Fields(value)
+	if len(fields) < 4 {
+		return 0, 0, "", fmt.Errorf("invalid DNSKEY %q: expected flags, protocol, algorithm and public key", value)
+	}
+	if flags, err = strconv.Atoi(fields[0]); err != nil {
+		return 0, 0, "", fmt.Errorf("invalid DNSKEY %q: flags %q is not a number", value, fields[0])
+	}
+	if algorithm, err = strconv.Atoi(fields[2]); err != nil {
+		return 0, 0, "", fmt.Errorf("invalid DNSKEY %q: algorithm %q is not a number", value, fields[2])
+	}
+	return flags, algorithm, strings.Join(fields[3:], ""), nil
+}
@@ -0,0 +1,92 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// endpointCooldown is how long an endpoint that could not be reached is
+// tried only after the others.
+const endpointCooldown = 30 * time.Second
+
+// endpointHealth tracks which API endpoints recently failed to connect.
+type endpointHealth struct {
+	cooldown time.Duration // endpointCooldown when zero
+
+	mu        sync.Mutex
+	downUntil map[string]time.Time
+}
+
+// markDown moves baseURL behind the healthy endpoints for the cooldown.
+func (h *endpointHealth) markDown(baseURL string) {
+	cooldown := h.cooldown
+	if cooldown <= 0 {
+		cooldown = endpointCooldown
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.downUntil == nil {
+		h.downUntil = map[string]time.Time{}
+	}
+	h.downUntil[baseURL] = time.Now().Add(cooldown)
+}
+
+// markUp records that baseURL answered.
+func (h *endpointHealth) markUp(baseURL string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.downUntil, baseURL)
+}
+
+// isDown reports whether baseURL failed within the cooldown.
+func (h *endpointHealth) isDown(baseURL string) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return time.Now().Before(h.downUntil[baseURL])
+}
+
+// endpointOrder returns the base URLs to try for a request: api_url and the
+// failover URLs in configured order, with endpoints that recently failed to
+// connect moved last, so they are still tried when every endpoint is down.
+func (c *Client) endpointOrder() []string {
+	all := append([]string{c.BaseURL}, c.FailoverURLs...)
+	healthy := make([]string, 0, len(all))
+	var down []string
+	for _, baseURL := range all {
+		if c.endpoints.isDown(baseURL) {
+			down = append(down, baseURL)
+		} else {
+			healthy = append(healthy, baseURL)
+		}
+	}
+	return append(healthy, down...)
+}
+
+// isConnectionError reports whether err from sending a request with ctx
+// means the endpoint could not be reached or did not answer in time, as
+// opposed to the caller giving up.
+func isConnectionError(ctx context.Context, err error) bool {
+	var urlErr *url.Error
+	return errors.As(err, &urlErr) && ctx.Err() == nil
+}
+
+// canFailOver reports whether a request that failed with a connection error
+// may be sent to another endpoint. Idempotent requests always may; others
+// only when the connection was never established, since the first server
+// may have processed a request whose response was lost.
+func canFailOver(method string, err error) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
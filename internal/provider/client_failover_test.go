@@ -0,0 +1,130 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// hangUpServer accepts connections and closes them once a request arrives,
+// without answering.
+func hangUpServer(t *testing.T, requests *atomic.Int32) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err == nil {
+			_ = conn.Close()
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestFailover(t *testing.T) {
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	down.Close()
+	var hungUp atomic.Int32
+	hangUp := hangUpServer(t, &hungUp)
+
+	var served atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		served.Add(1)
+		if r.Method == http.MethodPost {
+			respondJSON(t, w, map[string]int64{"zone_id": 2})
+			return
+		}
+		respondJSON(t, w, ZoneResponse{Zone: Zone{ID: 1, Name: "example.com"}})
+	})
+	healthy := client.BaseURL
+	ctx := context.Background()
+
+	// A refused connection fails over, and the endpoint is skipped afterwards
+	client.BaseURL = down.URL
+	client.FailoverURLs = []string{healthy}
+	if _, err := client.GetZone(ctx, 1); err != nil {
+		t.Fatalf("expected the request to fail over, got %v", err)
+	}
+	if got := client.endpointOrder(); !slices.Equal(got, []string{healthy, down.URL}) {
+		t.Errorf("expected the unreachable endpoint last, got %v", got)
+	}
+	if _, err := client.CreateZone(ctx, CreateZoneRequest{Name: "example.org", Type: "MASTER"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if served.Load() != 2 {
+		t.Errorf("expected 2 requests on the healthy endpoint, got %d", served.Load())
+	}
+
+	// A dropped connection fails reads over but not creates, which the
+	// first server may have processed
+	client.endpoints = endpointHealth{}
+	client.BaseURL = hangUp.URL
+	if _, err := client.GetZone(ctx, 1); err != nil {
+		t.Fatalf("expected the read to fail over, got %v", err)
+	}
+	client.endpoints = endpointHealth{}
+	if _, err := client.CreateZone(ctx, CreateZoneRequest{Name: "example.org", Type: "MASTER"}); err == nil {
+		t.Fatal("expected the create to fail without failing over")
+	}
+	if hungUp.Load() != 2 || served.Load() != 3 {
+		t.Errorf("expected 2 dropped and 3 served requests, got %d and %d", hungUp.Load(), served.Load())
+	}
+
+	// Every endpoint down reports the last error
+	client.FailoverURLs = []string{down.URL}
+	client.BaseURL = down.URL
+	if _, err := client.GetZone(ctx, 1); err == nil {
+		t.Fatal("expected an error with every endpoint down")
+	}
+}
+
+func TestEndpointHealth_Cooldown(t *testing.T) {
+	client := &Client{BaseURL: "https://a.example.com", FailoverURLs: []string{"https://b.example.com"}}
+	client.endpoints.cooldown = 10 * time.Millisecond
+
+	client.endpoints.markDown("https://a.example.com")
+	if got := client.endpointOrder(); got[0] != "https://b.example.com" {
+		t.Errorf("expected the failed endpoint last, got %v", got)
+	}
+	time.Sleep(20 * time.Millisecond)
+	if got := client.endpointOrder(); got[0] != "https://a.example.com" {
+		t.Errorf("expected api_url first again after the cooldown, got %v", got)
+	}
+}
+
+func TestNewClient_FailoverAPIURLs(t *testing.T) {
+	tests := []struct {
+		name    string
+		urls    []string
+		want    []string
+		wantErr bool
+	}{
+		{"normalized", []string{"https://dns2.example.com/", "https://dns.example.com", "https://dns2.example.com"}, []string{"https://dns2.example.com"}, false},
+		{"not a URL", []string{"dns2.example.com"}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			urls, _ := types.ListValueFrom(context.Background(), types.StringType, tt.urls)
+			client, err := NewClient(context.Background(), &PoweradminProviderModel{
+				ApiUrl:          types.StringValue("https://dns.example.com"),
+				ApiKey:          types.StringValue("test-key"),
+				FailoverAPIURLs: urls,
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewClient() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !slices.Equal(client.FailoverURLs, tt.want) {
+				t.Errorf("FailoverURLs = %v, want %v", client.FailoverURLs, tt.want)
+			}
+		})
+	}
+}
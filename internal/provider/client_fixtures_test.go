@@ -0,0 +1,742 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// serveFixture writes testdata/fixtures/<name>.json, a response body recorded
+// from the API, with the given status code.
+func serveFixture(t *testing.T, w http.ResponseWriter, status int, name string) {
+	t.Helper()
+	body, err := os.ReadFile(filepath.Join("testdata", "fixtures", name+".json"))
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(body)
+}
+
+// fixtureClient returns a client whose server answers each "METHOD path"
+// in routes with the named fixture, and fails the test on other requests.
+// The JSON bodies the server receives are recorded in bodies under the same
+// "METHOD path" keys.
+func fixtureClient(t *testing.T, routes map[string]string) (client *Client, bodies func() map[string]interface{}) {
+	t.Helper()
+	var mu sync.Mutex
+	received := make(map[string]interface{})
+	client = newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		key := r.Method + " " + strings.TrimPrefix(r.URL.RequestURI(), "/api/v2/")
+		name, ok := routes[key]
+		if !ok {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.RequestURI())
+			respondError(t, w, http.StatusNotFound, "Endpoint not found")
+			return
+		}
+		if raw, _ := io.ReadAll(r.Body); len(raw) > 0 {
+			var body interface{}
+			if err := json.Unmarshal(raw, &body); err != nil {
+				t.Errorf("%s: request body is not JSON: %v", key, err)
+			}
+			mu.Lock()
+			received[key] = body
+			mu.Unlock()
+		}
+		if name == "" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		serveFixture(t, w, http.StatusOK, name)
+	})
+	return client, func() map[string]interface{} {
+		mu.Lock()
+		defer mu.Unlock()
+		return received
+	}
+}
+
+// TestClientFixtures decodes recorded API responses through each client
+// method, so a change in the API's response shape fails here rather than as
+// empty attributes in state. For write methods it also checks the JSON body
+// sent with each request; requests missing from bodies must not send one.
+func TestClientFixtures(t *testing.T) {
+	tests := []struct {
+		name   string
+		routes map[string]string
+		bodies map[string]string
+		call   func(ctx context.Context, c *Client) (interface{}, error)
+		want   interface{}
+	}{
+		{
+			name:   "ListZones",
+			routes: map[string]string{"GET zones": "zones_list"},
+			call:   func(ctx context.Context, c *Client) (interface{}, error) { return c.ListZones(ctx) },
+			want: []Zone{
+				{ID: 1, Name: "example.com", Type: "MASTER", Description: "Primary domain", SOASerial: 2026101601, DNSSECSigned: true},
+				{ID: 2, Name: "example.net", Type: "SLAVE", Masters: "192.0.2.1,192.0.2.2", Account: "ops", SOASerial: 2026010101},
+				{ID: 3, Name: "2.0.192.in-addr.arpa", Type: "NATIVE"},
+			},
+		},
+		{
+			name:   "GetZone",
+			routes: map[string]string{"GET zones/1": "zone_get"},
+			call:   func(ctx context.Context, c *Client) (interface{}, error) { return c.GetZone(ctx, 1) },
+			want:   &Zone{ID: 1, Name: "example.com", Type: "MASTER", Description: "Primary domain", SOASerial: 2026101601, DNSSECSigned: true},
+		},
+		{
+			name:   "FindZoneByName",
+			routes: map[string]string{"GET zones": "zones_list"},
+			call:   func(ctx context.Context, c *Client) (interface{}, error) { return c.FindZoneByName(ctx, "example.net") },
+			want:   &Zone{ID: 2, Name: "example.net", Type: "SLAVE", Masters: "192.0.2.1,192.0.2.2", Account: "ops", SOASerial: 2026010101},
+		},
+		{
+			name:   "CreateZone",
+			routes: map[string]string{"POST zones": "zone_create"},
+			bodies: map[string]string{"POST zones": `{"name": "example.org", "type": "MASTER"}`},
+			call: func(ctx context.Context, c *Client) (interface{}, error) {
+				return c.CreateZone(ctx, CreateZoneRequest{Name: "example.org", Type: "MASTER"})
+			},
+			want: 12,
+		},
+		{
+			name:   "UpdateZone with empty body reads back",
+			routes: map[string]string{"PUT zones/1": "", "GET zones/1": "zone_get"},
+			bodies: map[string]string{"PUT zones/1": `{}`},
+			call: func(ctx context.Context, c *Client) (interface{}, error) {
+				return c.UpdateZone(ctx, 1, UpdateZoneRequest{})
+			},
+			want: &Zone{ID: 1, Name: "example.com", Type: "MASTER", Description: "Primary domain", SOASerial: 2026101601, DNSSECSigned: true},
+		},
+		{
+			name:   "ListZoneOwners",
+			routes: map[string]string{"GET zones/1/owners": "zone_owners"},
+			call:   func(ctx context.Context, c *Client) (interface{}, error) { return c.ListZoneOwners(ctx, 1) },
+			want:   []ZoneOwner{{UserID: 1, Username: "admin"}, {UserID: 7, Username: "jane"}},
+		},
+		{
+			name:   "GetZoneDefaults",
+			routes: map[string]string{"GET config/dns": "zone_defaults"},
+			call:   func(ctx context.Context, c *Client) (interface{}, error) { return c.GetZoneDefaults(ctx) },
+			want: &ZoneDefaults{
+				Hostmaster:  "hostmaster.example.com",
+				Nameservers: []string{"ns1.example.com", "ns2.example.net"},
+				TTL:         86400,
+				RecordTypes: []string{"A", "AAAA", "CNAME", "MX", "NS", "PTR", "SOA", "SRV", "TXT"},
+			},
+		},
+		{
+			name:   "ListRRSets splits embedded priorities",
+			routes: map[string]string{"GET zones/1/rrsets": "rrsets_list"},
+			call:   func(ctx context.Context, c *Client) (interface{}, error) { return c.ListRRSets(ctx, 1, "") },
+			want: []RRSet{
+				{Name: "example.com", Type: "MX", TTL: 3600, Records: []RRSetRecord{
+					{Content: "mail.example.com", Priority: 10},
+					{Content: "backup.example.com", Disabled: true, Priority: 20},
+				}},
+				{Name: "www.example.com", Type: "A", TTL: 300, Records: []RRSetRecord{{Content: "192.0.2.10"}}},
+			},
+		},
+		{
+			name:   "GetRRSet",
+			routes: map[string]string{"GET zones/1/rrsets/example.com/TXT": "rrset_get"},
+			call: func(ctx context.Context, c *Client) (interface{}, error) {
+				return c.GetRRSet(ctx, 1, "example.com", "TXT")
+			},
+			want: &RRSet{Name: "example.com", Type: "TXT", TTL: 3600, Records: []RRSetRecord{{Content: `"v=spf1 mx -all"`}}},
+		},
+		{
+			name:   "ListRecords with numeric IDs",
+			routes: map[string]string{"GET zones/1/records?type=A": "records_list"},
+			call:   func(ctx context.Context, c *Client) (interface{}, error) { return c.ListRecords(ctx, 1, "A") },
+			want: []Record{
+				{ID: "101", ZoneID: 1, Name: "www.example.com", Type: "A", Content: "192.0.2.10", TTL: 300},
+				{ID: "102", ZoneID: 1, Name: "example.com", Type: "MX", Content: "mail.example.com", TTL: 3600, Priority: 10},
+			},
+		},
+		{
+			name:   "GetRecord with an encoded ID",
+			routes: map[string]string{"GET zones/1/records/ZXhhbXBsZS5jb20vQS93d3c": "record_get"},
+			call: func(ctx context.Context, c *Client) (interface{}, error) {
+				return c.GetRecord(ctx, 1, "ZXhhbXBsZS5jb20vQS93d3c")
+			},
+			want: &Record{ID: "ZXhhbXBsZS5jb20vQS93d3c", ZoneID: 1, Name: "www.example.com", Type: "A", Content: "192.0.2.10", TTL: 300, Disabled: true},
+		},
+		{
+			name:   "ListUsers",
+			routes: map[string]string{"GET users": "users_list"},
+			call:   func(ctx context.Context, c *Client) (interface{}, error) { return c.ListUsers(ctx) },
+			want: []User{
+				{UserID: 1, Username: "admin", Fullname: "Administrator", Email: "admin@example.com", Active: true, PermTempl: 1, IsAdmin: true, ZoneCount: 3, CreatedAt: "2025-01-10 08:00:00"},
+				{UserID: 7, Username: "jane", Fullname: "Jane Doe", Email: "jane@example.com", Description: "DNS operator", PermTempl: 2, UseLdap: true},
+			},
+		},
+		{
+			name:   "CreateUser reads back the user",
+			routes: map[string]string{"POST users": "user_create", "GET users/7": "user_get"},
+			bodies: map[string]string{"POST users": `{"username": "jane", "password": "secret", "fullname": "", "email": "", "active": false}`},
+			call: func(ctx context.Context, c *Client) (interface{}, error) {
+				return c.CreateUser(ctx, CreateUserRequest{Username: "jane", Password: "secret"})
+			},
+			want: &User{
+				UserID: 7, Username: "jane", Fullname: "Jane Doe", Email: "jane@example.com", Description: "DNS operator",
+				Active: true, PermTempl: 2, UseLdap: true, Permissions: []string{"zone_content_view_own", "zone_content_edit_own"},
+				ZoneCount: 2, CreatedAt: "2025-03-02 14:21:09", UpdatedAt: "2026-09-30 11:05:47",
+			},
+		},
+		{
+			name:   "GetUser",
+			routes: map[string]string{"GET users/7": "user_get"},
+			call:   func(ctx context.Context, c *Client) (interface{}, error) { return c.GetUser(ctx, 7) },
+			want: &User{
+				UserID: 7, Username: "jane", Fullname: "Jane Doe", Email: "jane@example.com", Description: "DNS operator",
+				Active: true, PermTempl: 2, UseLdap: true, Permissions: []string{"zone_content_view_own", "zone_content_edit_own"},
+				ZoneCount: 2, CreatedAt: "2025-03-02 14:21:09", UpdatedAt: "2026-09-30 11:05:47",
+			},
+		},
+		{
+			name:   "FindUserByUsername",
+			routes: map[string]string{"GET users": "users_list"},
+			call:   func(ctx context.Context, c *Client) (interface{}, error) { return c.FindUserByUsername(ctx, "admin") },
+			want:   &User{UserID: 1, Username: "admin", Fullname: "Administrator", Email: "admin@example.com", Active: true, PermTempl: 1, IsAdmin: true, ZoneCount: 3, CreatedAt: "2025-01-10 08:00:00"},
+		},
+		{
+			name:   "ListUserPermissions",
+			routes: map[string]string{"GET users/7/permissions": "permissions_list"},
+			call:   func(ctx context.Context, c *Client) (interface{}, error) { return c.ListUserPermissions(ctx, 7) },
+			want: []Permission{
+				{ID: 41, Name: "zone_master_add", Descr: "User is allowed to add new master zones."},
+				{ID: 43, Name: "zone_content_view_own", Descr: "User is allowed to see the content and meta data of zones he owns."},
+			},
+		},
+		{
+			name:   "GetPermission",
+			routes: map[string]string{"GET permissions/41": "permission_get"},
+			call:   func(ctx context.Context, c *Client) (interface{}, error) { return c.GetPermission(ctx, 41) },
+			want:   &Permission{ID: 41, Name: "zone_master_add", Descr: "User is allowed to add new master zones."},
+		},
+		{
+			name:   "FindPermissionByName",
+			routes: map[string]string{"GET permissions": "permissions_list"},
+			call: func(ctx context.Context, c *Client) (interface{}, error) {
+				return c.FindPermissionByName(ctx, "zone_content_view_own")
+			},
+			want: &Permission{ID: 43, Name: "zone_content_view_own", Descr: "User is allowed to see the content and meta data of zones he owns."},
+		},
+		{
+			name:   "ListPermissionTemplates",
+			routes: map[string]string{"GET permission-templates": "permission_templates_list"},
+			call:   func(ctx context.Context, c *Client) (interface{}, error) { return c.ListPermissionTemplates(ctx) },
+			want: []PermissionTemplate{
+				{ID: 1, Name: "Administrator", Descr: "Administrator template with full rights."},
+				{ID: 2, Name: "Zone Manager", Descr: "Manages own zones"},
+			},
+		},
+		{
+			name:   "ListPermissionTemplateItems",
+			routes: map[string]string{"GET permission-templates/2/items": "permissions_list"},
+			call: func(ctx context.Context, c *Client) (interface{}, error) {
+				return c.ListPermissionTemplateItems(ctx, 2)
+			},
+			want: []Permission{
+				{ID: 41, Name: "zone_master_add", Descr: "User is allowed to add new master zones."},
+				{ID: 43, Name: "zone_content_view_own", Descr: "User is allowed to see the content and meta data of zones he owns."},
+			},
+		},
+		{
+			name:   "ListGroups",
+			routes: map[string]string{"GET groups": "groups_list"},
+			call:   func(ctx context.Context, c *Client) (interface{}, error) { return c.ListGroups(ctx) },
+			want:   []Group{{ID: 3, Name: "platform", Description: "Platform team", PermTemplID: 2, MemberCount: 4, ZoneCount: 12, CreatedAt: "2026-02-01 10:00:00"}},
+		},
+		{
+			name:   "GetGroup",
+			routes: map[string]string{"GET groups/3": "group_get"},
+			call:   func(ctx context.Context, c *Client) (interface{}, error) { return c.GetGroup(ctx, 3) },
+			want:   &Group{ID: 3, Name: "platform", Description: "Platform team", PermTemplID: 2, MemberCount: 4, ZoneCount: 12, CreatedAt: "2026-02-01 10:00:00"},
+		},
+		{
+			name:   "FindGroupByName",
+			routes: map[string]string{"GET groups": "groups_list"},
+			call:   func(ctx context.Context, c *Client) (interface{}, error) { return c.FindGroupByName(ctx, "platform") },
+			want:   &Group{ID: 3, Name: "platform", Description: "Platform team", PermTemplID: 2, MemberCount: 4, ZoneCount: 12, CreatedAt: "2026-02-01 10:00:00"},
+		},
+		{
+			name:   "CreateGroup",
+			routes: map[string]string{"POST groups": "group_create"},
+			bodies: map[string]string{"POST groups": `{"name": "security", "perm_templ_id": 2}`},
+			call: func(ctx context.Context, c *Client) (interface{}, error) {
+				return c.CreateGroup(ctx, CreateGroupRequest{Name: "security", PermTemplID: 2})
+			},
+			want: 5,
+		},
+		{
+			name:   "ListGroupMembers",
+			routes: map[string]string{"GET groups/3/members": "group_members"},
+			call:   func(ctx context.Context, c *Client) (interface{}, error) { return c.ListGroupMembers(ctx, 3) },
+			want:   []GroupMember{{UserID: 7, Username: "jane", JoinedAt: "2026-02-03 09:30:00"}},
+		},
+		{
+			name:   "ListGroupZones",
+			routes: map[string]string{"GET groups/3/zones": "group_zones"},
+			call:   func(ctx context.Context, c *Client) (interface{}, error) { return c.ListGroupZones(ctx, 3) },
+			want:   []GroupZone{{ZoneID: 1, ZoneName: "example.com", ZoneType: "MASTER"}},
+		},
+		{
+			name:   "ListZoneTemplates",
+			routes: map[string]string{"GET zone-templates": "zone_templates_list"},
+			call:   func(ctx context.Context, c *Client) (interface{}, error) { return c.ListZoneTemplates(ctx) },
+			want: []ZoneTemplate{
+				{ID: 1, Name: "Default", Description: "Default template", Owner: 1, ZonesLinked: 2},
+				{ID: 2, Name: "Global", Description: "Global template", IsGlobal: true, ZonesLinked: 5},
+			},
+		},
+		{
+			name:   "GetZoneTemplate",
+			routes: map[string]string{"GET zone-templates/2": "zone_template_get"},
+			call:   func(ctx context.Context, c *Client) (interface{}, error) { return c.GetZoneTemplate(ctx, 2) },
+			want: &ZoneTemplate{ID: 2, Name: "Global", Description: "Global template", IsGlobal: true, ZonesLinked: 5, Records: []ZoneTemplateRecord{
+				{ID: 11, Name: "[ZONE]", Type: "NS", Content: "ns1.example.com", TTL: 86400},
+				{ID: 12, Name: "[ZONE]", Type: "MX", Content: "mail.[ZONE]", TTL: 3600, Priority: 10},
+			}},
+		},
+		{
+			name:   "FindZoneTemplateByName",
+			routes: map[string]string{"GET zone-templates": "zone_templates_list"},
+			call: func(ctx context.Context, c *Client) (interface{}, error) {
+				return c.FindZoneTemplateByName(ctx, "Global")
+			},
+			want: &ZoneTemplate{ID: 2, Name: "Global", Description: "Global template", IsGlobal: true, ZonesLinked: 5},
+		},
+		{
+			name:   "CreateZoneTemplate",
+			routes: map[string]string{"POST zone-templates": "zone_template_create"},
+			bodies: map[string]string{"POST zone-templates": `{"name": "web", "description": "", "is_global": false}`},
+			call: func(ctx context.Context, c *Client) (interface{}, error) {
+				return c.CreateZoneTemplate(ctx, CreateZoneTemplateRequest{Name: "web"})
+			},
+			want: 9,
+		},
+		{
+			name:   "ListZoneTemplateRecords",
+			routes: map[string]string{"GET zone-templates/2/records": "zone_template_records"},
+			call:   func(ctx context.Context, c *Client) (interface{}, error) { return c.ListZoneTemplateRecords(ctx, 2) },
+			want:   []ZoneTemplateRecord{{ID: 11, Name: "[ZONE]", Type: "NS", Content: "ns1.example.com", TTL: 86400}},
+		},
+		{
+			name:   "ListZoneLogs",
+			routes: map[string]string{"GET zones/1/logs?limit=10": "zone_logs"},
+			call:   func(ctx context.Context, c *Client) (interface{}, error) { return c.ListZoneLogs(ctx, 1, 10) },
+			want:   []ZoneLogEntry{{ID: 501, ZoneID: 1, User: "jane", Event: "record_add name:www type:A content:192.0.2.10", CreatedAt: "2026-10-15 17:42:03"}},
+		},
+		{
+			name:   "ListUserLogs",
+			routes: map[string]string{"GET user-logs?username=jane": "user_logs"},
+			call: func(ctx context.Context, c *Client) (interface{}, error) {
+				return c.ListUserLogs(ctx, UserLogFilter{Username: "jane"})
+			},
+			want: []UserLogEntry{{ID: 880, User: "jane", Event: "login_success ip:198.51.100.7", CreatedAt: "2026-10-16 07:58:12"}},
+		},
+		{
+			name:   "GetZoneMetadata",
+			routes: map[string]string{"GET zones/1/metadata/ALSO-NOTIFY": "zone_metadata"},
+			call: func(ctx context.Context, c *Client) (interface{}, error) {
+				return c.GetZoneMetadata(ctx, 1, "ALSO-NOTIFY")
+			},
+			want: []string{"192.0.2.53", "198.51.100.53:5300"},
+		},
+		{
+			name:   "ListDNSSECKeys",
+			routes: map[string]string{"GET zones/1/dnssec/keys": "dnssec_keys_list"},
+			call:   func(ctx context.Context, c *Client) (interface{}, error) { return c.ListDNSSECKeys(ctx, 1) },
+			want: []DNSSECKey{{
+				ID: 1, KeyType: "csk", Active: true, Published: true,
+				DNSKey:    "257 3 13 mdsswUyr3DPW132mOi8V9xESWE8jTo0dxCjjnopKl+GqJxpVXckHAeF+KkxLbxILfDLUT0rAK9iUzy1L53eKGQ==",
+				DS:        []string{"2371 13 2 c9a4f9e3b1d0fc6e4e5b8f2e2a1d9c8b7a6f5e4d3c2b1a09f8e7d6c5b4a39281"},
+				Algorithm: "ECDSAP256SHA256", Bits: 256,
+			}},
+		},
+		{
+			name:   "GetDNSSECKey",
+			routes: map[string]string{"GET zones/1/dnssec/keys/4": "dnssec_key_get"},
+			call:   func(ctx context.Context, c *Client) (interface{}, error) { return c.GetDNSSECKey(ctx, 1, 4) },
+			want:   &DNSSECKey{ID: 4, KeyType: "zsk", Published: true, Algorithm: "ECDSAP256SHA256", Bits: 256},
+		},
+		{
+			name:   "DeleteZone",
+			routes: map[string]string{"DELETE zones/1": ""},
+			call:   func(ctx context.Context, c *Client) (interface{}, error) { return nil, c.DeleteZone(ctx, 1) },
+		},
+		{
+			name:   "AddZoneOwner",
+			routes: map[string]string{"POST zones/1/owners": ""},
+			bodies: map[string]string{"POST zones/1/owners": `{"user_id": 7}`},
+			call:   func(ctx context.Context, c *Client) (interface{}, error) { return nil, c.AddZoneOwner(ctx, 1, 7) },
+		},
+		{
+			name:   "RemoveZoneOwner",
+			routes: map[string]string{"DELETE zones/1/owners/7": ""},
+			call:   func(ctx context.Context, c *Client) (interface{}, error) { return nil, c.RemoveZoneOwner(ctx, 1, 7) },
+		},
+		{
+			name:   "CreateRecord sends the punycode name",
+			routes: map[string]string{"POST zones/1/records": "record_create"},
+			bodies: map[string]string{"POST zones/1/records": `{"name": "xn--bcher-kva.example.com", "type": "A", "content": "192.0.2.20", "ttl": 3600}`},
+			call: func(ctx context.Context, c *Client) (interface{}, error) {
+				return c.CreateRecord(ctx, 1, CreateRecordRequest{Name: "bücher.example.com", Type: "A", Content: "192.0.2.20", TTL: 3600})
+			},
+			want: &Record{ID: "103", ZoneID: 1, Name: "xn--bcher-kva.example.com", Type: "A", Content: "192.0.2.20", TTL: 3600},
+		},
+		{
+			name:   "UpdateRecord",
+			routes: map[string]string{"PUT zones/1/records/ZXhhbXBsZS5jb20vQS93d3c": "record_get"},
+			bodies: map[string]string{"PUT zones/1/records/ZXhhbXBsZS5jb20vQS93d3c": `{"ttl": 300, "disabled": true}`},
+			call: func(ctx context.Context, c *Client) (interface{}, error) {
+				ttl, disabled := 300, true
+				return c.UpdateRecord(ctx, 1, "ZXhhbXBsZS5jb20vQS93d3c", UpdateRecordRequest{TTL: &ttl, Disabled: &disabled})
+			},
+			want: &Record{ID: "ZXhhbXBsZS5jb20vQS93d3c", ZoneID: 1, Name: "www.example.com", Type: "A", Content: "192.0.2.10", TTL: 300, Disabled: true},
+		},
+		{
+			name: "UpdateRecord with empty body reads back",
+			routes: map[string]string{
+				"PUT zones/1/records/ZXhhbXBsZS5jb20vQS93d3c": "",
+				"GET zones/1/records/ZXhhbXBsZS5jb20vQS93d3c": "record_get",
+			},
+			bodies: map[string]string{"PUT zones/1/records/ZXhhbXBsZS5jb20vQS93d3c": `{"content": "192.0.2.10"}`},
+			call: func(ctx context.Context, c *Client) (interface{}, error) {
+				return c.UpdateRecord(ctx, 1, "ZXhhbXBsZS5jb20vQS93d3c", UpdateRecordRequest{Content: "192.0.2.10"})
+			},
+			want: &Record{ID: "ZXhhbXBsZS5jb20vQS93d3c", ZoneID: 1, Name: "www.example.com", Type: "A", Content: "192.0.2.10", TTL: 300, Disabled: true},
+		},
+		{
+			name:   "DeleteRecord",
+			routes: map[string]string{"DELETE zones/1/records/101": ""},
+			call:   func(ctx context.Context, c *Client) (interface{}, error) { return nil, c.DeleteRecord(ctx, 1, "101") },
+		},
+		{
+			name:   "CreateRRSet sends the punycode name and splits priorities",
+			routes: map[string]string{"PUT zones/1/rrsets": "rrset_put"},
+			bodies: map[string]string{"PUT zones/1/rrsets": `{
+				"name": "xn--bcher-kva.example.com", "type": "MX", "ttl": 3600,
+				"records": [{"content": "mail.example.com", "disabled": false, "priority": 10}]
+			}`},
+			call: func(ctx context.Context, c *Client) (interface{}, error) {
+				return c.CreateRRSet(ctx, 1, map[string]interface{}{
+					"name": "bücher.example.com", "type": "MX", "ttl": 3600,
+					"records": []map[string]interface{}{{"content": "mail.example.com", "disabled": false, "priority": 10}},
+				})
+			},
+			want: &RRSet{Name: "xn--bcher-kva.example.com", Type: "MX", TTL: 3600, Records: []RRSetRecord{{Content: "mail.example.com", Priority: 10}}},
+		},
+		{
+			name:   "UpdateRRSet with empty body",
+			routes: map[string]string{"PUT zones/1/rrsets": ""},
+			bodies: map[string]string{"PUT zones/1/rrsets": `{"name": "www.example.com", "type": "A", "ttl": 300, "records": [{"content": "192.0.2.10"}]}`},
+			call: func(ctx context.Context, c *Client) (interface{}, error) {
+				return c.UpdateRRSet(ctx, 1, map[string]interface{}{
+					"name": "www.example.com", "type": "A", "ttl": 300,
+					"records": []map[string]interface{}{{"content": "192.0.2.10"}},
+				})
+			},
+			want: (*RRSet)(nil),
+		},
+		{
+			name:   "DeleteRRSet escapes the punycode name",
+			routes: map[string]string{"DELETE zones/1/rrsets/xn--bcher-kva.example.com/MX": ""},
+			call: func(ctx context.Context, c *Client) (interface{}, error) {
+				return nil, c.DeleteRRSet(ctx, 1, "bücher.example.com", "MX")
+			},
+		},
+		{
+			name:   "UpdateUser reads back the user",
+			routes: map[string]string{"PUT users/7": "", "GET users/7": "user_get"},
+			bodies: map[string]string{"PUT users/7": `{"fullname": "Jane Doe", "description": "DNS operator", "active": true}`},
+			call: func(ctx context.Context, c *Client) (interface{}, error) {
+				description, active := "DNS operator", true
+				return c.UpdateUser(ctx, 7, UpdateUserRequest{Fullname: "Jane Doe", Description: &description, Active: &active})
+			},
+			want: &User{
+				UserID: 7, Username: "jane", Fullname: "Jane Doe", Email: "jane@example.com", Description: "DNS operator",
+				Active: true, PermTempl: 2, UseLdap: true, Permissions: []string{"zone_content_view_own", "zone_content_edit_own"},
+				ZoneCount: 2, CreatedAt: "2025-03-02 14:21:09", UpdatedAt: "2026-09-30 11:05:47",
+			},
+		},
+		{
+			name:   "DeleteUser",
+			routes: map[string]string{"DELETE users/7": ""},
+			call:   func(ctx context.Context, c *Client) (interface{}, error) { return nil, c.DeleteUser(ctx, 7, nil) },
+		},
+		{
+			name:   "DeleteUser transferring zones",
+			routes: map[string]string{"DELETE users/7": ""},
+			bodies: map[string]string{"DELETE users/7": `{"transfer_to_user_id": 1}`},
+			call: func(ctx context.Context, c *Client) (interface{}, error) {
+				transferTo := 1
+				return nil, c.DeleteUser(ctx, 7, &transferTo)
+			},
+		},
+		{
+			name:   "AssignUserPermission",
+			routes: map[string]string{"POST users/7/permissions": ""},
+			bodies: map[string]string{"POST users/7/permissions": `{"permission_id": 41}`},
+			call: func(ctx context.Context, c *Client) (interface{}, error) {
+				return nil, c.AssignUserPermission(ctx, 7, 41)
+			},
+		},
+		{
+			name:   "RevokeUserPermission",
+			routes: map[string]string{"DELETE users/7/permissions/41": ""},
+			call: func(ctx context.Context, c *Client) (interface{}, error) {
+				return nil, c.RevokeUserPermission(ctx, 7, 41)
+			},
+		},
+		{
+			name:   "AddPermissionTemplateItem",
+			routes: map[string]string{"POST permission-templates/2/items": ""},
+			bodies: map[string]string{"POST permission-templates/2/items": `{"permission_id": 43}`},
+			call: func(ctx context.Context, c *Client) (interface{}, error) {
+				return nil, c.AddPermissionTemplateItem(ctx, 2, 43)
+			},
+		},
+		{
+			name:   "RemovePermissionTemplateItem",
+			routes: map[string]string{"DELETE permission-templates/2/items/43": ""},
+			call: func(ctx context.Context, c *Client) (interface{}, error) {
+				return nil, c.RemovePermissionTemplateItem(ctx, 2, 43)
+			},
+		},
+		{
+			name:   "UpdateGroup",
+			routes: map[string]string{"PUT groups/3": "group_update"},
+			bodies: map[string]string{"PUT groups/3": `{"name": "platform-eng", "description": ""}`},
+			call: func(ctx context.Context, c *Client) (interface{}, error) {
+				description := ""
+				return c.UpdateGroup(ctx, 3, UpdateGroupRequest{Name: "platform-eng", Description: &description})
+			},
+			want: &Group{ID: 3, Name: "platform-eng", PermTemplID: 2, MemberCount: 4, ZoneCount: 12, CreatedAt: "2026-02-01 10:00:00"},
+		},
+		{
+			name:   "DeleteGroup",
+			routes: map[string]string{"DELETE groups/3": ""},
+			call:   func(ctx context.Context, c *Client) (interface{}, error) { return nil, c.DeleteGroup(ctx, 3) },
+		},
+		{
+			name:   "AddGroupMember",
+			routes: map[string]string{"POST groups/3/members": ""},
+			bodies: map[string]string{"POST groups/3/members": `{"user_id": 7}`},
+			call:   func(ctx context.Context, c *Client) (interface{}, error) { return nil, c.AddGroupMember(ctx, 3, 7) },
+		},
+		{
+			name:   "RemoveGroupMember",
+			routes: map[string]string{"DELETE groups/3/members/7": ""},
+			call:   func(ctx context.Context, c *Client) (interface{}, error) { return nil, c.RemoveGroupMember(ctx, 3, 7) },
+		},
+		{
+			name:   "AssignZoneToGroup",
+			routes: map[string]string{"POST groups/3/zones": ""},
+			bodies: map[string]string{"POST groups/3/zones": `{"zone_id": 1}`},
+			call:   func(ctx context.Context, c *Client) (interface{}, error) { return nil, c.AssignZoneToGroup(ctx, 3, 1) },
+		},
+		{
+			name:   "UnassignZoneFromGroup",
+			routes: map[string]string{"DELETE groups/3/zones/1": ""},
+			call: func(ctx context.Context, c *Client) (interface{}, error) {
+				return nil, c.UnassignZoneFromGroup(ctx, 3, 1)
+			},
+		},
+		{
+			name:   "UpdateZoneTemplate",
+			routes: map[string]string{"PUT zone-templates/9": ""},
+			bodies: map[string]string{"PUT zone-templates/9": `{"name": "web", "description": "Web servers", "is_global": true}`},
+			call: func(ctx context.Context, c *Client) (interface{}, error) {
+				return nil, c.UpdateZoneTemplate(ctx, 9, UpdateZoneTemplateRequest{Name: "web", Description: "Web servers", IsGlobal: true})
+			},
+		},
+		{
+			name:   "DeleteZoneTemplate",
+			routes: map[string]string{"DELETE zone-templates/9": ""},
+			call:   func(ctx context.Context, c *Client) (interface{}, error) { return nil, c.DeleteZoneTemplate(ctx, 9) },
+		},
+		{
+			name:   "CreateZoneTemplateRecord",
+			routes: map[string]string{"POST zone-templates/9/records": "zone_template_record_create"},
+			bodies: map[string]string{"POST zone-templates/9/records": `{"name": "www.[ZONE]", "type": "A", "content": "192.0.2.10", "ttl": 300}`},
+			call: func(ctx context.Context, c *Client) (interface{}, error) {
+				return c.CreateZoneTemplateRecord(ctx, 9, CreateZoneTemplateRecordRequest{Name: "www.[ZONE]", Type: "A", Content: "192.0.2.10", TTL: 300})
+			},
+			want: 21,
+		},
+		{
+			name:   "UpdateZoneTemplateRecord",
+			routes: map[string]string{"PUT zone-templates/9/records/21": ""},
+			bodies: map[string]string{"PUT zone-templates/9/records/21": `{"name": "www.[ZONE]", "type": "A", "content": "192.0.2.11", "ttl": 600}`},
+			call: func(ctx context.Context, c *Client) (interface{}, error) {
+				ttl := 600
+				return nil, c.UpdateZoneTemplateRecord(ctx, 9, 21, UpdateZoneTemplateRecordRequest{Name: "www.[ZONE]", Type: "A", Content: "192.0.2.11", TTL: &ttl})
+			},
+		},
+		{
+			name:   "DeleteZoneTemplateRecord",
+			routes: map[string]string{"DELETE zone-templates/9/records/21": ""},
+			call: func(ctx context.Context, c *Client) (interface{}, error) {
+				return nil, c.DeleteZoneTemplateRecord(ctx, 9, 21)
+			},
+		},
+		{
+			name:   "SetZoneMetadata",
+			routes: map[string]string{"PUT zones/1/metadata/ALSO-NOTIFY": ""},
+			bodies: map[string]string{"PUT zones/1/metadata/ALSO-NOTIFY": `{"kind": "ALSO-NOTIFY", "metadata": ["192.0.2.53"]}`},
+			call: func(ctx context.Context, c *Client) (interface{}, error) {
+				return nil, c.SetZoneMetadata(ctx, 1, "ALSO-NOTIFY", []string{"192.0.2.53"})
+			},
+		},
+		{
+			name:   "DeleteZoneMetadata",
+			routes: map[string]string{"DELETE zones/1/metadata/ALSO-NOTIFY": ""},
+			call: func(ctx context.Context, c *Client) (interface{}, error) {
+				return nil, c.DeleteZoneMetadata(ctx, 1, "ALSO-NOTIFY")
+			},
+		},
+		{
+			name:   "SetZoneTags sorts the values",
+			routes: map[string]string{"PUT zones/1/metadata/X-POWERADMIN-TAGS": ""},
+			bodies: map[string]string{"PUT zones/1/metadata/X-POWERADMIN-TAGS": `{"kind": "X-POWERADMIN-TAGS", "metadata": ["env=prod", "team=platform"]}`},
+			call: func(ctx context.Context, c *Client) (interface{}, error) {
+				return nil, c.SetZoneTags(ctx, 1, map[string]string{"team": "platform", "env": "prod"})
+			},
+		},
+		{
+			name:   "GetZoneAlsoNotify",
+			routes: map[string]string{"GET zones/1/metadata/ALSO-NOTIFY": "zone_metadata"},
+			call:   func(ctx context.Context, c *Client) (interface{}, error) { return c.GetZoneAlsoNotify(ctx, 1) },
+			want:   []string{"192.0.2.53", "198.51.100.53:5300"},
+		},
+		{
+			name:   "SetZoneAlsoNotify with no targets deletes the kind",
+			routes: map[string]string{"DELETE zones/1/metadata/ALSO-NOTIFY": ""},
+			call: func(ctx context.Context, c *Client) (interface{}, error) {
+				return nil, c.SetZoneAlsoNotify(ctx, 1, nil)
+			},
+		},
+		{
+			name:   "SetZoneDefaultTTL",
+			routes: map[string]string{"PUT zones/1/metadata/X-POWERADMIN-DEFAULT-TTL": ""},
+			bodies: map[string]string{"PUT zones/1/metadata/X-POWERADMIN-DEFAULT-TTL": `{"kind": "X-POWERADMIN-DEFAULT-TTL", "metadata": ["600"]}`},
+			call: func(ctx context.Context, c *Client) (interface{}, error) {
+				return nil, c.SetZoneDefaultTTL(ctx, 1, 600)
+			},
+		},
+		{
+			name:   "DeleteZoneDefaultTTL",
+			routes: map[string]string{"DELETE zones/1/metadata/X-POWERADMIN-DEFAULT-TTL": ""},
+			call:   func(ctx context.Context, c *Client) (interface{}, error) { return nil, c.DeleteZoneDefaultTTL(ctx, 1) },
+		},
+		{
+			name:   "CreateDNSSECKey",
+			routes: map[string]string{"POST zones/1/dnssec/keys": "dnssec_key_create"},
+			bodies: map[string]string{"POST zones/1/dnssec/keys": `{"keytype": "ksk", "active": true, "published": true}`},
+			call: func(ctx context.Context, c *Client) (interface{}, error) {
+				return c.CreateDNSSECKey(ctx, 1, CreateDNSSECKeyRequest{KeyType: "ksk", Active: true, Published: true})
+			},
+			want: &DNSSECKey{
+				ID: 5, KeyType: "ksk", Active: true, Published: true,
+				DNSKey:    "257 3 13 oJMRESz5E4gYzS/q6XDrvU1qMPYIjCWzJaOau8XNEZeqCYKD5ar0IRd8KqXXFJkqmVfRvMGPmM1x8fGAa2XhSA==",
+				DS:        []string{"31406 13 2 f78cf3344f72137235098ecbbd08947c2c9001c7f6a085a17f518b5d8f6b916d"},
+				Algorithm: "ECDSAP256SHA256", Bits: 256,
+			},
+		},
+		{
+			name:   "UpdateDNSSECKey",
+			routes: map[string]string{"PUT zones/1/dnssec/keys/4": ""},
+			bodies: map[string]string{"PUT zones/1/dnssec/keys/4": `{"active": true}`},
+			call: func(ctx context.Context, c *Client) (interface{}, error) {
+				active := true
+				return nil, c.UpdateDNSSECKey(ctx, 1, 4, UpdateDNSSECKeyRequest{Active: &active})
+			},
+		},
+		{
+			name:   "DeleteDNSSECKey",
+			routes: map[string]string{"DELETE zones/1/dnssec/keys/4": ""},
+			call:   func(ctx context.Context, c *Client) (interface{}, error) { return nil, c.DeleteDNSSECKey(ctx, 1, 4) },
+		},
+		{
+			name:   "NotifyZone sends no body",
+			routes: map[string]string{"PUT zones/1/notify": ""},
+			call:   func(ctx context.Context, c *Client) (interface{}, error) { return nil, c.NotifyZone(ctx, 1) },
+		},
+		{
+			name:   "RectifyZone sends no body",
+			routes: map[string]string{"PUT zones/1/rectify": ""},
+			call:   func(ctx context.Context, c *Client) (interface{}, error) { return nil, c.RectifyZone(ctx, 1) },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, bodies := fixtureClient(t, tt.routes)
+			got, err := tt.call(context.Background(), client)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got  %+v\nwant %+v", got, tt.want)
+			}
+			wantBodies := make(map[string]interface{}, len(tt.bodies))
+			for key, raw := range tt.bodies {
+				var body interface{}
+				if err := json.Unmarshal([]byte(raw), &body); err != nil {
+					t.Fatalf("%s: invalid expected body: %v", key, err)
+				}
+				wantBodies[key] = body
+			}
+			if gotBodies := bodies(); !reflect.DeepEqual(gotBodies, wantBodies) {
+				t.Errorf("request bodies\ngot  %v\nwant %v", gotBodies, wantBodies)
+			}
+		})
+	}
+}
+
+// TestClientFixtures_Errors checks that recorded error responses surface
+// their message and status.
+func TestClientFixtures_Errors(t *testing.T) {
+	tests := []struct {
+		fixture      string
+		status       int
+		wantNotFound bool
+		wantMessage  string
+	}{
+		{"error_not_found", http.StatusNotFound, true, "API error (HTTP 404): Zone not found"},
+		{"error_validation", http.StatusBadRequest, false, "API error (HTTP 400): Validation failed"},
+		{"error_unauthorized", http.StatusUnauthorized, false, "API error (HTTP 401): Invalid API key"},
+		{"error_forbidden", http.StatusForbidden, false, "API error (HTTP 403): You do not have permission to perform this action"},
+		{"error_success_false", http.StatusOK, false, "API operation failed: Zone name already exists"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				serveFixture(t, w, tt.status, tt.fixture)
+			})
+			_, err := client.GetZone(context.Background(), 1)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if err.Error() != tt.wantMessage {
+				t.Errorf("error = %q, want %q", err.Error(), tt.wantMessage)
+			}
+			if IsNotFoundError(err) != tt.wantNotFound {
+				t.Errorf("IsNotFoundError() = %v, want %v", IsNotFoundError(err), tt.wantNotFound)
+			}
+		})
+	}
+}
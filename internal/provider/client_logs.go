@@ -0,0 +1,52 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// ListZoneLogs retrieves the most recent change-log entries for a zone,
+// newest first. A limit of 0 leaves the page size to the server.
+func (c *Client) ListZoneLogs(ctx context.Context, zoneID int64, limit int64) ([]ZoneLogEntry, error) {
+	path := fmt.Sprintf("zones/%d/logs", zoneID)
+	if limit > 0 {
+		path += fmt.Sprintf("?limit=%d", limit)
+	}
+	var result ZoneLogListResponse
+	if err := c.Get(ctx, path, &result); err != nil {
+		return nil, err
+	}
+	return result.Logs, nil
+}
+
+// ListUserLogs retrieves user/login activity log entries matching filter,
+// newest first.
+func (c *Client) ListUserLogs(ctx context.Context, filter UserLogFilter) ([]UserLogEntry, error) {
+	query := url.Values{}
+	if filter.Username != "" {
+		query.Set("username", filter.Username)
+	}
+	if filter.Since != "" {
+		query.Set("since", filter.Since)
+	}
+	if filter.Until != "" {
+		query.Set("until", filter.Until)
+	}
+	if filter.Limit > 0 {
+		query.Set("limit", strconv.FormatInt(filter.Limit, 10))
+	}
+	path := "user-logs"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	var result UserLogListResponse
+	if err := c.Get(ctx, path, &result); err != nil {
+		return nil, err
+	}
+	return result.Logs, nil
+}
@@ -0,0 +1,66 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestListZoneLogs(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/zones/5/logs" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("limit"); got != "10" {
+			t.Errorf("expected limit=10, got %q", got)
+		}
+		respondJSON(t, w, ZoneLogListResponse{Logs: []ZoneLogEntry{
+			{ID: 2, ZoneID: 5, User: "admin", Event: "edit_record www A", CreatedAt: "2026-01-02T10:00:00Z"},
+			{ID: 1, ZoneID: 5, User: "admin", Event: "add_zone example.com", CreatedAt: "2026-01-01T09:00:00Z"},
+		}})
+	})
+
+	logs, err := client.ListZoneLogs(context.Background(), 5, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(logs) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(logs))
+	}
+	if logs[0].User != "admin" || logs[0].Event != "edit_record www A" {
+		t.Errorf("unexpected first entry: %+v", logs[0])
+	}
+}
+
+func TestListUserLogs(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/user-logs" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("username") != "alice" || q.Get("since") != "2026-01-01T00:00:00Z" || q.Get("limit") != "5" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+		if q.Has("until") {
+			t.Errorf("unset until must not be sent, got %q", q.Get("until"))
+		}
+		respondJSON(t, w, UserLogListResponse{Logs: []UserLogEntry{
+			{ID: 9, User: "alice", Event: "login success", CreatedAt: "2026-01-03T08:00:00Z"},
+		}})
+	})
+
+	logs, err := client.ListUserLogs(context.Background(), UserLogFilter{
+		Username: "alice",
+		Since:    "2026-01-01T00:00:00Z",
+		Limit:    5,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(logs) != 1 || logs[0].Event != "login success" {
+		t.Errorf("unexpected entries: %+v", logs)
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// GetZoneMetadata retrieves the values of one metadata kind for a zone.
+func (c *Client) GetZoneMetadata(ctx context.Context, zoneID int64, kind string) ([]string, error) {
+	path := fmt.Sprintf("zones/%d/metadata/%s", zoneID, url.PathEscape(kind))
+	var result ZoneMetadata
+	err := c.withPowerDNSFallback(ctx, "get metadata",
+		func() error { return c.Get(ctx, path, &result) },
+		func() error {
+			return c.powerDNSRequest(ctx, http.MethodGet, zoneID, "/metadata/"+url.PathEscape(kind), nil, &result)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return result.Metadata, nil
+}
+
+// SetZoneMetadata replaces all values of one metadata kind for a zone.
+func (c *Client) SetZoneMetadata(ctx context.Context, zoneID int64, kind string, values []string) error {
+	path := fmt.Sprintf("zones/%d/metadata/%s", zoneID, url.PathEscape(kind))
+	body := ZoneMetadata{Kind: kind, Metadata: values}
+	return c.withPowerDNSFallback(ctx, "set metadata",
+		func() error { return c.Put(ctx, path, body, nil) },
+		func() error {
+			return c.powerDNSRequest(ctx, http.MethodPut, zoneID, "/metadata/"+url.PathEscape(kind), body, nil)
+		},
+	)
+}
+
+// DeleteZoneMetadata removes all values of one metadata kind from a zone.
+func (c *Client) DeleteZoneMetadata(ctx context.Context, zoneID int64, kind string) error {
+	path := fmt.Sprintf("zones/%d/metadata/%s", zoneID, url.PathEscape(kind))
+	return c.withPowerDNSFallback(ctx, "delete metadata",
+		func() error { return c.Delete(ctx, path) },
+		func() error {
+			return c.powerDNSRequest(ctx, http.MethodDelete, zoneID, "/metadata/"+url.PathEscape(kind), nil, nil)
+		},
+	)
+}
+
+// zoneTagsMetadataKind is the custom metadata kind zone tags are kept in, one
+// "key=value" value per tag.
+const zoneTagsMetadataKind = "X-POWERADMIN-TAGS"
+
+// GetZoneTags returns the tags of a zone; a zone without the metadata kind
+// has no tags.
+func (c *Client) GetZoneTags(ctx context.Context, zoneID int64) (map[string]string, error) {
+	values, err := c.GetZoneMetadata(ctx, zoneID, zoneTagsMetadataKind)
+	if err != nil && !IsNotFoundError(err) {
+		return nil, err
+	}
+	tags := make(map[string]string, len(values))
+	for _, value := range values {
+		key, tagValue, _ := strings.Cut(value, "=")
+		tags[key] = tagValue
+	}
+	return tags, nil
+}
+
+// SetZoneTags replaces the tags of a zone; an empty map removes them.
+func (c *Client) SetZoneTags(ctx context.Context, zoneID int64, tags map[string]string) error {
+	if len(tags) == 0 {
+		err := c.DeleteZoneMetadata(ctx, zoneID, zoneTagsMetadataKind)
+		if IsNotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+	values := make([]string, 0, len(tags))
+	for key, value := range tags {
+		values = append(values, key+"="+value)
+	}
+	sort.Strings(values)
+	return c.SetZoneMetadata(ctx, zoneID, zoneTagsMetadataKind, values)
+}
+
+// GetZoneAlsoNotify returns the extra NOTIFY targets of a zone; a zone without
+// the ALSO-NOTIFY kind has none.
+func (c *Client) GetZoneAlsoNotify(ctx context.Context, zoneID int64) ([]string, error) {
+	values, err := c.GetZoneMetadata(ctx, zoneID, metadataAlsoNotify)
+	if err != nil && !IsNotFoundError(err) {
+		return nil, err
+	}
+	return values, nil
+}
+
+// SetZoneAlsoNotify replaces the extra NOTIFY targets of a zone; an empty list
+// removes them.
+func (c *Client) SetZoneAlsoNotify(ctx context.Context, zoneID int64, targets []string) error {
+	if len(targets) == 0 {
+		err := c.DeleteZoneMetadata(ctx, zoneID, metadataAlsoNotify)
+		if IsNotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+	return c.SetZoneMetadata(ctx, zoneID, metadataAlsoNotify, targets)
+}
+
+// zoneDefaultTTLMetadataKind is the custom metadata kind holding the TTL, in
+// seconds, that records in a zone get when they do not set one.
+const zoneDefaultTTLMetadataKind = "X-POWERADMIN-DEFAULT-TTL"
+
+// GetZoneDefaultTTL returns the default record TTL of a zone; ok is false
+// when the zone has none.
+func (c *Client) GetZoneDefaultTTL(ctx context.Context, zoneID int64) (ttl int64, ok bool, err error) {
+	values, err := c.GetZoneMetadata(ctx, zoneID, zoneDefaultTTLMetadataKind)
+	if err != nil {
+		if IsNotFoundError(err) {
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+	if len(values) == 0 {
+		return 0, false, nil
+	}
+	ttl, err = parseTTL(values[0])
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid %s metadata of zone %d: %w", zoneDefaultTTLMetadataKind, zoneID, err)
+	}
+	return ttl, true, nil
+}
+
+// SetZoneDefaultTTL sets the default record TTL of a zone.
+func (c *Client) SetZoneDefaultTTL(ctx context.Context, zoneID int64, ttl int64) error {
+	if err := c.SetZoneMetadata(ctx, zoneID, zoneDefaultTTLMetadataKind, []string{strconv.FormatInt(ttl, 10)}); err != nil {
+		return err
+	}
+	c.zoneRecordTTLs.Store(zoneID, ttl)
+	return nil
+}
+
+// DeleteZoneDefaultTTL removes the default record TTL of a zone; a zone
+// without one is left as is.
+func (c *Client) DeleteZoneDefaultTTL(ctx context.Context, zoneID int64) error {
+	err := c.DeleteZoneMetadata(ctx, zoneID, zoneDefaultTTLMetadataKind)
+	c.zoneRecordTTLs.Delete(zoneID)
+	if IsNotFoundError(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,63 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// maxConcurrentPages bounds how many pages of a listing are fetched at once,
+// so large installs are listed quickly without flooding the API.
+const maxConcurrentPages = 4
+
+// listAllPages fetches a paginated listing: the first page without a page
+// number, which servers that do not paginate also accept, then every further
+// page up to the reported last page concurrently. Items are returned in page
+// order.
+func listAllPages[T any](ctx context.Context, path string, query url.Values, get func(ctx context.Context, path string) ([]T, *Pagination, error)) ([]T, error) {
+	pagePath := func(page int) string {
+		q := url.Values{}
+		for k, v := range query {
+			q[k] = v
+		}
+		if page > 0 {
+			q.Set("page", strconv.Itoa(page))
+		}
+		if len(q) == 0 {
+			return path
+		}
+		return path + "?" + q.Encode()
+	}
+
+	items, pagination, err := get(ctx, pagePath(0))
+	if err != nil {
+		return nil, err
+	}
+	if pagination == nil || len(items) == 0 || pagination.CurrentPage >= pagination.LastPage {
+		return items, nil
+	}
+
+	pages := make([][]T, pagination.LastPage-pagination.CurrentPage)
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(maxConcurrentPages)
+	for i := range pages {
+		page := pagination.CurrentPage + 1 + i
+		g.Go(func() error {
+			var err error
+			pages[i], _, err = get(gctx, pagePath(page))
+			return err
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	for _, page := range pages {
+		items = append(items, page...)
+	}
+	return items, nil
+}
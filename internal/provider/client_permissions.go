@@ -42,3 +42,35 @@ func (c *Client) FindPermissionByName(ctx context.Context, name string) (*Permis
 
 	return nil, fmt.Errorf("permission not found: %s", name)
 }
+
+// ListPermissionTemplates retrieves all permission templates.
+func (c *Client) ListPermissionTemplates(ctx context.Context) ([]PermissionTemplate, error) {
+	var result PermissionTemplateListResponse
+	if err := c.Get(ctx, "permission-templates", &result); err != nil {
+		return nil, err
+	}
+	return result.PermissionTemplates, nil
+}
+
+// ListPermissionTemplateItems lists the permissions granted by a permission template.
+func (c *Client) ListPermissionTemplateItems(ctx context.Context, templateID int) ([]Permission, error) {
+	path := fmt.Sprintf("permission-templates/%d/items", templateID)
+	var result PermissionListResponse
+	if err := c.Get(ctx, path, &result); err != nil {
+		return nil, err
+	}
+	return result.Permissions, nil
+}
+
+// AddPermissionTemplateItem adds a permission to a permission template.
+func (c *Client) AddPermissionTemplateItem(ctx context.Context, templateID int, permissionID int) error {
+	path := fmt.Sprintf("permission-templates/%d/items", templateID)
+	req := PermissionTemplateItemRequest{PermissionID: permissionID}
+	return c.Post(ctx, path, req, nil)
+}
+
+// RemovePermissionTemplateItem removes a permission from a permission template.
+func (c *Client) RemovePermissionTemplateItem(ctx context.Context, templateID int, permissionID int) error {
+	path := fmt.Sprintf("permission-templates/%d/items/%d", templateID, permissionID)
+	return c.Delete(ctx, path)
+}
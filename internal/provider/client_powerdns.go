@@ -0,0 +1,154 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// powerDNSServerID is the server ID the PowerDNS HTTP API serves its own
+// zones under.
+const powerDNSServerID = "localhost"
+
+// isMissingEndpoint reports whether err means the Poweradmin API does not
+// implement the endpoint, so the operation may be passed through to PowerDNS.
+// A 404 is ambiguous; the PowerDNS API answers it again authoritatively.
+func isMissingEndpoint(err error) bool {
+	var apiErr *apiHTTPError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	switch apiErr.StatusCode {
+	case http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		return true
+	}
+	return false
+}
+
+// withPowerDNSFallback runs op against the Poweradmin API and, when it lacks
+// the endpoint and a PowerDNS API is configured, runs fallback instead.
+func (c *Client) withPowerDNSFallback(ctx context.Context, operation string, op, fallback func() error) error {
+	err := op()
+	if err == nil || c.PowerDNSAPIURL == "" || !isMissingEndpoint(err) {
+		return err
+	}
+	tflog.Debug(ctx, "Poweradmin API lacks the endpoint, using the PowerDNS API", map[string]interface{}{
+		"operation": operation,
+		"error":     err.Error(),
+	})
+	return fallback()
+}
+
+// powerDNSRequest sends a request to the PowerDNS API for the zone with the
+// given Poweradmin ID; path is relative to the zone, e.g. "/metadata/KIND".
+func (c *Client) powerDNSRequest(ctx context.Context, method string, zoneID int64, path string, body interface{}, result interface{}) error {
+	if method != http.MethodGet && c.ReadOnly {
+		return &readOnlyError{Method: method, Path: "powerdns" + path}
+	}
+
+	zoneName, err := c.GetZoneName(ctx, zoneID)
+	if err != nil {
+		return err
+	}
+	if !strings.HasSuffix(zoneName, ".") {
+		zoneName += "."
+	}
+	return c.powerDNSServerRequest(ctx, method, "/zones/"+url.PathEscape(zoneName)+path, body, result)
+}
+
+// powerDNSServerRequest sends a request to the PowerDNS API; path is
+// relative to the server, e.g. "/autoprimaries".
+func (c *Client) powerDNSServerRequest(ctx context.Context, method, path string, body interface{}, result interface{}) error {
+	if method != http.MethodGet && c.ReadOnly {
+		return &readOnlyError{Method: method, Path: "powerdns" + path}
+	}
+	endpoint := fmt.Sprintf("%s/api/v1/servers/%s%s", c.PowerDNSAPIURL, powerDNSServerID, path)
+
+	var reqBody io.Reader
+	if body != nil {
+		jsonBody, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to marshal request body: %w", err)
+		}
+		reqBody = bytes.NewReader(jsonBody)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reqBody)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("X-API-Key", c.PowerDNSAPIKey)
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+
+	tflog.Debug(ctx, "Making PowerDNS API request", map[string]interface{}{
+		"method": method,
+		"url":    endpoint,
+	})
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("PowerDNS API request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+	if err != nil {
+		return fmt.Errorf("failed to read PowerDNS API response body: %w", err)
+	}
+
+	tflog.Debug(ctx, "PowerDNS API response", map[string]interface{}{
+		"status_code": resp.StatusCode,
+		"body":        string(respBody),
+	})
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg := strings.TrimSpace(string(respBody))
+		var pdnsErr struct {
+			Error string `json:"error"`
+		}
+		if json.Unmarshal(respBody, &pdnsErr) == nil && pdnsErr.Error != "" {
+			msg = pdnsErr.Error
+		}
+		return &apiHTTPError{StatusCode: resp.StatusCode, Message: "PowerDNS API: " + msg}
+	}
+
+	if result == nil || len(bytes.TrimSpace(respBody)) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(respBody, result); err != nil {
+		return fmt.Errorf("failed to parse PowerDNS API response: %w", err)
+	}
+	return nil
+}
+
+// NotifyZone sends DNS NOTIFY messages for a zone to its slaves.
+func (c *Client) NotifyZone(ctx context.Context, zoneID int64) error {
+	return c.withPowerDNSFallback(ctx, "notify",
+		func() error { return c.Put(ctx, fmt.Sprintf("zones/%d/notify", zoneID), nil, nil) },
+		func() error { return c.powerDNSRequest(ctx, http.MethodPut, zoneID, "/notify", nil, nil) },
+	)
+}
+
+// RectifyZone recalculates the DNSSEC ordering and authoritative flags of a
+// zone's records, correcting its NSEC/NSEC3 chain after record changes.
+func (c *Client) RectifyZone(ctx context.Context, zoneID int64) error {
+	return c.withPowerDNSFallback(ctx, "rectify",
+		func() error { return c.Put(ctx, fmt.Sprintf("zones/%d/rectify", zoneID), nil, nil) },
+		func() error { return c.powerDNSRequest(ctx, http.MethodPut, zoneID, "/rectify", nil, nil) },
+	)
+}
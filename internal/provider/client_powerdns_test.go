@@ -0,0 +1,123 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPowerDNSFallback(t *testing.T) {
+	var pdnsRequests []string
+	pdns := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-API-Key"); got != "pdns-key" {
+			t.Errorf("expected the PowerDNS API key, got %q", got)
+		}
+		pdnsRequests = append(pdnsRequests, r.Method+" "+r.URL.EscapedPath())
+		switch r.URL.EscapedPath() {
+		case "/api/v1/servers/localhost/zones/example.com./metadata/ALSO-NOTIFY":
+			_ = json.NewEncoder(w).Encode(ZoneMetadata{Kind: "ALSO-NOTIFY", Metadata: []string{"192.0.2.1"}})
+		case "/api/v1/servers/localhost/zones/example.com./cryptokeys":
+			_ = json.NewEncoder(w).Encode([]DNSSECKey{{ID: 1, KeyType: "csk", Active: true, Published: true}})
+		case "/api/v1/servers/localhost/zones/example.com./notify",
+			"/api/v1/servers/localhost/zones/example.com./rectify":
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error": "Not Found"}`))
+		}
+	}))
+	t.Cleanup(pdns.Close)
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v2/zones/1":
+			respondJSON(t, w, ZoneResponse{Zone: Zone{ID: 1, Name: "example.com"}})
+		case strings.HasSuffix(r.URL.Path, "/notify"), strings.HasSuffix(r.URL.Path, "/rectify"):
+			respondError(t, w, http.StatusNotImplemented, "Not implemented")
+		default:
+			respondError(t, w, http.StatusNotFound, "Endpoint not found")
+		}
+	})
+	client.PowerDNSAPIURL = pdns.URL
+	client.PowerDNSAPIKey = "pdns-key"
+	ctx := context.Background()
+
+	values, err := client.GetZoneMetadata(ctx, 1, "ALSO-NOTIFY")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(values) != 1 || values[0] != "192.0.2.1" {
+		t.Errorf("expected metadata from PowerDNS, got %v", values)
+	}
+
+	keys, err := client.ListDNSSECKeys(ctx, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(keys) != 1 || keys[0].KeyType != "csk" {
+		t.Errorf("expected the PowerDNS cryptokey, got %+v", keys)
+	}
+
+	if err := client.NotifyZone(ctx, 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := client.RectifyZone(ctx, 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// A resource missing on PowerDNS as well is still a not-found error
+	if _, err := client.GetDNSSECKey(ctx, 1, 9); !IsNotFoundError(err) {
+		t.Errorf("expected a not-found error, got %v", err)
+	}
+	if len(pdnsRequests) != 5 {
+		t.Errorf("expected 5 PowerDNS requests, got %v", pdnsRequests)
+	}
+
+	// Without a PowerDNS API the Poweradmin error is returned as is
+	client.PowerDNSAPIURL = ""
+	if _, err := client.ListDNSSECKeys(ctx, 1); !IsNotFoundError(err) {
+		t.Errorf("expected the Poweradmin not-found error, got %v", err)
+	}
+	if len(pdnsRequests) != 5 {
+		t.Errorf("expected no further PowerDNS requests, got %v", pdnsRequests)
+	}
+}
+
+func TestNewClient_PowerDNSAPI(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     types.String
+		key     types.String
+		want    string
+		wantErr bool
+	}{
+		{"unset", types.StringNull(), types.StringNull(), "", false},
+		{"configured", types.StringValue("http://127.0.0.1:8081/"), types.StringValue("secret"), "http://127.0.0.1:8081", false},
+		{"missing key", types.StringValue("http://127.0.0.1:8081"), types.StringNull(), "", true},
+		{"not a URL", types.StringValue("127.0.0.1:8081"), types.StringValue("secret"), "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := NewClient(context.Background(), &PoweradminProviderModel{
+				ApiUrl:         types.StringValue("https://dns.example.com"),
+				ApiKey:         types.StringValue("test-key"),
+				PowerDNSAPIURL: tt.url,
+				PowerDNSAPIKey: tt.key,
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewClient() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && client.PowerDNSAPIURL != tt.want {
+				t.Errorf("PowerDNSAPIURL = %q, want %q", client.PowerDNSAPIURL, tt.want)
+			}
+		})
+	}
+}
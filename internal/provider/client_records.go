@@ -17,39 +17,58 @@ func (c *Client) GetRecord(ctx context.Context, zoneID int64, recordID RecordID)
 	if err := c.Get(ctx, path, &result); err != nil {
 		return nil, err
 	}
+	normalizeRecordPriority(&result.Record)
 	return &result.Record, nil
 }
 
-// ListRecords retrieves all records for a zone, with optional type filtering.
+// ListRecords retrieves all records for a zone, with optional type filtering,
+// fetching further pages concurrently.
 func (c *Client) ListRecords(ctx context.Context, zoneID int64, recordType string) ([]Record, error) {
-	path := fmt.Sprintf("zones/%d/records", zoneID)
+	query := url.Values{}
 	if recordType != "" {
-		path += "?type=" + url.QueryEscape(recordType)
+		query.Set("type", recordType)
 	}
-	var result RecordListResponse
-	if err := c.Get(ctx, path, &result); err != nil {
+	records, err := listAllPages(ctx, fmt.Sprintf("zones/%d/records", zoneID), query, func(ctx context.Context, path string) ([]Record, *Pagination, error) {
+		var result RecordListResponse
+		if err := c.Get(ctx, path, &result); err != nil {
+			return nil, nil, err
+		}
+		return result.Records, result.Pagination, nil
+	})
+	if err != nil {
 		return nil, err
 	}
-	return result.Records, nil
+	for i := range records {
+		normalizeRecordPriority(&records[i])
+	}
+	return records, nil
 }
 
 // CreateRecord creates a new record in a zone.
 func (c *Client) CreateRecord(ctx context.Context, zoneID int64, req CreateRecordRequest) (*Record, error) {
+	req.Name = toASCIIName(req.Name)
 	path := fmt.Sprintf("zones/%d/records", zoneID)
 	var result RecordResponse
 	if err := c.Post(ctx, path, req, &result); err != nil {
 		return nil, err
 	}
+	normalizeRecordPriority(&result.Record)
 	return &result.Record, nil
 }
 
 // UpdateRecord updates an existing record.
 func (c *Client) UpdateRecord(ctx context.Context, zoneID int64, recordID RecordID, req UpdateRecordRequest) (*Record, error) {
+	req.Name = toASCIIName(req.Name)
 	path := fmt.Sprintf("zones/%d/records/%s", zoneID, url.PathEscape(string(recordID)))
 	var result RecordResponse
 	if err := c.Put(ctx, path, req, &result); err != nil {
 		return nil, err
 	}
+	if result.Record.ID == "" {
+		// Empty success body: read back the updated record instead
+		return c.GetRecord(ctx, zoneID, recordID)
+	}
+	normalizeRecordPriority(&result.Record)
 	return &result.Record, nil
 }
 
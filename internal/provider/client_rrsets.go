@@ -7,6 +7,9 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
 )
 
 // RRSetRecord represents a single record in an RRSet.
@@ -14,6 +17,9 @@ type RRSetRecord struct {
 	Content  string `json:"content"`
 	Disabled bool   `json:"disabled"`
 	Priority int64  `json:"priority"`
+
+	// ChangeDate is the Unix time PowerDNS last saw the record change.
+	ChangeDate int64 `json:"change_date,omitempty"`
 }
 
 // RRSet represents a Resource Record Set.
@@ -26,7 +32,8 @@ type RRSet struct {
 
 // RRSetListResponse represents the response from listing RRSets.
 type RRSetListResponse struct {
-	RRSets []RRSet `json:"rrsets"`
+	RRSets     []RRSet     `json:"rrsets"`
+	Pagination *Pagination `json:"pagination,omitempty"`
 }
 
 // RRSetResponse represents the response for a single RRSet.
@@ -34,51 +41,135 @@ type RRSetResponse struct {
 	RRSet RRSet `json:"rrset"`
 }
 
+// RRSetFilter narrows an RRSet listing. Zero values are not sent.
+type RRSetFilter struct {
+	Type string
+	// Name is an owner name in relative, FQDN, "@" or Unicode form.
+	Name string
+	// NameRegex must match the name as the API returns it; it is applied
+	// client-side.
+	NameRegex *regexp.Regexp
+	// Page fetches only that page (1-based) instead of every page.
+	Page int
+	// PerPage is the page size requested from the server.
+	PerPage int
+	// MaxItems stops the listing once that many RRSets matched.
+	MaxItems int
+}
+
 // ListRRSets retrieves all RRSets for a zone, with optional type filtering.
 func (c *Client) ListRRSets(ctx context.Context, zoneID int64, recordType string) ([]RRSet, error) {
-	path := fmt.Sprintf("zones/%d/rrsets", zoneID)
-	if recordType != "" {
-		path += "?type=" + url.QueryEscape(recordType)
+	return c.ListRRSetsMatching(ctx, zoneID, RRSetFilter{Type: recordType})
+}
+
+// ListRRSetsMatching retrieves the RRSets of a zone matching filter,
+// following the server's pagination until the last page or MaxItems. The
+// name is sent to the server as an FQDN and matched again on the response,
+// since API versions without name filtering return the whole zone.
+func (c *Client) ListRRSetsMatching(ctx context.Context, zoneID int64, filter RRSetFilter) ([]RRSet, error) {
+	query := url.Values{}
+	if filter.Type != "" {
+		query.Set("type", filter.Type)
 	}
-	var result RRSetListResponse
-	if err := c.Get(ctx, path, &result); err != nil {
-		return nil, err
+	zoneName := ""
+	if filter.Name != "" {
+		var err error
+		if zoneName, err = c.GetZoneName(ctx, zoneID); err != nil {
+			return nil, err
+		}
+		query.Set("name", strings.TrimSuffix(ownerFQDN(filter.Name, zoneName), "."))
+	}
+	if filter.PerPage > 0 {
+		query.Set("per_page", strconv.Itoa(filter.PerPage))
+	}
+
+	var rrsets []RRSet
+	page := filter.Page
+	for {
+		// The first page is requested without a page number, which servers
+		// that do not paginate RRSets also accept
+		if page > 0 {
+			query.Set("page", strconv.Itoa(page))
+		}
+		path := fmt.Sprintf("zones/%d/rrsets", zoneID)
+		if len(query) > 0 {
+			path += "?" + query.Encode()
+		}
+		var result RRSetListResponse
+		if err := c.Get(ctx, path, &result); err != nil {
+			return nil, err
+		}
+		for _, rrset := range result.RRSets {
+			if filter.Name != "" && !rrsetNamesEqual(rrset.Name, filter.Name, zoneName) {
+				continue
+			}
+			if filter.NameRegex != nil && !filter.NameRegex.MatchString(rrset.Name) {
+				continue
+			}
+			normalizeRRSetPriorities(&rrset)
+			rrsets = append(rrsets, rrset)
+			if filter.MaxItems > 0 && len(rrsets) == filter.MaxItems {
+				return rrsets, nil
+			}
+		}
+
+		if filter.Page > 0 || result.Pagination == nil || len(result.RRSets) == 0 ||
+			result.Pagination.CurrentPage >= result.Pagination.LastPage {
+			return rrsets, nil
+		}
+		page = result.Pagination.CurrentPage + 1
 	}
-	return result.RRSets, nil
 }
 
 // GetRRSet retrieves a specific RRSet by zone ID, name, and type.
 func (c *Client) GetRRSet(ctx context.Context, zoneID int64, name, recordType string) (*RRSet, error) {
-	path := fmt.Sprintf("zones/%d/rrsets/%s/%s", zoneID, url.PathEscape(name), url.PathEscape(recordType))
+	path := fmt.Sprintf("zones/%d/rrsets/%s/%s", zoneID, url.PathEscape(toASCIIName(name)), url.PathEscape(recordType))
 	var result RRSetResponse
 	if err := c.Get(ctx, path, &result); err != nil {
 		return nil, err
 	}
+	normalizeRRSetPriorities(&result.RRSet)
 	return &result.RRSet, nil
 }
 
-// CreateRRSet creates or replaces an RRSet in a zone.
-func (c *Client) CreateRRSet(ctx context.Context, zoneID int64, rrsetData map[string]interface{}) error {
+// CreateRRSet creates or replaces an RRSet in a zone. It returns the stored
+// RRSet when the API echoes it, or nil when the response has no body, in
+// which case callers needing the stored values read it back.
+func (c *Client) CreateRRSet(ctx context.Context, zoneID int64, rrsetData map[string]interface{}) (*RRSet, error) {
 	path := fmt.Sprintf("zones/%d/rrsets", zoneID)
-	// Put returns the response, but for RRSet creation we just need to know if it succeeded
-	// The Put method will return an error if the API returns success: false
-	if err := c.Put(ctx, path, rrsetData, nil); err != nil {
-		return err
+	var result RRSetResponse
+	if err := c.Put(ctx, path, rrsetRequestBody(rrsetData), &result); err != nil {
+		return nil, err
 	}
-	return nil
+	if result.RRSet.Name == "" {
+		return nil, nil
+	}
+	normalizeRRSetPriorities(&result.RRSet)
+	return &result.RRSet, nil
 }
 
 // UpdateRRSet updates an existing RRSet (same as CreateRRSet since PUT replaces).
-func (c *Client) UpdateRRSet(ctx context.Context, zoneID int64, rrsetData map[string]interface{}) error {
-	path := fmt.Sprintf("zones/%d/rrsets", zoneID)
-	if err := c.Put(ctx, path, rrsetData, nil); err != nil {
-		return err
-	}
-	return nil
+func (c *Client) UpdateRRSet(ctx context.Context, zoneID int64, rrsetData map[string]interface{}) (*RRSet, error) {
+	return c.CreateRRSet(ctx, zoneID, rrsetData)
 }
 
 // DeleteRRSet deletes an RRSet.
 func (c *Client) DeleteRRSet(ctx context.Context, zoneID int64, name, recordType string) error {
-	path := fmt.Sprintf("zones/%d/rrsets/%s/%s", zoneID, url.PathEscape(name), url.PathEscape(recordType))
+	path := fmt.Sprintf("zones/%d/rrsets/%s/%s", zoneID, url.PathEscape(toASCIIName(name)), url.PathEscape(recordType))
 	return c.Delete(ctx, path)
 }
+
+// rrsetRequestBody returns a copy of rrsetData with the owner name in the
+// punycode form the API stores.
+func rrsetRequestBody(rrsetData map[string]interface{}) map[string]interface{} {
+	name, ok := rrsetData["name"].(string)
+	if !ok || isASCII(name) {
+		return rrsetData
+	}
+	body := make(map[string]interface{}, len(rrsetData))
+	for k, v := range rrsetData {
+		body[k] = v
+	}
+	body["name"] = toASCIIName(name)
+	return body
+}
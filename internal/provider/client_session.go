@@ -0,0 +1,44 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+)
+
+// Session endpoints exchange a username and password for a short-lived
+// token and revoke it again; they change no DNS data, so they are allowed in
+// read-only mode.
+const (
+	sessionLoginPath  = "auth/login"
+	sessionLogoutPath = "auth/logout"
+)
+
+// Login opens an API session with a username and password and returns its
+// token, which is accepted wherever an API key is.
+func (c *Client) Login(ctx context.Context, username, password string) (*Session, error) {
+	var result Session
+	if err := c.Post(ctx, sessionLoginPath, LoginRequest{Username: username, Password: password}, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Logout revokes a session token. The request authenticates with the token
+// itself rather than the provider's credentials.
+func (c *Client) Logout(ctx context.Context, token string) error {
+	req, err := c.newRequest(ctx, http.MethodPost, c.buildURL(c.BaseURL, sessionLogoutPath), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("X-API-Key", token)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	return c.parseResponse(ctx, resp, nil)
+}
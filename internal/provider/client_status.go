@@ -0,0 +1,82 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+)
+
+// APIStatus is the outcome of probing the API with the configured
+// credentials.
+type APIStatus struct {
+	// Reachable is true when the API answered with any HTTP response.
+	Reachable bool
+	// Authenticated is true when the API accepted the credentials.
+	Authenticated bool
+	StatusCode    int
+	Latency       time.Duration
+	// ServerTime is the API's response timestamp, or the Date header when
+	// the response has none.
+	ServerTime string
+	// User is the authenticated user, when the API reports it.
+	User *User
+	// Error describes why the API is not healthy; empty when it is.
+	Error string
+}
+
+// GetAPIStatus requests the authenticated user once, without retries or the
+// circuit breaker, and reports how the API answered. Failures are part of
+// the status rather than errors.
+func (c *Client) GetAPIStatus(ctx context.Context) *APIStatus {
+	status := &APIStatus{}
+
+	start := time.Now()
+	resp, err := c.doRequest(ctx, http.MethodGet, "users/me", nil)
+	status.Latency = time.Since(start)
+	if err != nil {
+		status.Error = err.Error()
+		return status
+	}
+	status.Reachable = true
+	status.StatusCode = resp.StatusCode
+	if date, err := http.ParseTime(resp.Header.Get("Date")); err == nil {
+		status.ServerTime = date.UTC().Format(time.RFC3339)
+	}
+
+	// Keep the body for the timestamp; parseResponse consumes it
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+	resp.Body.Close()
+	if err != nil {
+		status.Error = err.Error()
+		return status
+	}
+	var envelope APIResponse
+	if json.Unmarshal(body, &envelope) == nil && envelope.Meta != nil && envelope.Meta.Timestamp != "" {
+		status.ServerTime = envelope.Meta.Timestamp
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	var result UserResponse
+	err = c.parseResponse(ctx, resp, &result)
+	var apiErr *apiHTTPError
+	switch {
+	case err == nil:
+		status.Authenticated = true
+		if result.User.Username != "" {
+			status.User = &result.User
+		}
+	case errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound:
+		// Servers without the endpoint still authenticated the request
+		status.Authenticated = true
+	default:
+		status.Error = err.Error()
+	}
+	return status
+}
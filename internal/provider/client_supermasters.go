@@ -0,0 +1,33 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+)
+
+// ListSupermasters lists the supermasters (PowerDNS autoprimaries) whose
+// NOTIFYs provision SLAVE zones automatically, falling back to the PowerDNS
+// API when Poweradmin lacks the endpoint.
+func (c *Client) ListSupermasters(ctx context.Context) ([]Supermaster, error) {
+	var supermasters []Supermaster
+	err := c.withPowerDNSFallback(ctx, "list supermasters",
+		func() error {
+			var result SupermasterListResponse
+			if err := c.Get(ctx, "supermasters", &result); err != nil {
+				return err
+			}
+			supermasters = result.Supermasters
+			return nil
+		},
+		func() error {
+			return c.powerDNSServerRequest(ctx, http.MethodGet, "/autoprimaries", nil, &supermasters)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return supermasters, nil
+}
@@ -4,16 +4,26 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strconv"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
 )
 
 // newTestClient creates a Client backed by a test HTTP server.
@@ -73,7 +83,7 @@ func TestNewClient_RefusesRedirects(t *testing.T) {
 	}))
 	t.Cleanup(front.Close)
 
-	client, err := NewClient(&PoweradminProviderModel{
+	client, err := NewClient(context.Background(), &PoweradminProviderModel{
 		ApiUrl: types.StringValue(front.URL),
 		ApiKey: types.StringValue("test-key"),
 	})
@@ -124,6 +134,29 @@ func TestGetZone(t *testing.T) {
 	}
 }
 
+func TestGetZoneTypeMemoized(t *testing.T) {
+	calls := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		respondJSON(t, w, ZoneResponse{
+			Zone: Zone{ID: 1, Name: "example.com", Type: "SLAVE"},
+		})
+	})
+
+	for i := 0; i < 3; i++ {
+		zoneType, err := client.GetZoneType(context.Background(), 1)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if zoneType != "SLAVE" {
+			t.Errorf("expected zone type 'SLAVE', got '%s'", zoneType)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("expected 1 API call, got %d", calls)
+	}
+}
+
 func TestListZones(t *testing.T) {
 	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
 		respondJSON(t, w, ZoneListResponse{
@@ -163,6 +196,85 @@ func TestCreateZone(t *testing.T) {
 	}
 }
 
+func TestCreateZone_Unicode(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var req CreateZoneRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if req.Name != "xn--mnchen-3ya.example" {
+			t.Errorf("expected punycode zone name, got %q", req.Name)
+		}
+		respondJSON(t, w, CreateZoneResponse{ZoneID: 42})
+	})
+
+	if _, err := client.CreateZone(context.Background(), CreateZoneRequest{
+		Name: "münchen.example",
+		Type: "MASTER",
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestCreateRRSet_UnicodeName(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if body["name"] != "xn--bcher-kva" {
+			t.Errorf("expected punycode owner name, got %v", body["name"])
+		}
+		respondJSON(t, w, nil)
+	})
+
+	rrsetData := map[string]interface{}{"name": "bücher", "type": "A"}
+	if _, err := client.CreateRRSet(context.Background(), 1, rrsetData); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rrsetData["name"] != "bücher" {
+		t.Errorf("caller's rrset data was modified: %v", rrsetData["name"])
+	}
+}
+
+func TestUpdateRRSet_EchoedRRSet(t *testing.T) {
+	echo := true
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("expected only the PUT, got %s %s", r.Method, r.URL.Path)
+		}
+		if !echo {
+			respondJSON(t, w, nil)
+			return
+		}
+		respondJSON(t, w, RRSetResponse{RRSet: RRSet{
+			Name: "mail.example.com", Type: "MX", TTL: 300,
+			Records: []RRSetRecord{{Content: "10 mx1.example.com"}},
+		}})
+	})
+	rrsetData := map[string]interface{}{"name": "mail", "type": "MX"}
+
+	rrset, err := client.UpdateRRSet(context.Background(), 1, rrsetData)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rrset == nil || rrset.TTL != 300 {
+		t.Fatalf("expected the echoed RRSet, got %+v", rrset)
+	}
+	if rrset.Records[0].Content != "mx1.example.com" || rrset.Records[0].Priority != 10 {
+		t.Errorf("expected the embedded priority to be split off, got %+v", rrset.Records[0])
+	}
+
+	echo = false
+	rrset, err = client.UpdateRRSet(context.Background(), 1, rrsetData)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rrset != nil {
+		t.Errorf("expected nil without an echoed RRSet, got %+v", rrset)
+	}
+}
+
 func TestUpdateZone(t *testing.T) {
 	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPut || r.URL.Path != "/api/v2/zones/1" {
@@ -183,6 +295,40 @@ func TestUpdateZone(t *testing.T) {
 	}
 }
 
+func TestUpdateZone_EmptyBody(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		respondJSON(t, w, ZoneResponse{
+			Zone: Zone{ID: 1, Name: "example.com", Type: "NATIVE"},
+		})
+	})
+
+	newType := "NATIVE"
+	zone, err := client.UpdateZone(context.Background(), 1, UpdateZoneRequest{Type: &newType})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if zone.ID != 1 || zone.Type != "NATIVE" {
+		t.Errorf("expected the zone to be read back, got %+v", zone)
+	}
+}
+
+func TestEmptySuccessBody(t *testing.T) {
+	for _, status := range []int{http.StatusOK, http.StatusAccepted, http.StatusNoContent} {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(status)
+			})
+			if err := client.Delete(context.Background(), "zones/1/records/2"); err != nil {
+				t.Errorf("expected empty %d response to succeed, got %v", status, err)
+			}
+		})
+	}
+}
+
 func TestDeleteZone(t *testing.T) {
 	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodDelete || r.URL.Path != "/api/v2/zones/1" {
@@ -216,6 +362,25 @@ func TestFindZoneByName(t *testing.T) {
 	}
 }
 
+func TestFindZoneByName_Unicode(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(t, w, ZoneListResponse{
+			Zones: []Zone{
+				{ID: 1, Name: "example.com", Type: "MASTER"},
+				{ID: 2, Name: "xn--mnchen-3ya.example", Type: "MASTER"},
+			},
+		})
+	})
+
+	zone, err := client.FindZoneByName(context.Background(), "münchen.example")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if zone.ID != 2 {
+		t.Errorf("expected zone ID 2, got %d", zone.ID)
+	}
+}
+
 func TestFindZoneByName_NotFound(t *testing.T) {
 	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
 		respondJSON(t, w, ZoneListResponse{
@@ -229,6 +394,31 @@ func TestFindZoneByName_NotFound(t *testing.T) {
 	}
 }
 
+func TestGetZoneDefaults(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/api/v2/config/dns" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		respondJSON(t, w, ZoneDefaultsResponse{Defaults: ZoneDefaults{
+			Hostmaster:  "hostmaster.example.com",
+			Nameservers: []string{"ns1.example.com", "ns2.example.com"},
+			TTL:         86400,
+			RecordTypes: []string{"A", "AAAA", "MX"},
+		}})
+	})
+
+	defaults, err := client.GetZoneDefaults(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if defaults.Hostmaster != "hostmaster.example.com" || defaults.TTL != 86400 {
+		t.Errorf("unexpected defaults: %+v", defaults)
+	}
+	if len(defaults.Nameservers) != 2 || len(defaults.RecordTypes) != 3 {
+		t.Errorf("expected 2 nameservers and 3 record types, got %v and %v", defaults.Nameservers, defaults.RecordTypes)
+	}
+}
+
 // --- Record tests ---
 
 // PowerDNS API backend record IDs are encoded strings: they must decode from
@@ -280,6 +470,22 @@ func TestGetRecord(t *testing.T) {
 	}
 }
 
+func TestGetRecord_SplitsEmbeddedPriority(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(t, w, RecordResponse{
+			Record: Record{ID: "11", ZoneID: 1, Name: "@", Type: "MX", Content: "10 mail.example.com", TTL: 3600},
+		})
+	})
+
+	record, err := client.GetRecord(context.Background(), 1, "11")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if record.Content != "mail.example.com" || record.Priority != 10 {
+		t.Errorf("expected content 'mail.example.com' with priority 10, got '%s' with %d", record.Content, record.Priority)
+	}
+}
+
 func TestListRecords(t *testing.T) {
 	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
 		respondJSON(t, w, RecordListResponse{
@@ -483,8 +689,8 @@ func TestFindUserByUsername_NotFound(t *testing.T) {
 	})
 
 	_, err := client.FindUserByUsername(context.Background(), "missing")
-	if err == nil {
-		t.Fatal("expected error for missing user")
+	if !errors.Is(err, errUserNotFound) {
+		t.Fatalf("expected errUserNotFound, got %v", err)
 	}
 }
 
@@ -508,6 +714,153 @@ func TestListRRSets(t *testing.T) {
 	}
 }
 
+func TestListRRSetsMatching_Name(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/zones/1":
+			respondJSON(t, w, ZoneResponse{Zone: Zone{ID: 1, Name: "example.com"}})
+		case "/api/v2/zones/1/rrsets":
+			if got := r.URL.Query().Get("name"); got != "www.example.com" {
+				t.Errorf("expected name=www.example.com, got %q", got)
+			}
+			if got := r.URL.Query().Get("type"); got != "A" {
+				t.Errorf("expected type=A, got %q", got)
+			}
+			// An API without name filtering returns every RRSet
+			respondJSON(t, w, RRSetListResponse{RRSets: []RRSet{
+				{Name: "www.example.com", Type: "A", TTL: 3600, Records: []RRSetRecord{{Content: "192.0.2.1"}}},
+				{Name: "mail.example.com", Type: "A", TTL: 3600, Records: []RRSetRecord{{Content: "192.0.2.2"}}},
+			}})
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+	})
+
+	rrsets, err := client.ListRRSetsMatching(context.Background(), 1, RRSetFilter{Type: "A", Name: "www"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(rrsets) != 1 || rrsets[0].Name != "www.example.com" {
+		t.Errorf("expected only www.example.com, got %+v", rrsets)
+	}
+}
+
+func TestListRRSetsMatching_Pagination(t *testing.T) {
+	pages := map[string][]RRSet{
+		"":  {{Name: "a.example.com", Type: "A"}, {Name: "b.example.com", Type: "A"}},
+		"2": {{Name: "c.example.com", Type: "A"}, {Name: "d.example.com", Type: "A"}},
+		"3": {{Name: "e.example.com", Type: "A"}},
+	}
+	tests := []struct {
+		name      string
+		filter    RRSetFilter
+		want      []string
+		wantPages []string
+	}{
+		{"all pages", RRSetFilter{}, []string{"a", "b", "c", "d", "e"}, []string{"", "2", "3"}},
+		{"single page", RRSetFilter{Page: 2}, []string{"c", "d"}, []string{"2"}},
+		{"max items", RRSetFilter{MaxItems: 3}, []string{"a", "b", "c"}, []string{"", "2"}},
+		{"regex before max items", RRSetFilter{NameRegex: regexp.MustCompile(`^[ce]\.`), MaxItems: 1}, []string{"c"}, []string{"", "2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested []string
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				page := r.URL.Query().Get("page")
+				if page == "1" {
+					page = ""
+				}
+				requested = append(requested, page)
+				if got := r.URL.Query().Get("per_page"); got != "2" {
+					t.Errorf("expected per_page=2, got %q", got)
+				}
+				current := map[string]int{"": 1, "2": 2, "3": 3}[page]
+				respondJSON(t, w, RRSetListResponse{
+					RRSets:     pages[page],
+					Pagination: &Pagination{CurrentPage: current, PerPage: 2, Total: 5, LastPage: 3},
+				})
+			})
+
+			tt.filter.PerPage = 2
+			rrsets, err := client.ListRRSetsMatching(context.Background(), 1, tt.filter)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var got []string
+			for _, rrset := range rrsets {
+				got = append(got, strings.TrimSuffix(rrset.Name, ".example.com"))
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+			if !reflect.DeepEqual(requested, tt.wantPages) {
+				t.Errorf("expected pages %q to be requested, got %q", tt.wantPages, requested)
+			}
+		})
+	}
+}
+
+func TestListZones_ConcurrentPages(t *testing.T) {
+	const lastPage = 10
+	var inFlight, maxInFlight atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			m := maxInFlight.Load()
+			if n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		page := 1
+		if p := r.URL.Query().Get("page"); p != "" {
+			page, _ = strconv.Atoi(p)
+			// Hold later pages so concurrent requests overlap
+			time.Sleep(20 * time.Millisecond)
+		}
+		respondJSON(t, w, ZoneListResponse{
+			Zones:      []Zone{{ID: page, Name: fmt.Sprintf("zone%d.example.com", page)}},
+			Pagination: &Pagination{CurrentPage: page, PerPage: 1, Total: lastPage, LastPage: lastPage},
+		})
+	})
+
+	zones, err := client.ListZones(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(zones) != lastPage {
+		t.Fatalf("expected %d zones, got %d", lastPage, len(zones))
+	}
+	for i, zone := range zones {
+		if zone.ID != i+1 {
+			t.Errorf("expected zones in page order, got ID %d at index %d", zone.ID, i)
+		}
+	}
+	if got := maxInFlight.Load(); got < 2 || got > maxConcurrentPages {
+		t.Errorf("expected between 2 and %d concurrent requests, got %d", maxConcurrentPages, got)
+	}
+}
+
+func TestListRecords_PageError(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("type") != "A" {
+			t.Errorf("expected type filter on every page, got %q", r.URL.RawQuery)
+		}
+		if r.URL.Query().Get("page") == "3" {
+			respondError(t, w, http.StatusBadRequest, "Invalid page")
+			return
+		}
+		respondJSON(t, w, RecordListResponse{
+			Records:    []Record{{ID: "1", Name: "www.example.com", Type: "A", Content: "192.0.2.1"}},
+			Pagination: &Pagination{CurrentPage: 1, PerPage: 1, Total: 3, LastPage: 3},
+		})
+	})
+
+	if _, err := client.ListRecords(context.Background(), 1, "A"); err == nil {
+		t.Fatal("expected a failing page to fail the listing")
+	}
+}
+
 func TestGetRRSet(t *testing.T) {
 	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path != "/api/v2/zones/1/rrsets/www.example.com/A" {
@@ -525,6 +878,25 @@ func TestGetRRSet(t *testing.T) {
 	}
 }
 
+func TestGetRRSet_SplitsEmbeddedPriority(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(t, w, RRSetResponse{RRSet: RRSet{Name: "_sip._udp", Type: "SRV", TTL: 3600, Records: []RRSetRecord{
+			{Content: "20 60 5060 sip1.example.com"},
+			{Content: "60 5060 sip2.example.com", Priority: 20},
+		}}})
+	})
+
+	rrset, err := client.GetRRSet(context.Background(), 1, "_sip._udp", "SRV")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, rec := range rrset.Records {
+		if rec.Priority != 20 || strings.Count(rec.Content, " ") != 2 {
+			t.Errorf("expected priority 20 and 'weight port target' content, got %d and '%s'", rec.Priority, rec.Content)
+		}
+	}
+}
+
 func TestDeleteRRSet(t *testing.T) {
 	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodDelete {
@@ -614,6 +986,90 @@ func TestAPIError(t *testing.T) {
 	}
 }
 
+func TestAPIError_HTMLBody(t *testing.T) {
+	page := "<!DOCTYPE html>\n<html><head><title>502 Bad Gateway</title></head>" +
+		"<body><center><h1>502 Bad Gateway</h1></center><hr><center>nginx</center>" +
+		strings.Repeat("<p>padding</p>", 100) + "</body></html>"
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.WriteHeader(http.StatusBadGateway)
+		_, _ = w.Write([]byte(page))
+	})
+
+	_, err := client.GetZone(context.Background(), 1)
+	var apiErr *apiHTTPError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadGateway {
+		t.Fatalf("expected HTTP 502 apiHTTPError, got %v", err)
+	}
+	if strings.Contains(err.Error(), "<") || strings.Contains(err.Error(), "padding") {
+		t.Errorf("expected the HTML body to be summarized, got %q", err)
+	}
+	if !strings.Contains(err.Error(), "an HTML page") || !strings.Contains(err.Error(), "proxy") {
+		t.Errorf("expected an HTML hint, got %q", err)
+	}
+}
+
+func TestNonJSONResponseMessage(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      string
+		contentType string
+		body        string
+		want        string
+	}{
+		{
+			name:        "html title",
+			status:      "403 Forbidden",
+			contentType: "text/html; charset=utf-8",
+			body:        "<html><head><title>Sign in &amp; continue</title></head><body>...</body></html>",
+			want:        `received an HTML page instead of JSON (403 Forbidden): "Sign in & continue"`,
+		},
+		{
+			name:   "title matching status omitted",
+			status: "502 Bad Gateway",
+			body:   "<html><head><title>502 Bad Gateway</title></head></html>",
+			want:   "received an HTML page instead of JSON (502 Bad Gateway).",
+		},
+		{
+			name:        "php fatal without title",
+			status:      "500 Internal Server Error",
+			contentType: "text/html",
+			body:        "<br />\n<b>Fatal error</b>:  Uncaught Error in /var/www/index.php:12<br />",
+			want:        `(500 Internal Server Error): "Fatal error"`,
+		},
+		{
+			name:   "plain text first line",
+			status: "503 Service Unavailable",
+			body:   "\n  upstream connect error\nreset reason: overflow",
+			want:   `received a non-JSON response instead of JSON (503 Service Unavailable): "upstream connect error"`,
+		},
+		{
+			name:   "empty body",
+			status: "401 Unauthorized",
+			body:   "",
+			want:   "received an empty response instead of JSON (401 Unauthorized).",
+		},
+		{
+			name:   "long line truncated",
+			status: "500 Internal Server Error",
+			body:   strings.Repeat("x", 300),
+			want:   `"` + strings.Repeat("x", maxSummaryLength) + `..."`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &http.Response{Status: tt.status, Header: http.Header{}}
+			if tt.contentType != "" {
+				resp.Header.Set("Content-Type", tt.contentType)
+			}
+			got := nonJSONResponseMessage(resp, []byte(tt.body))
+			if !strings.Contains(got, tt.want) {
+				t.Errorf("nonJSONResponseMessage() = %q, want it to contain %q", got, tt.want)
+			}
+		})
+	}
+}
+
 // Locks in the wire shape for clearing semantics: an empty description is
 // sent (clears server-side), an unset perm_templ is omitted (server keeps it).
 func TestUpdateUserRequestJSON(t *testing.T) {
@@ -658,6 +1114,30 @@ func TestIsNotFoundError(t *testing.T) {
 	}
 }
 
+func TestIsCNAMEConflictError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil error", nil, false},
+		{"cname already exists", &apiHTTPError{StatusCode: 409, Message: "A CNAME record already exists with this name"}, true},
+		{"cname conflict on bad request", &apiHTTPError{StatusCode: 400, Message: "Record conflicts with existing CNAME"}, true},
+		{"wrapped cname conflict", fmt.Errorf("create: %w", &apiHTTPError{StatusCode: 422, Message: "CNAME cannot coexist with other records"}), true},
+		{"other conflict", &apiHTTPError{StatusCode: 409, Message: "Record already exists"}, false},
+		{"invalid cname target", &apiHTTPError{StatusCode: 400, Message: "Invalid CNAME target"}, false},
+		{"server error mentioning cname", &apiHTTPError{StatusCode: 500, Message: "CNAME already exists"}, false},
+		{"string error not matched", errors.New("API error (HTTP 409): CNAME already exists"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsCNAMEConflictError(tt.err); got != tt.want {
+				t.Errorf("IsCNAMEConflictError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestAuthHeaders_APIKey(t *testing.T) {
 	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
 		if r.Header.Get("Authorization") != "Bearer test-key" {
@@ -698,3 +1178,892 @@ func TestAuthHeaders_BasicAuth(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
+
+func TestZoneMetadata(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/zones/3/metadata/ALLOW-AXFR-FROM" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		switch r.Method {
+		case http.MethodGet:
+			respondJSON(t, w, ZoneMetadata{Kind: "ALLOW-AXFR-FROM", Metadata: []string{"192.0.2.0/24"}})
+		case http.MethodPut:
+			var body ZoneMetadata
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode body: %v", err)
+			}
+			if body.Kind != "ALLOW-AXFR-FROM" || len(body.Metadata) != 2 {
+				t.Errorf("unexpected body: %+v", body)
+			}
+			respondJSON(t, w, nil)
+		default:
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+	})
+	ctx := context.Background()
+
+	values, err := client.GetZoneMetadata(ctx, 3, "ALLOW-AXFR-FROM")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(values) != 1 || values[0] != "192.0.2.0/24" {
+		t.Errorf("unexpected values: %v", values)
+	}
+	if err := client.SetZoneMetadata(ctx, 3, "ALLOW-AXFR-FROM", []string{"192.0.2.0/24", "AUTO-NS"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestZoneTags(t *testing.T) {
+	var stored []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/zones/3/metadata/X-POWERADMIN-TAGS" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		switch r.Method {
+		case http.MethodGet:
+			if stored == nil {
+				respondError(t, w, http.StatusNotFound, "Metadata not found")
+				return
+			}
+			respondJSON(t, w, ZoneMetadata{Kind: zoneTagsMetadataKind, Metadata: stored})
+		case http.MethodPut:
+			var body ZoneMetadata
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode body: %v", err)
+			}
+			stored = body.Metadata
+			respondJSON(t, w, nil)
+		case http.MethodDelete:
+			stored = nil
+			respondError(t, w, http.StatusNotFound, "Metadata not found")
+		default:
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+	})
+	ctx := context.Background()
+
+	tags, err := client.GetZoneTags(ctx, 3)
+	if err != nil || len(tags) != 0 {
+		t.Fatalf("expected no tags for missing metadata, got %v (err %v)", tags, err)
+	}
+
+	want := map[string]string{"owner": "team-dns", "ticket": "OPS-1=2"}
+	if err := client.SetZoneTags(ctx, 3, want); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fmt.Sprint(stored) != "[owner=team-dns ticket=OPS-1=2]" {
+		t.Errorf("expected sorted key=value entries, got %v", stored)
+	}
+	tags, err = client.GetZoneTags(ctx, 3)
+	if err != nil || !reflect.DeepEqual(tags, want) {
+		t.Errorf("expected %v, got %v (err %v)", want, tags, err)
+	}
+
+	if err := client.SetZoneTags(ctx, 3, nil); err != nil {
+		t.Fatalf("expected clearing tags to ignore missing metadata, got %v", err)
+	}
+	if stored != nil {
+		t.Errorf("expected tags to be removed, got %v", stored)
+	}
+}
+
+func TestZoneAlsoNotify(t *testing.T) {
+	var stored []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/zones/3/metadata/ALSO-NOTIFY" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		switch r.Method {
+		case http.MethodGet:
+			if stored == nil {
+				respondError(t, w, http.StatusNotFound, "Metadata not found")
+				return
+			}
+			respondJSON(t, w, ZoneMetadata{Kind: metadataAlsoNotify, Metadata: stored})
+		case http.MethodPut:
+			var body ZoneMetadata
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode body: %v", err)
+			}
+			stored = body.Metadata
+			respondJSON(t, w, nil)
+		case http.MethodDelete:
+			stored = nil
+			respondError(t, w, http.StatusNotFound, "Metadata not found")
+		default:
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+	})
+	ctx := context.Background()
+
+	targets, err := client.GetZoneAlsoNotify(ctx, 3)
+	if err != nil || len(targets) != 0 {
+		t.Fatalf("expected no targets for missing metadata, got %v (err %v)", targets, err)
+	}
+
+	want := []string{"192.0.2.10", "[2001:db8::10]:5300"}
+	if err := client.SetZoneAlsoNotify(ctx, 3, want); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	targets, err = client.GetZoneAlsoNotify(ctx, 3)
+	if err != nil || !reflect.DeepEqual(targets, want) {
+		t.Errorf("expected %v, got %v (err %v)", want, targets, err)
+	}
+
+	if err := client.SetZoneAlsoNotify(ctx, 3, nil); err != nil {
+		t.Fatalf("expected clearing targets to ignore missing metadata, got %v", err)
+	}
+	if stored != nil {
+		t.Errorf("expected targets to be removed, got %v", stored)
+	}
+}
+
+func TestZoneDefaultTTL(t *testing.T) {
+	var stored []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/zones/3/metadata/"+zoneDefaultTTLMetadataKind {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		switch r.Method {
+		case http.MethodGet:
+			if stored == nil {
+				respondError(t, w, http.StatusNotFound, "Metadata not found")
+				return
+			}
+			respondJSON(t, w, ZoneMetadata{Kind: zoneDefaultTTLMetadataKind, Metadata: stored})
+		case http.MethodPut:
+			var body ZoneMetadata
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode body: %v", err)
+			}
+			stored = body.Metadata
+			respondJSON(t, w, nil)
+		case http.MethodDelete:
+			stored = nil
+			respondError(t, w, http.StatusNotFound, "Metadata not found")
+		default:
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+	})
+	ctx := context.Background()
+
+	if ttl, err := zoneRecordTTL(ctx, client, 3); err != nil || ttl != defaultRecordTTL {
+		t.Fatalf("expected %d for a zone without a default, got %d (err %v)", defaultRecordTTL, ttl, err)
+	}
+
+	if err := client.SetZoneDefaultTTL(ctx, 3, 300); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(stored, []string{"300"}) {
+		t.Errorf("expected the TTL to be stored in seconds, got %v", stored)
+	}
+	if ttl, err := zoneRecordTTL(ctx, client, 3); err != nil || ttl != 300 {
+		t.Errorf("expected 300, got %d (err %v)", ttl, err)
+	}
+
+	// Values written outside Terraform may use the duration form
+	stored = []string{"1h"}
+	if ttl, ok, err := client.GetZoneDefaultTTL(ctx, 3); err != nil || !ok || ttl != 3600 {
+		t.Errorf("expected 3600, got %d, %t (err %v)", ttl, ok, err)
+	}
+	stored = []string{"soon"}
+	if _, _, err := client.GetZoneDefaultTTL(ctx, 3); err == nil {
+		t.Error("expected an error for an invalid stored TTL")
+	}
+
+	if err := client.DeleteZoneDefaultTTL(ctx, 3); err != nil {
+		t.Fatalf("expected removing the default to ignore missing metadata, got %v", err)
+	}
+	if stored != nil {
+		t.Errorf("expected the default to be removed, got %v", stored)
+	}
+}
+
+func TestZoneRecordTTL_OncePerZone(t *testing.T) {
+	reads := map[string]int{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		reads[r.URL.Path]++
+		switch r.URL.Path {
+		case "/api/v2/zones/3/metadata/" + zoneDefaultTTLMetadataKind:
+			respondJSON(t, w, ZoneMetadata{Kind: zoneDefaultTTLMetadataKind, Metadata: []string{"300"}})
+		default:
+			respondError(t, w, http.StatusForbidden, "Insufficient permissions")
+		}
+	})
+	ctx := context.Background()
+
+	// Records of one zone share a single metadata read
+	for i := 0; i < 5; i++ {
+		if ttl, err := zoneRecordTTL(ctx, client, 3); err != nil || ttl != 300 {
+			t.Fatalf("record %d: expected 300, got %d (err %v)", i, ttl, err)
+		}
+	}
+	// A key without metadata permission falls back to the default TTL
+	ttl, err := zoneRecordTTL(ctx, client, 4)
+	if err == nil || ttl != defaultRecordTTL {
+		t.Errorf("expected %d with an error to warn about, got %d (err %v)", defaultRecordTTL, ttl, err)
+	}
+	if ttl, err := zoneRecordTTL(ctx, client, 4); err != nil || ttl != defaultRecordTTL {
+		t.Errorf("expected the fallback to be kept for the zone, got %d (err %v)", ttl, err)
+	}
+	for path, n := range reads {
+		if n != 1 {
+			t.Errorf("expected one read of %s, got %d", path, n)
+		}
+	}
+}
+
+func TestReadOnlyRefusesWrites(t *testing.T) {
+	var methods []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		respondJSON(t, w, ZoneResponse{Zone: Zone{ID: 1, Name: "example.com", Type: "MASTER"}})
+	})
+	client.ReadOnly = true
+	ctx := context.Background()
+
+	if _, err := client.GetZone(ctx, 1); err != nil {
+		t.Fatalf("reads must still work, got %v", err)
+	}
+
+	writes := map[string]func() error{
+		"Post":   func() error { return client.Post(ctx, "zones", map[string]string{}, nil) },
+		"Put":    func() error { return client.Put(ctx, "zones/1", map[string]string{}, nil) },
+		"Delete": func() error { return client.Delete(ctx, "zones/1") },
+	}
+	for name, write := range writes {
+		if err := write(); !IsReadOnlyError(err) {
+			t.Errorf("%s: expected read-only error, got %v", name, err)
+		}
+	}
+	if len(methods) != 1 || methods[0] != http.MethodGet {
+		t.Errorf("expected only the GET to reach the server, got %v", methods)
+	}
+}
+
+func TestConflictRetry(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		message   string
+		window    time.Duration
+		wantCalls int
+		wantErr   bool
+	}{
+		{"zone locked then success", http.StatusConflict, "Zone is locked by another operation", time.Second, 3, false},
+		{"locked on other status", http.StatusServiceUnavailable, "zone locked", time.Second, 3, false},
+		{"already exists not retried", http.StatusConflict, "Domain already exists", time.Second, 1, true},
+		{"cname conflict not retried", http.StatusConflict, "A CNAME record already exists with this name", time.Second, 1, true},
+		{"retries disabled", http.StatusConflict, "Zone is locked", 0, 1, true},
+		{"locked status retried", http.StatusLocked, "Resource busy", time.Second, 3, false},
+		{"account locked not retried", http.StatusForbidden, "Account locked", time.Second, 1, true},
+		{"user locked out not retried", http.StatusUnauthorized, "User locked out", time.Second, 1, true},
+		{"other lock not retried", http.StatusUnprocessableEntity, "Record is locked for editing", time.Second, 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				if calls < 3 {
+					respondError(t, w, tt.status, tt.message)
+					return
+				}
+				respondJSON(t, w, nil)
+			})
+			client.ConflictRetryTimeout = tt.window
+			client.retryBaseDelay = time.Millisecond
+
+			err := client.Put(context.Background(), "zones/1/rrsets", map[string]string{}, nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Put() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("expected %d calls, got %d", tt.wantCalls, calls)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy(t *testing.T) {
+	policy := RetryPolicy{
+		Statuses:       []int{http.StatusServiceUnavailable},
+		Methods:        []string{http.MethodGet},
+		MaxAttempts:    3,
+		MaxElapsedTime: time.Second,
+	}
+	tests := []struct {
+		name      string
+		policy    RetryPolicy
+		method    string
+		status    int
+		wantCalls int
+		wantErr   bool
+	}{
+		{"retried until success", policy, http.MethodGet, http.StatusServiceUnavailable, 3, false},
+		{"method not listed", policy, http.MethodPost, http.StatusServiceUnavailable, 1, true},
+		{"status not listed", policy, http.MethodGet, http.StatusBadGateway, 1, true},
+		{"attempts exhausted", RetryPolicy{Statuses: policy.Statuses, Methods: policy.Methods, MaxAttempts: 2, MaxElapsedTime: time.Second}, http.MethodGet, http.StatusServiceUnavailable, 2, true},
+		{"no policy", RetryPolicy{}, http.MethodGet, http.StatusServiceUnavailable, 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				if calls < 3 {
+					respondError(t, w, tt.status, "backend unavailable")
+					return
+				}
+				respondJSON(t, w, nil)
+			})
+			client.Retry = tt.policy
+			client.retryBaseDelay = time.Millisecond
+
+			var err error
+			if tt.method == http.MethodPost {
+				err = client.Post(context.Background(), "zones", map[string]string{}, nil)
+			} else {
+				err = client.Get(context.Background(), "zones", nil)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("request error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("expected %d calls, got %d", tt.wantCalls, calls)
+			}
+		})
+	}
+}
+
+func TestNewRetryPolicy(t *testing.T) {
+	policy, err := newRetryPolicy(&RetryPolicyModel{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(policy.Statuses, defaultRetryStatuses) || !reflect.DeepEqual(policy.Methods, defaultRetryMethods) ||
+		policy.MaxAttempts != defaultRetryMaxAttempts || policy.MaxElapsedTime != defaultRetryMaxElapsedTime {
+		t.Errorf("expected defaults for an empty block, got %+v", policy)
+	}
+
+	statuses, _ := types.ListValueFrom(context.Background(), types.Int64Type, []int64{429, 500})
+	methods, _ := types.ListValueFrom(context.Background(), types.StringType, []string{"get", "POST"})
+	policy, err = newRetryPolicy(&RetryPolicyModel{
+		Statuses:       statuses,
+		Methods:        methods,
+		MaxAttempts:    types.Int64Value(10),
+		MaxElapsedTime: types.StringValue("2m"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := RetryPolicy{Statuses: []int{429, 500}, Methods: []string{"GET", "POST"}, MaxAttempts: 10, MaxElapsedTime: 2 * time.Minute}
+	if !reflect.DeepEqual(policy, want) {
+		t.Errorf("expected %+v, got %+v", want, policy)
+	}
+
+	badStatuses, _ := types.ListValueFrom(context.Background(), types.Int64Type, []int64{200})
+	badMethods, _ := types.ListValueFrom(context.Background(), types.StringType, []string{"CONNECT"})
+	for name, bad := range map[string]RetryPolicyModel{
+		"success status":    {Statuses: badStatuses},
+		"unknown method":    {Methods: badMethods},
+		"zero attempts":     {MaxAttempts: types.Int64Value(0)},
+		"negative duration": {MaxElapsedTime: types.StringValue("-1m")},
+		"invalid duration":  {MaxElapsedTime: types.StringValue("soon")},
+	} {
+		if _, err := newRetryPolicy(&bad); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestNewClient_ConflictRetryTimeout(t *testing.T) {
+	base := PoweradminProviderModel{
+		ApiUrl: types.StringValue("https://dns.example.com"),
+		ApiKey: types.StringValue("test-key"),
+	}
+
+	client, err := NewClient(context.Background(), &base)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	if client.ConflictRetryTimeout != defaultConflictRetryTimeout {
+		t.Errorf("expected default timeout %s, got %s", defaultConflictRetryTimeout, client.ConflictRetryTimeout)
+	}
+
+	custom := base
+	custom.ConflictRetryTimeout = types.StringValue("2m")
+	if client, err = NewClient(context.Background(), &custom); err != nil || client.ConflictRetryTimeout != 2*time.Minute {
+		t.Errorf("expected 2m timeout, got %v (err %v)", client, err)
+	}
+
+	for _, bad := range []string{"soon", "-5s"} {
+		invalid := base
+		invalid.ConflictRetryTimeout = types.StringValue(bad)
+		if _, err := NewClient(context.Background(), &invalid); err == nil {
+			t.Errorf("expected error for conflict_retry_timeout %q", bad)
+		}
+	}
+}
+
+func TestNewClient_TLS(t *testing.T) {
+	tests := []struct {
+		name       string
+		configure  func(*PoweradminProviderModel)
+		wantConfig bool
+		skip       bool
+		minVersion uint16
+		serverName string
+		wantErr    bool
+	}{
+		{"defaults", func(*PoweradminProviderModel) {}, false, false, 0, "", false},
+		{"skip verify", func(m *PoweradminProviderModel) { m.TLSSkipVerify = types.BoolValue(true) }, true, true, tls.VersionTLS12, "", false},
+		{"deprecated insecure", func(m *PoweradminProviderModel) { m.Insecure = types.BoolValue(true) }, true, true, tls.VersionTLS12, "", false},
+		{"min version", func(m *PoweradminProviderModel) { m.TLSMinVersion = types.StringValue("1.3") }, true, false, tls.VersionTLS13, "", false},
+		{"server name", func(m *PoweradminProviderModel) { m.TLSServerName = types.StringValue("dns.example.com.") }, true, false, tls.VersionTLS12, "dns.example.com", false},
+		{"old min version", func(m *PoweradminProviderModel) { m.TLSMinVersion = types.StringValue("1.1") }, false, false, 0, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := PoweradminProviderModel{
+				ApiUrl: types.StringValue("https://10.0.0.5"),
+				ApiKey: types.StringValue("test-key"),
+			}
+			tt.configure(&config)
+			client, err := NewClient(context.Background(), &config)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewClient() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			transport, _ := client.HTTPClient.Transport.(*http.Transport)
+			if !tt.wantConfig {
+				if transport != nil {
+					t.Errorf("expected the default transport, got TLS config %+v", transport.TLSClientConfig)
+				}
+				return
+			}
+			if transport == nil || transport.TLSClientConfig == nil {
+				t.Fatal("expected a TLS config")
+			}
+			got := transport.TLSClientConfig
+			if got.InsecureSkipVerify != tt.skip || got.MinVersion != tt.minVersion || got.ServerName != tt.serverName {
+				t.Errorf("got skip=%v min=%x server=%q, want skip=%v min=%x server=%q",
+					got.InsecureSkipVerify, got.MinVersion, got.ServerName, tt.skip, tt.minVersion, tt.serverName)
+			}
+		})
+	}
+}
+
+func TestNewClient_HTTPVersion(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(t, w, map[string]int{"proto_major": r.ProtoMajor})
+	}))
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	tests := []struct {
+		version   string
+		wantMajor int
+		wantErr   bool
+	}{
+		{"", 2, false},
+		{"auto", 2, false},
+		{"1.1", 1, false},
+		{"2", 2, false},
+		{"3", 0, true},
+	}
+	for _, tt := range tests {
+		client, err := NewClient(context.Background(), &PoweradminProviderModel{
+			ApiUrl:        types.StringValue(server.URL),
+			ApiKey:        types.StringValue("test-key"),
+			TLSSkipVerify: types.BoolValue(true),
+			HTTPVersion:   types.StringValue(tt.version),
+		})
+		if (err != nil) != tt.wantErr {
+			t.Errorf("http_version %q: error = %v, wantErr %v", tt.version, err, tt.wantErr)
+			continue
+		}
+		if tt.wantErr {
+			continue
+		}
+		var result struct {
+			ProtoMajor int `json:"proto_major"`
+		}
+		if err := client.Get(context.Background(), "zones", &result); err != nil {
+			t.Fatalf("http_version %q: unexpected error: %v", tt.version, err)
+		}
+		if result.ProtoMajor != tt.wantMajor {
+			t.Errorf("http_version %q: request used HTTP/%d, want HTTP/%d", tt.version, result.ProtoMajor, tt.wantMajor)
+		}
+	}
+}
+
+func TestNewClient_APIPathPrefix(t *testing.T) {
+	tests := []struct {
+		prefix   string
+		wantPath string
+	}{
+		{"", "/api/v2/zones"},
+		{"/poweradmin/api/v2", "/poweradmin/api/v2/zones"},
+		{"poweradmin/api/v2/", "/poweradmin/api/v2/zones"},
+		{"/", "/zones"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.prefix, func(t *testing.T) {
+			var gotPath string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotPath = r.URL.Path
+				respondJSON(t, w, ZoneListResponse{})
+			}))
+			t.Cleanup(server.Close)
+
+			client, err := NewClient(context.Background(), &PoweradminProviderModel{
+				ApiUrl:        types.StringValue(server.URL),
+				ApiKey:        types.StringValue("test-key"),
+				ApiPathPrefix: types.StringValue(tt.prefix),
+			})
+			if err != nil {
+				t.Fatalf("NewClient() error = %v", err)
+			}
+			if _, err := client.ListZones(context.Background()); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if gotPath != tt.wantPath {
+				t.Errorf("expected request path %q, got %q", tt.wantPath, gotPath)
+			}
+		})
+	}
+
+	for _, bad := range []string{"https://dns.example.com/api/v2", "/api/v2?x=1", "/api v2"} {
+		_, err := NewClient(context.Background(), &PoweradminProviderModel{
+			ApiUrl:        types.StringValue("https://dns.example.com"),
+			ApiKey:        types.StringValue("test-key"),
+			ApiPathPrefix: types.StringValue(bad),
+		})
+		if err == nil {
+			t.Errorf("expected error for api_path_prefix %q", bad)
+		}
+	}
+}
+
+func TestNewClient_DNSCheckServers(t *testing.T) {
+	servers, diags := types.ListValueFrom(context.Background(), types.StringType,
+		[]string{"192.0.2.53", "2001:db8::53", "[2001:db8::54]:5353", "ns1.example.com", "ns2.example.com:5353"})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	client, err := NewClient(context.Background(), &PoweradminProviderModel{
+		ApiUrl:          types.StringValue("https://dns.example.com"),
+		ApiKey:          types.StringValue("test-key"),
+		DNSCheckServers: servers,
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	want := []string{"192.0.2.53:53", "[2001:db8::53]:53", "[2001:db8::54]:5353", "ns1.example.com:53", "ns2.example.com:5353"}
+	if !reflect.DeepEqual(client.DNSCheckServers, want) {
+		t.Errorf("expected servers %v, got %v", want, client.DNSCheckServers)
+	}
+
+	for _, bad := range []string{"", "udp://192.0.2.53", "192.0.2.53:0", "ns1.example.com:dns", "[2001:db8::53"} {
+		servers, _ := types.ListValueFrom(context.Background(), types.StringType, []string{bad})
+		_, err := NewClient(context.Background(), &PoweradminProviderModel{
+			ApiUrl:          types.StringValue("https://dns.example.com"),
+			ApiKey:          types.StringValue("test-key"),
+			DNSCheckServers: servers,
+		})
+		if err == nil {
+			t.Errorf("expected error for dns_check_servers entry %q", bad)
+		}
+	}
+}
+
+func TestNewClient_CircuitBreakerThreshold(t *testing.T) {
+	base := PoweradminProviderModel{
+		ApiUrl: types.StringValue("https://dns.example.com"),
+		ApiKey: types.StringValue("test-key"),
+	}
+	client, err := NewClient(context.Background(), &base)
+	if err != nil || client.breaker.threshold != defaultCircuitBreakerThreshold {
+		t.Errorf("expected default threshold, got %v (err %v)", client, err)
+	}
+
+	disabled := base
+	disabled.CircuitBreakerThreshold = types.Int64Value(0)
+	if client, err = NewClient(context.Background(), &disabled); err != nil || client.breaker.threshold != 0 {
+		t.Errorf("expected a disabled breaker, got %v (err %v)", client, err)
+	}
+
+	invalid := base
+	invalid.CircuitBreakerThreshold = types.Int64Value(-1)
+	if _, err := NewClient(context.Background(), &invalid); err == nil {
+		t.Error("expected error for a negative circuit_breaker_threshold")
+	}
+}
+
+func TestNewClient_RRSetSizeWarningThreshold(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   types.Int64
+		want    int
+		wantErr bool
+	}{
+		{"default", types.Int64Null(), defaultRRSetSizeWarningThreshold, false},
+		{"custom", types.Int64Value(500), 500, false},
+		{"disabled", types.Int64Value(0), 0, false},
+		{"negative", types.Int64Value(-1), 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := NewClient(context.Background(), &PoweradminProviderModel{
+				ApiUrl:                    types.StringValue("https://dns.example.com"),
+				ApiKey:                    types.StringValue("test-key"),
+				RRSetSizeWarningThreshold: tt.value,
+			})
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewClient() error = %v", err)
+			}
+			if client.RRSetSizeWarningThreshold != tt.want {
+				t.Errorf("expected threshold %d, got %d", tt.want, client.RRSetSizeWarningThreshold)
+			}
+		})
+	}
+}
+
+func TestBuildUserAgent(t *testing.T) {
+	tests := []struct {
+		name             string
+		providerVersion  string
+		terraformVersion string
+		appendEnv        string
+		suffix           string
+		want             string
+	}{
+		{"full", "1.2.3", "1.9.0", "", "pipeline/dns-prod", "terraform-provider-poweradmin/1.2.3 Terraform/1.9.0 pipeline/dns-prod"},
+		{"no suffix", "1.2.3", "1.9.0", "", "", "terraform-provider-poweradmin/1.2.3 Terraform/1.9.0"},
+		{"dev build without terraform version", "", "", "", "", "terraform-provider-poweradmin/dev"},
+		{"environment", "1.2.3", "1.9.0", "ci/42", " pipeline/dns-prod ", "terraform-provider-poweradmin/1.2.3 Terraform/1.9.0 ci/42 pipeline/dns-prod"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TF_APPEND_USER_AGENT", tt.appendEnv)
+			if got := buildUserAgent(tt.providerVersion, tt.terraformVersion, tt.suffix); got != tt.want {
+				t.Errorf("buildUserAgent() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUserAgentHeader(t *testing.T) {
+	var got string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("User-Agent")
+		respondJSON(t, w, ZoneListResponse{})
+	})
+	client.UserAgent = "terraform-provider-poweradmin/1.2.3 Terraform/1.9.0"
+
+	if _, err := client.ListZones(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != client.UserAgent {
+		t.Errorf("expected User-Agent %q, got %q", client.UserAgent, got)
+	}
+}
+
+func TestCurlCommand(t *testing.T) {
+	newRequest := func(t *testing.T, client *Client, body string) *http.Request {
+		t.Helper()
+		req, err := http.NewRequest(http.MethodPost, "https://dns.example.com/api/v2/users", strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("failed to create request: %v", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		if client.APIKey != "" {
+			req.Header.Set("Authorization", "Bearer "+client.APIKey)
+			req.Header.Set("X-API-Key", client.APIKey)
+		} else {
+			req.SetBasicAuth(client.Username, client.Password)
+		}
+		return req
+	}
+
+	t.Run("api key", func(t *testing.T) {
+		client := &Client{HTTPClient: http.DefaultClient, APIKey: "secret-key"}
+		body := `{"username":"o'brien","password":"hunter2","nested":{"api_key":"k"}}`
+		got := client.curlCommand(newRequest(t, client, body), []byte(body))
+
+		want := `curl -X POST 'https://dns.example.com/api/v2/users' -H 'Authorization: Bearer <redacted>' -H 'Content-Type: application/json' -H 'X-Api-Key: <redacted>' ` +
+			`--data '{"nested":{"api_key":"<redacted>"},"password":"<redacted>","username":"o'\''brien"}'`
+		if got != want {
+			t.Errorf("curlCommand() =\n%s\nwant\n%s", got, want)
+		}
+		if strings.Contains(got, "secret-key") || strings.Contains(got, "hunter2") {
+			t.Errorf("expected secrets to be redacted, got %s", got)
+		}
+	})
+
+	t.Run("basic auth", func(t *testing.T) {
+		client := &Client{HTTPClient: http.DefaultClient, Username: "admin", Password: "hunter2"}
+		got := client.curlCommand(newRequest(t, client, ""), nil)
+
+		if !strings.Contains(got, `-u 'admin:<redacted>'`) || strings.Contains(got, "Authorization") {
+			t.Errorf("expected redacted basic auth, got %s", got)
+		}
+		if strings.Contains(got, "--data") {
+			t.Errorf("expected no body, got %s", got)
+		}
+	})
+}
+
+func TestLogCurlCommands(t *testing.T) {
+	var logs bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &logs)
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(t, w, ZoneListResponse{})
+	})
+	client.LogCurlCommands = true
+
+	if _, err := client.ListZones(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(logs.String(), "curl -X GET") || strings.Contains(logs.String(), "test-key") {
+		t.Errorf("expected a redacted curl command in the logs, got %s", logs.String())
+	}
+}
+
+func TestReauthenticateOn401(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "api-key")
+	if err := os.WriteFile(keyFile, []byte("old-key\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("X-API-Key") != "new-key" {
+			respondError(t, w, http.StatusUnauthorized, "Invalid API key")
+			return
+		}
+		respondJSON(t, w, ZoneListResponse{})
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := NewClient(context.Background(), &PoweradminProviderModel{
+		ApiUrl:     types.StringValue(server.URL),
+		ApiKeyFile: types.StringValue(keyFile),
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	ctx := context.Background()
+
+	// Unchanged key: the 401 is reported without a replay
+	if _, err := client.ListZones(ctx); err == nil {
+		t.Fatal("expected 401 while the key file holds the old key")
+	}
+	if requests != 1 {
+		t.Errorf("expected 1 request without a key change, got %d", requests)
+	}
+
+	// Rotated key: the request is replayed once with the new key
+	if err := os.WriteFile(keyFile, []byte("new-key"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	requests = 0
+	if _, err := client.ListZones(ctx); err != nil {
+		t.Fatalf("expected replay with rotated key to succeed, got %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("expected the request to be replayed once, got %d requests", requests)
+	}
+	if client.apiKey() != "new-key" {
+		t.Errorf("expected the rotated key to be kept, got %q", client.apiKey())
+	}
+}
+
+func TestNewClient_APIKeyFile(t *testing.T) {
+	dir := t.TempDir()
+	empty := filepath.Join(dir, "empty")
+	if err := os.WriteFile(empty, []byte("  \n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{empty, filepath.Join(dir, "missing")} {
+		_, err := NewClient(context.Background(), &PoweradminProviderModel{
+			ApiUrl:     types.StringValue("https://dns.example.com"),
+			ApiKeyFile: types.StringValue(path),
+		})
+		if err == nil {
+			t.Errorf("expected error for api_key_file %q", path)
+		}
+	}
+}
+
+func TestSlowRequestWarning(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/zones/2" {
+			time.Sleep(30 * time.Millisecond)
+		}
+		respondJSON(t, w, ZoneResponse{Zone: Zone{ID: 1, Name: "example.com"}})
+	})
+	client.SlowRequestThreshold = 20 * time.Millisecond
+	ctx := context.Background()
+
+	for _, id := range []int{1, 2, 2} {
+		if _, err := client.GetZone(ctx, id); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if got := client.slowRequests.Load(); got != 2 {
+		t.Errorf("expected 2 slow requests, got %d", got)
+	}
+	if got := time.Duration(client.slowRequestTime.Load()); got < 60*time.Millisecond {
+		t.Errorf("expected at least 60ms of slow requests, got %s", got)
+	}
+
+	// A zero threshold disables the warning
+	client.SlowRequestThreshold = 0
+	if _, err := client.GetZone(ctx, 2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := client.slowRequests.Load(); got != 2 {
+		t.Errorf("expected no further slow requests, got %d", got)
+	}
+}
+
+func TestNewClient_SlowRequestThreshold(t *testing.T) {
+	tests := []struct {
+		value   types.String
+		want    time.Duration
+		wantErr bool
+	}{
+		{types.StringNull(), defaultSlowRequestThreshold, false},
+		{types.StringValue("750ms"), 750 * time.Millisecond, false},
+		{types.StringValue("0s"), 0, false},
+		{types.StringValue("-1s"), 0, true},
+		{types.StringValue("slow"), 0, true},
+	}
+	for _, tt := range tests {
+		client, err := NewClient(context.Background(), &PoweradminProviderModel{
+			ApiUrl:               types.StringValue("https://dns.example.com"),
+			ApiKey:               types.StringValue("test-key"),
+			SlowRequestThreshold: tt.value,
+		})
+		if (err != nil) != tt.wantErr {
+			t.Errorf("slow_request_threshold %s: error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && client.SlowRequestThreshold != tt.want {
+			t.Errorf("slow_request_threshold %s: got %s, want %s", tt.value, client.SlowRequestThreshold, tt.want)
+		}
+	}
+}
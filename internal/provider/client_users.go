@@ -5,9 +5,13 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 )
 
+// errUserNotFound is wrapped by FindUserByUsername when no user matches.
+var errUserNotFound = errors.New("user not found")
+
 // GetUser retrieves a user by ID.
 func (c *Client) GetUser(ctx context.Context, userID int) (*User, error) {
 	path := fmt.Sprintf("users/%d", userID)
@@ -18,6 +22,16 @@ func (c *Client) GetUser(ctx context.Context, userID int) (*User, error) {
 	return &result.User, nil
 }
 
+// GetCurrentUser retrieves the user the client is authenticated as; with an
+// API key, the user owning the key.
+func (c *Client) GetCurrentUser(ctx context.Context) (*User, error) {
+	var result UserResponse
+	if err := c.Get(ctx, "users/me", &result); err != nil {
+		return nil, err
+	}
+	return &result.User, nil
+}
+
 // ListUsers retrieves all users.
 func (c *Client) ListUsers(ctx context.Context) ([]User, error) {
 	var result UserListResponse
@@ -33,6 +47,7 @@ func (c *Client) CreateUser(ctx context.Context, req CreateUserRequest) (*User,
 	if err := c.Post(ctx, "users", req, &result); err != nil {
 		return nil, err
 	}
+	c.userList.invalidate(nil)
 
 	// Fetch the created user to get full details
 	return c.GetUser(ctx, result.UserID)
@@ -45,6 +60,7 @@ func (c *Client) UpdateUser(ctx context.Context, userID int, req UpdateUserReque
 	if err := c.Put(ctx, path, req, &result); err != nil {
 		return nil, err
 	}
+	c.userList.invalidate(nil)
 
 	// Fetch the updated user to get full details
 	return c.GetUser(ctx, userID)
@@ -53,6 +69,7 @@ func (c *Client) UpdateUser(ctx context.Context, userID int, req UpdateUserReque
 // DeleteUser deletes a user.
 func (c *Client) DeleteUser(ctx context.Context, userID int, transferToUserID *int) error {
 	path := fmt.Sprintf("users/%d", userID)
+	defer c.userList.invalidate(nil)
 
 	if transferToUserID != nil {
 		// Include transfer_to_user_id in request body
@@ -66,9 +83,33 @@ func (c *Client) DeleteUser(ctx context.Context, userID int, transferToUserID *i
 	return c.Delete(ctx, path)
 }
 
-// FindUserByUsername finds a user by username.
+// ListUserPermissions lists the permissions assigned directly to a user,
+// excluding those granted through its permission template.
+func (c *Client) ListUserPermissions(ctx context.Context, userID int) ([]Permission, error) {
+	path := fmt.Sprintf("users/%d/permissions", userID)
+	var result PermissionListResponse
+	if err := c.Get(ctx, path, &result); err != nil {
+		return nil, err
+	}
+	return result.Permissions, nil
+}
+
+// AssignUserPermission assigns a permission directly to a user.
+func (c *Client) AssignUserPermission(ctx context.Context, userID int, permissionID int) error {
+	path := fmt.Sprintf("users/%d/permissions", userID)
+	req := UserPermissionRequest{PermissionID: permissionID}
+	return c.Post(ctx, path, req, nil)
+}
+
+// RevokeUserPermission removes a directly assigned permission from a user.
+func (c *Client) RevokeUserPermission(ctx context.Context, userID int, permissionID int) error {
+	path := fmt.Sprintf("users/%d/permissions/%d", userID, permissionID)
+	return c.Delete(ctx, path)
+}
+
+// FindUserByUsername finds a user by username in the per-run user listing.
 func (c *Client) FindUserByUsername(ctx context.Context, username string) (*User, error) {
-	users, err := c.ListUsers(ctx)
+	users, err := c.ListUsersCached(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -79,5 +120,5 @@ func (c *Client) FindUserByUsername(ctx context.Context, username string) (*User
 		}
 	}
 
-	return nil, fmt.Errorf("user not found: %s", username)
+	return nil, fmt.Errorf("%w: %s", errUserNotFound, username)
 }
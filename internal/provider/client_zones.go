@@ -6,6 +6,12 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/sync/errgroup"
 )
 
 // GetZone retrieves a zone by ID.
@@ -15,24 +21,108 @@ func (c *Client) GetZone(ctx context.Context, zoneID int) (*Zone, error) {
 	if err := c.Get(ctx, path, &result); err != nil {
 		return nil, err
 	}
+	c.zoneTypes.Store(int64(zoneID), result.Zone.Type)
 	return &result.Zone, nil
 }
 
-// ListZones retrieves all zones.
+// ZoneFilter selects zones in a listing; zero fields match every zone.
+type ZoneFilter struct {
+	Account string
+	// Owner is the ID of a user owning the zone.
+	Owner int
+	// Type is matched case-insensitively.
+	Type string
+	// DNSSECSigned keeps only signed (true) or unsigned (false) zones.
+	DNSSECSigned *bool
+}
+
+// ListZones retrieves all zones, fetching further pages concurrently.
 func (c *Client) ListZones(ctx context.Context) ([]Zone, error) {
-	var result ZoneListResponse
-	if err := c.Get(ctx, "zones", &result); err != nil {
+	return c.ListZonesMatching(ctx, ZoneFilter{})
+}
+
+// ListZonesMatching retrieves the zones matching filter. The filters are
+// passed to the server as query parameters in case it narrows the listing,
+// but the API does not document them, so they are always applied again on
+// the response. The owner is confirmed with one owners lookup per remaining
+// zone, since the API has no listing of a user's zones; lookups run
+// concurrently like further pages.
+func (c *Client) ListZonesMatching(ctx context.Context, filter ZoneFilter) ([]Zone, error) {
+	query := url.Values{}
+	if filter.Account != "" {
+		query.Set("account", filter.Account)
+	}
+	if filter.Owner > 0 {
+		query.Set("owner", strconv.Itoa(filter.Owner))
+	}
+	if filter.Type != "" {
+		query.Set("type", strings.ToUpper(filter.Type))
+	}
+	if filter.DNSSECSigned != nil {
+		query.Set("dnssec", strconv.FormatBool(*filter.DNSSECSigned))
+	}
+
+	zones, err := listAllPages(ctx, "zones", query, func(ctx context.Context, path string) ([]Zone, *Pagination, error) {
+		var result ZoneListResponse
+		if err := c.Get(ctx, path, &result); err != nil {
+			return nil, nil, err
+		}
+		return result.Zones, result.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	matched := zones[:0]
+	for _, zone := range zones {
+		if filter.Account != "" && zone.Account != filter.Account {
+			continue
+		}
+		if filter.Type != "" && !strings.EqualFold(zone.Type, filter.Type) {
+			continue
+		}
+		if filter.DNSSECSigned != nil && zone.DNSSECSigned != *filter.DNSSECSigned {
+			continue
+		}
+		matched = append(matched, zone)
+	}
+	if filter.Owner <= 0 {
+		return matched, nil
+	}
+
+	owned := make([]bool, len(matched))
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(maxConcurrentPages)
+	for i, zone := range matched {
+		g.Go(func() error {
+			owners, err := c.ListZoneOwners(gctx, zone.ID)
+			if err != nil {
+				return fmt.Errorf("failed to check owners of zone %s: %w", zone.Name, err)
+			}
+			owned[i] = slices.ContainsFunc(owners, func(o ZoneOwner) bool { return o.UserID == filter.Owner })
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
 		return nil, err
 	}
-	return result.Zones, nil
+	result := matched[:0]
+	for i, zone := range matched {
+		if owned[i] {
+			result = append(result, zone)
+		}
+	}
+	return result, nil
 }
 
 // CreateZone creates a new zone and returns the zone ID.
 func (c *Client) CreateZone(ctx context.Context, req CreateZoneRequest) (int, error) {
+	req.Name = toASCIIName(req.Name)
 	var result CreateZoneResponse
 	if err := c.Post(ctx, "zones", req, &result); err != nil {
 		return 0, err
 	}
+	c.zoneList.invalidate(nil)
 	return result.ZoneID, nil
 }
 
@@ -43,13 +133,34 @@ func (c *Client) UpdateZone(ctx context.Context, zoneID int, req UpdateZoneReque
 	if err := c.Put(ctx, path, req, &result); err != nil {
 		return nil, err
 	}
+	if result.Zone.ID == 0 {
+		// Empty success body: read back the updated zone instead
+		return c.GetZone(ctx, zoneID)
+	}
+	if result.Zone.Type != "" {
+		c.zoneTypes.Store(int64(zoneID), result.Zone.Type)
+	}
 	return &result.Zone, nil
 }
 
 // DeleteZone deletes a zone.
 func (c *Client) DeleteZone(ctx context.Context, zoneID int) error {
 	path := fmt.Sprintf("zones/%d", zoneID)
-	return c.Delete(ctx, path)
+	if err := c.Delete(ctx, path); err != nil {
+		return err
+	}
+	c.zoneList.invalidate(nil)
+	return nil
+}
+
+// GetZoneDefaults retrieves the server's default NS set, hostmaster, TTL, and
+// allowed record types.
+func (c *Client) GetZoneDefaults(ctx context.Context) (*ZoneDefaults, error) {
+	var result ZoneDefaultsResponse
+	if err := c.Get(ctx, "config/dns", &result); err != nil {
+		return nil, err
+	}
+	return &result.Defaults, nil
 }
 
 // GetZoneName returns the zone's name, memoized per client instance since
@@ -68,18 +179,56 @@ func (c *Client) GetZoneName(ctx context.Context, zoneID int64) (string, error)
 	return zone.Name, nil
 }
 
-// FindZoneByName finds a zone by its name.
+// GetZoneType returns the zone's type, reusing the type seen by the last
+// GetZone/UpdateZone call so records sharing a zone cost one lookup per run.
+func (c *Client) GetZoneType(ctx context.Context, zoneID int64) (string, error) {
+	if cached, ok := c.zoneTypes.Load(zoneID); ok {
+		if zoneType, ok := cached.(string); ok {
+			return zoneType, nil
+		}
+	}
+	zone, err := c.GetZone(ctx, int(zoneID))
+	if err != nil {
+		return "", err
+	}
+	return zone.Type, nil
+}
+
+// FindZoneByName finds a zone by its name, given in Unicode or punycode form.
 func (c *Client) FindZoneByName(ctx context.Context, name string) (*Zone, error) {
 	zones, err := c.ListZones(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	ascii := toASCIIName(name)
 	for _, zone := range zones {
-		if zone.Name == name {
+		if zone.Name == name || zone.Name == ascii {
 			return &zone, nil
 		}
 	}
 
 	return nil, fmt.Errorf("zone not found: %s", name)
 }
+
+// ListZoneOwners lists the users owning a zone.
+func (c *Client) ListZoneOwners(ctx context.Context, zoneID int) ([]ZoneOwner, error) {
+	path := fmt.Sprintf("zones/%d/owners", zoneID)
+	var result ZoneOwnerListResponse
+	if err := c.Get(ctx, path, &result); err != nil {
+		return nil, err
+	}
+	return result.Owners, nil
+}
+
+// AddZoneOwner makes a user an additional owner of a zone.
+func (c *Client) AddZoneOwner(ctx context.Context, zoneID int, userID int) error {
+	path := fmt.Sprintf("zones/%d/owners", zoneID)
+	return c.Post(ctx, path, ZoneOwnerRequest{UserID: userID}, nil)
+}
+
+// RemoveZoneOwner removes a user from a zone's owners.
+func (c *Client) RemoveZoneOwner(ctx context.Context, zoneID int, userID int) error {
+	path := fmt.Sprintf("zones/%d/owners/%d", zoneID, userID)
+	return c.Delete(ctx, path)
+}
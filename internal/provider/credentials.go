@@ -0,0 +1,114 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultCredentialsProfile is the profile read when only credentials_file is set.
+const defaultCredentialsProfile = "default"
+
+// credentialsProfile is one table of a shared credentials file.
+type credentialsProfile struct {
+	APIURL   string `toml:"api_url"`
+	APIKey   string `toml:"api_key"`
+	Username string `toml:"username"`
+	Password string `toml:"password"`
+}
+
+// defaultCredentialsFile returns ~/.config/poweradmin/credentials.toml.
+func defaultCredentialsFile() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("cannot locate the default credentials file: %w", err)
+	}
+	return filepath.Join(home, ".config", "poweradmin", "credentials.toml"), nil
+}
+
+// loadCredentialsProfile reads the named profile from a TOML credentials
+// file with one table per profile. A leading "~/" in path is expanded.
+func loadCredentialsProfile(path, profile string) (*credentialsProfile, error) {
+	if rest, ok := strings.CutPrefix(path, "~/"); ok {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, fmt.Errorf("cannot expand %q: %w", path, err)
+		}
+		path = filepath.Join(home, rest)
+	}
+
+	var profiles map[string]credentialsProfile
+	meta, err := toml.DecodeFile(path, &profiles)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("credentials file %s does not exist", path)
+		}
+		return nil, fmt.Errorf("failed to read credentials file %s: %w", path, err)
+	}
+	// Misspelled keys would otherwise be dropped silently
+	if undecoded := meta.Undecoded(); len(undecoded) > 0 {
+		return nil, fmt.Errorf("credentials file %s has unknown key %q; profiles may set api_url, api_key, username and password", path, undecoded[0].String())
+	}
+
+	p, ok := profiles[profile]
+	if !ok {
+		names := make([]string, 0, len(profiles))
+		for name := range profiles {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("profile %q not found in credentials file %s (available: %s)", profile, path, strings.Join(names, ", "))
+	}
+	return &p, nil
+}
+
+// applyCredentialsProfile fills the connection settings of config from the
+// selected profile of the shared credentials file. Settings in the provider
+// configuration take precedence: api_url is filled only when unset, and the
+// profile's credentials are used only when no authentication is configured.
+// Nothing is read unless profile or credentials_file is set.
+func applyCredentialsProfile(config *PoweradminProviderModel) error {
+	profile := config.Profile.ValueString()
+	path := config.CredentialsFile.ValueString()
+	if profile == "" && path == "" {
+		return nil
+	}
+	if profile == "" {
+		profile = defaultCredentialsProfile
+	}
+	if path == "" {
+		var err error
+		if path, err = defaultCredentialsFile(); err != nil {
+			return err
+		}
+	}
+
+	p, err := loadCredentialsProfile(path, profile)
+	if err != nil {
+		return err
+	}
+
+	if config.ApiUrl.ValueString() == "" && p.APIURL != "" {
+		config.ApiUrl = types.StringValue(p.APIURL)
+	}
+	if config.ApiKey.ValueString() == "" && config.ApiKeyFile.ValueString() == "" &&
+		config.VaultAPIKeyPath.ValueString() == "" && config.Username.ValueString() == "" {
+		if p.APIKey != "" {
+			config.ApiKey = types.StringValue(p.APIKey)
+		}
+		if p.Username != "" {
+			config.Username = types.StringValue(p.Username)
+			config.Password = types.StringValue(p.Password)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const testCredentialsFile = `
+[default]
+api_url = "https://dns.example.com"
+api_key = "default-key"
+
+[staging]
+api_url  = "https://dns.staging.example.com"
+username = "deploy"
+password = "s3cret"
+`
+
+func writeCredentialsFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "credentials.toml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestApplyCredentialsProfile(t *testing.T) {
+	path := writeCredentialsFile(t, testCredentialsFile)
+
+	tests := []struct {
+		name         string
+		config       PoweradminProviderModel
+		wantURL      string
+		wantKey      string
+		wantUsername string
+		wantPassword string
+		wantErr      string
+	}{
+		{
+			name:    "no profile leaves the configuration alone",
+			config:  PoweradminProviderModel{ApiUrl: types.StringValue("https://other.example.com")},
+			wantURL: "https://other.example.com",
+		},
+		{
+			name:    "default profile when only the file is set",
+			config:  PoweradminProviderModel{CredentialsFile: types.StringValue(path)},
+			wantURL: "https://dns.example.com",
+			wantKey: "default-key",
+		},
+		{
+			name:         "named profile with basic auth",
+			config:       PoweradminProviderModel{CredentialsFile: types.StringValue(path), Profile: types.StringValue("staging")},
+			wantURL:      "https://dns.staging.example.com",
+			wantUsername: "deploy",
+			wantPassword: "s3cret",
+		},
+		{
+			name: "provider block takes precedence",
+			config: PoweradminProviderModel{
+				CredentialsFile: types.StringValue(path),
+				ApiUrl:          types.StringValue("https://override.example.com"),
+				ApiKeyFile:      types.StringValue("/run/secrets/key"),
+			},
+			wantURL: "https://override.example.com",
+		},
+		{
+			name:    "unknown profile",
+			config:  PoweradminProviderModel{CredentialsFile: types.StringValue(path), Profile: types.StringValue("prod")},
+			wantErr: `profile "prod" not found in credentials file ` + path + ` (available: default, staging)`,
+		},
+		{
+			name:    "missing file",
+			config:  PoweradminProviderModel{CredentialsFile: types.StringValue(path + ".missing")},
+			wantErr: "does not exist",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := tt.config
+			err := applyCredentialsProfile(&config)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := config.ApiUrl.ValueString(); got != tt.wantURL {
+				t.Errorf("api_url = %q, want %q", got, tt.wantURL)
+			}
+			if got := config.ApiKey.ValueString(); got != tt.wantKey {
+				t.Errorf("api_key = %q, want %q", got, tt.wantKey)
+			}
+			if got := config.Username.ValueString(); got != tt.wantUsername {
+				t.Errorf("username = %q, want %q", got, tt.wantUsername)
+			}
+			if got := config.Password.ValueString(); got != tt.wantPassword {
+				t.Errorf("password = %q, want %q", got, tt.wantPassword)
+			}
+		})
+	}
+}
+
+func TestLoadCredentialsProfile_UnknownKey(t *testing.T) {
+	path := writeCredentialsFile(t, "[default]\napi_url = \"https://dns.example.com\"\napikey = \"typo\"\n")
+	_, err := loadCredentialsProfile(path, "default")
+	if err == nil || !strings.Contains(err.Error(), `unknown key "default.apikey"`) {
+		t.Fatalf("expected an unknown key error, got %v", err)
+	}
+}
+
+func TestProviderConfigure_Profile(t *testing.T) {
+	path := writeCredentialsFile(t, testCredentialsFile)
+	req := testConfigureRequest(t, false, map[string]tftypes.Value{
+		"credentials_file": tftypes.NewValue(tftypes.String, path),
+		"profile":          tftypes.NewValue(tftypes.String, "staging"),
+	})
+	var resp provider.ConfigureResponse
+	New("test")().Configure(context.Background(), req, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	client, ok := resp.ResourceData.(*Client)
+	if !ok {
+		t.Fatalf("expected a client, got %T", resp.ResourceData)
+	}
+	if client.BaseURL != "https://dns.staging.example.com" || client.Username != "deploy" || client.Password != "s3cret" {
+		t.Errorf("expected the staging profile, got %s as %q", client.BaseURL, client.Username)
+	}
+}
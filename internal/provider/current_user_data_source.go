@@ -0,0 +1,182 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CurrentUserDataSource{}
+
+func NewCurrentUserDataSource() datasource.DataSource {
+	return &CurrentUserDataSource{}
+}
+
+// CurrentUserDataSource defines the data source implementation.
+type CurrentUserDataSource struct {
+	client *Client
+}
+
+// CurrentUserDataSourceModel describes the data source data model.
+type CurrentUserDataSourceModel struct {
+	UserID        types.Int64  `tfsdk:"user_id"`
+	Username      types.String `tfsdk:"username"`
+	Fullname      types.String `tfsdk:"fullname"`
+	Email         types.String `tfsdk:"email"`
+	Active        types.Bool   `tfsdk:"active"`
+	IsAdmin       types.Bool   `tfsdk:"is_admin"`
+	PermTempl     types.Int64  `tfsdk:"perm_templ"`
+	PermTemplName types.String `tfsdk:"perm_templ_name"`
+	Permissions   types.Set    `tfsdk:"permissions"`
+	AuthMethod    types.String `tfsdk:"auth_method"`
+}
+
+func (d *CurrentUserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_current_user"
+}
+
+func (d *CurrentUserDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Returns the user the provider is authenticated as (for an API key, the user owning the key), with its permission template and admin status, " +
+			"so configurations can assert they run with the expected service account.",
+
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the authenticated user",
+				Computed:            true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Username of the authenticated user",
+				Computed:            true,
+			},
+			"fullname": schema.StringAttribute{
+				MarkdownDescription: "Full name of the user",
+				Computed:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email address of the user",
+				Computed:            true,
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is active",
+				Computed:            true,
+			},
+			"is_admin": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is an administrator (holds the `user_is_ueberuser` permission)",
+				Computed:            true,
+			},
+			"perm_templ": schema.Int64Attribute{
+				MarkdownDescription: "ID of the user's permission template; null when the server does not report one",
+				Computed:            true,
+			},
+			"perm_templ_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the user's permission template; null when it cannot be looked up",
+				Computed:            true,
+			},
+			"permissions": schema.SetAttribute{
+				MarkdownDescription: "Names of the permissions the user holds, when the server reports them",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"auth_method": schema.StringAttribute{
+				MarkdownDescription: "How the provider authenticates: `api_key` or `basic`",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *CurrentUserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CurrentUserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading current user")
+
+	user, err := d.client.GetCurrentUser(ctx)
+	if err != nil {
+		detail := fmt.Sprintf("Could not read the authenticated user: %s", err.Error())
+		if IsNotFoundError(err) {
+			detail += " The Poweradmin API may be too old to report the current user."
+		}
+		resp.Diagnostics.AddError("Error Reading Current User", detail)
+		return
+	}
+
+	data := currentUserModel(ctx, user, d.permTemplName(ctx, user.PermTempl), d.client.authMethod(), &resp.Diagnostics)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// permTemplName returns the name of a permission template, or "" when there
+// is none or it cannot be looked up; servers before 4.2.0 cannot list them.
+func (d *CurrentUserDataSource) permTemplName(ctx context.Context, id int) string {
+	if id == 0 {
+		return ""
+	}
+	templates, err := d.client.ListPermissionTemplates(ctx)
+	if err != nil {
+		tflog.Debug(ctx, "Could not look up permission template name", map[string]interface{}{
+			"perm_templ": id,
+			"error":      err.Error(),
+		})
+		return ""
+	}
+	for _, template := range templates {
+		if template.ID == id {
+			return template.Name
+		}
+	}
+	return ""
+}
+
+// currentUserModel converts the authenticated user to the data source model.
+func currentUserModel(ctx context.Context, user *User, permTemplName, authMethod string, diags *diag.Diagnostics) CurrentUserDataSourceModel {
+	data := CurrentUserDataSourceModel{
+		UserID:        types.Int64Value(int64(user.UserID)),
+		Username:      types.StringValue(user.Username),
+		Fullname:      types.StringValue(user.Fullname),
+		Email:         types.StringValue(user.Email),
+		Active:        types.BoolValue(user.Active),
+		IsAdmin:       types.BoolValue(user.IsAdmin),
+		PermTempl:     types.Int64Null(),
+		PermTemplName: types.StringNull(),
+		AuthMethod:    types.StringValue(authMethod),
+	}
+	if user.PermTempl != 0 {
+		data.PermTempl = types.Int64Value(int64(user.PermTempl))
+	}
+	if permTemplName != "" {
+		data.PermTemplName = types.StringValue(permTemplName)
+	}
+	var d diag.Diagnostics
+	data.Permissions, d = types.SetValueFrom(ctx, types.StringType, nonNilStrings(user.Permissions))
+	diags.Append(d...)
+	return data
+}
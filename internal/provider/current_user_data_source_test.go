@@ -0,0 +1,75 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestCurrentUser(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/users/me":
+			serveFixture(t, w, http.StatusOK, "user_get")
+		case "/api/v2/permission-templates":
+			serveFixture(t, w, http.StatusOK, "permission_templates_list")
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+	d := &CurrentUserDataSource{client: client}
+	ctx := context.Background()
+
+	user, err := client.GetCurrentUser(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var diags diag.Diagnostics
+	data := currentUserModel(ctx, user, d.permTemplName(ctx, user.PermTempl), client.authMethod(), &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if data.UserID.ValueInt64() != 7 || data.Username.ValueString() != "jane" || data.IsAdmin.ValueBool() {
+		t.Errorf("unexpected user: %+v", data)
+	}
+	if data.PermTempl.ValueInt64() != 2 || data.PermTemplName.ValueString() != "Zone Manager" {
+		t.Errorf("perm_templ = %s (%s), want 2 (Zone Manager)", data.PermTempl, data.PermTemplName)
+	}
+	if len(data.Permissions.Elements()) != 2 {
+		t.Errorf("expected 2 permissions, got %s", data.Permissions)
+	}
+	if data.AuthMethod.ValueString() != "api_key" {
+		t.Errorf("auth_method = %s, want api_key", data.AuthMethod)
+	}
+
+	client.APIKey, client.Username, client.Password = "", "jane", "secret"
+	if got := client.authMethod(); got != "basic" {
+		t.Errorf("authMethod() = %q, want basic", got)
+	}
+}
+
+func TestAccCurrentUserDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+data "poweradmin_current_user" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.poweradmin_current_user.test", "user_id"),
+					resource.TestCheckResourceAttrSet("data.poweradmin_current_user.test", "username"),
+					resource.TestCheckResourceAttrSet("data.poweradmin_current_user.test", "is_admin"),
+					resource.TestCheckResourceAttrSet("data.poweradmin_current_user.test", "auth_method"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,218 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &DKIMRecordFunction{}
+
+// txtChunkSize is the longest character-string a TXT record can hold.
+const txtChunkSize = 255
+
+// dkimMinRSABits is the smallest RSA key verifiers must accept (RFC 8301).
+const dkimMinRSABits = 1024
+
+func NewDKIMRecordFunction() function.Function {
+	return &DKIMRecordFunction{}
+}
+
+// DKIMRecordFunction builds the content of a <selector>._domainkey TXT record.
+type DKIMRecordFunction struct{}
+
+func (f *DKIMRecordFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "dkim_record"
+}
+
+func (f *DKIMRecordFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build a DKIM TXT record value from a public key",
+		MarkdownDescription: "Returns the `v=DKIM1` TXT content for a DKIM public key, to be published at `<selector>._domainkey`. " +
+			"Accepts a PEM public key (`PUBLIC KEY` or `RSA PUBLIC KEY`) or the raw base64 key, RSA (at least 1024 bits) or Ed25519; the key type is detected. " +
+			"Content longer than 255 characters is split into quoted 255-character strings, as TXT records require. " +
+			"An optional object may set `hash_algorithms` (list of `sha256`, `sha1`), `testing` (`t=y`) and `strict` (`t=s`).",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "public_key",
+				MarkdownDescription: "PEM-encoded or raw base64 DKIM public key",
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:                "options",
+			MarkdownDescription: "Optional settings, e.g. `{ hash_algorithms = [\"sha256\"], testing = true }`",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *DKIMRecordFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var publicKey string
+	var options []types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &publicKey, &options))
+	if resp.Error != nil {
+		return
+	}
+	if len(options) > 1 {
+		resp.Error = function.NewArgumentFuncError(2, "at most one options object may be given")
+		return
+	}
+
+	keyType, keyData, err := parseDKIMPublicKey(publicKey)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	var attrs map[string]attr.Value
+	if len(options) == 1 {
+		if attrs, err = dynamicAttributes(options[0]); err != nil {
+			resp.Error = function.NewArgumentFuncError(1, err.Error())
+			return
+		}
+	}
+	content, err := buildDKIMRecord(keyType, keyData, attrs)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, content))
+}
+
+// parseDKIMPublicKey decodes a PEM or raw base64 public key and returns the
+// DKIM key type and the base64 p= value: SubjectPublicKeyInfo for RSA, the
+// bare 32-byte key for Ed25519 (RFC 8463).
+func parseDKIMPublicKey(input string) (string, string, error) {
+	input = strings.TrimSpace(input)
+	var der []byte
+	pkcs1 := false
+	if block, _ := pem.Decode([]byte(input)); block != nil {
+		der = block.Bytes
+		pkcs1 = block.Type == "RSA PUBLIC KEY"
+	} else {
+		raw, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(input), ""))
+		if err != nil {
+			return "", "", fmt.Errorf("public key must be PEM or base64 encoded: %s", err)
+		}
+		if len(raw) == ed25519.PublicKeySize {
+			return "ed25519", base64.StdEncoding.EncodeToString(raw), nil
+		}
+		der = raw
+	}
+
+	var key interface{}
+	var err error
+	if pkcs1 {
+		key, err = x509.ParsePKCS1PublicKey(der)
+	} else {
+		key, err = x509.ParsePKIXPublicKey(der)
+		if err != nil {
+			// Raw base64 keys are sometimes the PKCS#1 form
+			if rsaKey, pkcs1Err := x509.ParsePKCS1PublicKey(der); pkcs1Err == nil {
+				key, err = rsaKey, nil
+			}
+		}
+	}
+	if err != nil {
+		return "", "", fmt.Errorf("could not parse public key: %s", err)
+	}
+
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		if k.N.BitLen() < dkimMinRSABits {
+			return "", "", fmt.Errorf("RSA key is %d bits; DKIM verifiers reject keys shorter than %d bits", k.N.BitLen(), dkimMinRSABits)
+		}
+		spki, err := x509.MarshalPKIXPublicKey(k)
+		if err != nil {
+			return "", "", fmt.Errorf("could not encode public key: %s", err)
+		}
+		return "rsa", base64.StdEncoding.EncodeToString(spki), nil
+	case ed25519.PublicKey:
+		return "ed25519", base64.StdEncoding.EncodeToString(k), nil
+	}
+	return "", "", fmt.Errorf("unsupported key type %T; DKIM supports RSA and Ed25519", key)
+}
+
+// buildDKIMRecord renders the DKIM record content, chunked when it exceeds
+// one TXT character-string.
+func buildDKIMRecord(keyType, keyData string, attrs map[string]attr.Value) (string, error) {
+	var unknown []string
+	for key := range attrs {
+		switch key {
+		case "hash_algorithms", "testing", "strict":
+		default:
+			unknown = append(unknown, key)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return "", fmt.Errorf("unsupported DKIM option(s): %s", strings.Join(unknown, ", "))
+	}
+
+	tags := []string{"v=DKIM1", "k=" + keyType}
+
+	hashes, err := attrStrings(attrs["hash_algorithms"])
+	if err != nil {
+		return "", fmt.Errorf("hash_algorithms: %w", err)
+	}
+	for _, h := range hashes {
+		if h != "sha256" && h != "sha1" {
+			return "", fmt.Errorf("hash_algorithms: %q must be sha256 or sha1", h)
+		}
+	}
+	if len(hashes) > 0 {
+		tags = append(tags, "h="+strings.Join(hashes, ":"))
+	}
+
+	var flags []string
+	for _, opt := range []struct{ key, flag string }{{"testing", "y"}, {"strict", "s"}} {
+		value, ok := attrs[opt.key]
+		if !ok || value.IsNull() {
+			continue
+		}
+		b, isBool := value.(types.Bool)
+		if !isBool {
+			return "", fmt.Errorf("%s: expected true or false", opt.key)
+		}
+		if b.ValueBool() {
+			flags = append(flags, opt.flag)
+		}
+	}
+	if len(flags) > 0 {
+		tags = append(tags, "t="+strings.Join(flags, ":"))
+	}
+
+	tags = append(tags, "p="+keyData)
+	return chunkTXTContent(strings.Join(tags, "; ")), nil
+}
+
+// chunkTXTContent splits content longer than one TXT character-string into
+// quoted 255-character strings; shorter content is returned unchanged.
+func chunkTXTContent(content string) string {
+	if len(content) <= txtChunkSize {
+		return content
+	}
+	var chunks []string
+	for len(content) > txtChunkSize {
+		chunks = append(chunks, `"`+content[:txtChunkSize]+`"`)
+		content = content[txtChunkSize:]
+	}
+	chunks = append(chunks, `"`+content+`"`)
+	return strings.Join(chunks, " ")
+}
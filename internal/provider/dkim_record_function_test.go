@@ -0,0 +1,150 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseDKIMPublicKey(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spki, _ := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+	pkcs1 := x509.MarshalPKCS1PublicKey(&rsaKey.PublicKey)
+	wantRSA := base64.StdEncoding.EncodeToString(spki)
+
+	edPub, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	edSPKI, _ := x509.MarshalPKIXPublicKey(edPub)
+	wantEd := base64.StdEncoding.EncodeToString(edPub)
+
+	// crypto/rsa refuses to generate short keys, so build a 512-bit modulus
+	weakSPKI, _ := x509.MarshalPKIXPublicKey(&rsa.PublicKey{N: new(big.Int).SetBit(big.NewInt(1), 511, 1), E: 65537})
+
+	tests := []struct {
+		name     string
+		input    string
+		wantType string
+		wantData string
+		wantErr  bool
+	}{
+		{"rsa pem", string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: spki})), "rsa", wantRSA, false},
+		{"rsa pkcs1 pem", string(pem.EncodeToMemory(&pem.Block{Type: "RSA PUBLIC KEY", Bytes: pkcs1})), "rsa", wantRSA, false},
+		{"rsa raw base64 wrapped", wantRSA[:64] + "\n" + wantRSA[64:], "rsa", wantRSA, false},
+		{"rsa raw pkcs1", base64.StdEncoding.EncodeToString(pkcs1), "rsa", wantRSA, false},
+		{"ed25519 pem", string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: edSPKI})), "ed25519", wantEd, false},
+		{"ed25519 raw", wantEd, "ed25519", wantEd, false},
+		{"weak rsa", base64.StdEncoding.EncodeToString(weakSPKI), "", "", true},
+		{"not base64", "not a key!", "", "", true},
+		{"garbage der", base64.StdEncoding.EncodeToString([]byte("definitely not a public key")), "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keyType, keyData, err := parseDKIMPublicKey(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseDKIMPublicKey() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if keyType != tt.wantType || keyData != tt.wantData {
+				t.Errorf("parseDKIMPublicKey() = %q, %q, want %q, %q", keyType, keyData, tt.wantType, tt.wantData)
+			}
+		})
+	}
+}
+
+func TestBuildDKIMRecord(t *testing.T) {
+	tests := []struct {
+		name    string
+		attrs   map[string]attr.Value
+		want    string
+		wantErr bool
+	}{
+		{"defaults", nil, "v=DKIM1; k=ed25519; p=KEY", false},
+		{
+			"all options",
+			map[string]attr.Value{
+				"hash_algorithms": spfStrings("sha256"),
+				"testing":         types.BoolValue(true),
+				"strict":          types.BoolValue(true),
+			},
+			"v=DKIM1; k=ed25519; h=sha256; t=y:s; p=KEY",
+			false,
+		},
+		{"testing off", map[string]attr.Value{"testing": types.BoolValue(false)}, "v=DKIM1; k=ed25519; p=KEY", false},
+		{"bad hash", map[string]attr.Value{"hash_algorithms": types.StringValue("md5")}, "", true},
+		{"non-bool flag", map[string]attr.Value{"testing": types.StringValue("yes")}, "", true},
+		{"unknown key", map[string]attr.Value{"selector": types.StringValue("s1")}, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildDKIMRecord("ed25519", "KEY", tt.attrs)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("buildDKIMRecord() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("buildDKIMRecord() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChunkTXTContent(t *testing.T) {
+	if got := chunkTXTContent("short"); got != "short" {
+		t.Errorf("short content should be unchanged, got %q", got)
+	}
+
+	long := strings.Repeat("a", txtChunkSize) + strings.Repeat("b", txtChunkSize) + "c"
+	want := `"` + strings.Repeat("a", txtChunkSize) + `" "` + strings.Repeat("b", txtChunkSize) + `" "c"`
+	if got := chunkTXTContent(long); got != want {
+		t.Errorf("chunkTXTContent() = %q, want %q", got, want)
+	}
+}
+
+func TestDKIMRecordFunctionRun(t *testing.T) {
+	ctx := context.Background()
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spki, _ := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+	keyPEM := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: spki}))
+
+	options := types.DynamicValue(types.ObjectValueMust(
+		map[string]attr.Type{"testing": types.BoolType},
+		map[string]attr.Value{"testing": types.BoolValue(true)},
+	))
+	variadic := types.TupleValueMust([]attr.Type{types.DynamicType}, []attr.Value{options})
+
+	req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(keyPEM), variadic})}
+	resp := function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+	NewDKIMRecordFunction().Run(ctx, req, &resp)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+	got := resp.Result.Value().(types.String).ValueString()
+	if !strings.HasPrefix(got, `"v=DKIM1; k=rsa; t=y; p=`) {
+		t.Errorf("unexpected result %q", got)
+	}
+	unquoted := strings.ReplaceAll(strings.ReplaceAll(got, `" "`, ""), `"`, "")
+	if !strings.HasSuffix(unquoted, base64.StdEncoding.EncodeToString(spki)) {
+		t.Errorf("result does not carry the full key: %q", got)
+	}
+}
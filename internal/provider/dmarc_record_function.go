@@ -0,0 +1,175 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &DMARCRecordFunction{}
+
+func NewDMARCRecordFunction() function.Function {
+	return &DMARCRecordFunction{}
+}
+
+// DMARCRecordFunction builds the content of a _dmarc TXT record.
+type DMARCRecordFunction struct{}
+
+func (f *DMARCRecordFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "dmarc_record"
+}
+
+func (f *DMARCRecordFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build a DMARC TXT record value",
+		MarkdownDescription: "Validates a DMARC policy and returns the `v=DMARC1` TXT content for the `_dmarc` record. " +
+			"Supported keys: `policy` (required: `none`, `quarantine` or `reject`), `subdomain_policy`, `pct` (0-100), " +
+			"`rua` and `ruf` (comma-separated `mailto:` or `https:` URIs), `adkim` and `aspf` (`r` or `s`), " +
+			"`fo` (colon-separated `0`, `1`, `d`, `s`) and `ri` (report interval in seconds). Tags are emitted in the conventional order.",
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:                "policy",
+				ElementType:         types.StringType,
+				MarkdownDescription: "DMARC settings, e.g. `{ policy = \"reject\", rua = \"mailto:dmarc@example.com\", pct = 100 }`",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *DMARCRecordFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var settings map[string]string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &settings))
+	if resp.Error != nil {
+		return
+	}
+
+	content, err := buildDMARCRecord(settings)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, content))
+}
+
+// dmarcTags maps the accepted keys to DMARC tags, in output order.
+var dmarcTags = []struct {
+	key      string
+	tag      string
+	validate func(string) error
+}{
+	{"policy", "p", validateDMARCPolicy},
+	{"subdomain_policy", "sp", validateDMARCPolicy},
+	{"pct", "pct", validateDMARCPercent},
+	{"rua", "rua", validateDMARCURIs},
+	{"ruf", "ruf", validateDMARCURIs},
+	{"adkim", "adkim", validateDMARCAlignment},
+	{"aspf", "aspf", validateDMARCAlignment},
+	{"fo", "fo", validateDMARCFailureOptions},
+	{"ri", "ri", validateDMARCInterval},
+}
+
+// buildDMARCRecord validates settings and renders the DMARC record content.
+func buildDMARCRecord(settings map[string]string) (string, error) {
+	known := make(map[string]bool, len(dmarcTags))
+	for _, t := range dmarcTags {
+		known[t.key] = true
+	}
+	var unknown []string
+	for key := range settings {
+		if !known[key] {
+			unknown = append(unknown, key)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return "", fmt.Errorf("unsupported DMARC setting(s): %s", strings.Join(unknown, ", "))
+	}
+	if strings.TrimSpace(settings["policy"]) == "" {
+		return "", fmt.Errorf("policy is required (none, quarantine or reject)")
+	}
+
+	parts := []string{"v=DMARC1"}
+	for _, t := range dmarcTags {
+		value, ok := settings[t.key]
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		if err := t.validate(value); err != nil {
+			return "", fmt.Errorf("%s: %w", t.key, err)
+		}
+		parts = append(parts, t.tag+"="+value)
+	}
+	return strings.Join(parts, "; "), nil
+}
+
+func validateDMARCPolicy(v string) error {
+	switch v {
+	case "none", "quarantine", "reject":
+		return nil
+	}
+	return fmt.Errorf("%q must be none, quarantine or reject", v)
+}
+
+func validateDMARCPercent(v string) error {
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 0 || n > 100 {
+		return fmt.Errorf("%q must be a whole number between 0 and 100", v)
+	}
+	return nil
+}
+
+func validateDMARCURIs(v string) error {
+	for _, uri := range strings.Split(v, ",") {
+		uri = strings.TrimSpace(uri)
+		lower := strings.ToLower(uri)
+		if !strings.HasPrefix(lower, "mailto:") && !strings.HasPrefix(lower, "https:") {
+			return fmt.Errorf("%q must be a mailto: or https: URI", uri)
+		}
+		if strings.ContainsAny(uri, " ;") {
+			return fmt.Errorf("%q must not contain spaces or semicolons", uri)
+		}
+		if lower == "mailto:" || lower == "https:" {
+			return fmt.Errorf("%q is missing an address", uri)
+		}
+	}
+	return nil
+}
+
+func validateDMARCAlignment(v string) error {
+	if v != "r" && v != "s" {
+		return fmt.Errorf("%q must be r (relaxed) or s (strict)", v)
+	}
+	return nil
+}
+
+func validateDMARCFailureOptions(v string) error {
+	for _, opt := range strings.Split(v, ":") {
+		switch opt {
+		case "0", "1", "d", "s":
+		default:
+			return fmt.Errorf("%q must be a colon-separated list of 0, 1, d and s", v)
+		}
+	}
+	return nil
+}
+
+func validateDMARCInterval(v string) error {
+	n, err := strconv.ParseUint(v, 10, 32)
+	if err != nil || n == 0 {
+		return fmt.Errorf("%q must be a positive number of seconds", v)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBuildDMARCRecord(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings map[string]string
+		want     string
+		wantErr  bool
+	}{
+		{"policy only", map[string]string{"policy": "none"}, "v=DMARC1; p=none", false},
+		{
+			"full policy in tag order",
+			map[string]string{"rua": "mailto:dmarc@example.com", "pct": "50", "policy": "reject", "adkim": "s", "fo": "1:d"},
+			"v=DMARC1; p=reject; pct=50; rua=mailto:dmarc@example.com; adkim=s; fo=1:d",
+			false,
+		},
+		{"multiple report uris", map[string]string{"policy": "quarantine", "rua": "mailto:a@example.com,https://dmarc.example.com/report"}, "v=DMARC1; p=quarantine; rua=mailto:a@example.com,https://dmarc.example.com/report", false},
+		{"missing policy", map[string]string{"pct": "100"}, "", true},
+		{"invalid policy", map[string]string{"policy": "block"}, "", true},
+		{"pct out of range", map[string]string{"policy": "reject", "pct": "150"}, "", true},
+		{"rua without scheme", map[string]string{"policy": "reject", "rua": "dmarc@example.com"}, "", true},
+		{"invalid alignment", map[string]string{"policy": "reject", "aspf": "x"}, "", true},
+		{"unknown key", map[string]string{"policy": "reject", "p": "none"}, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildDMARCRecord(tt.settings)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("buildDMARCRecord() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("buildDMARCRecord() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDMARCRecordFunctionRun(t *testing.T) {
+	ctx := context.Background()
+	settings, _ := types.MapValueFrom(ctx, types.StringType, map[string]string{"policy": "reject", "pct": "100"})
+
+	req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{settings})}
+	resp := function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+	NewDMARCRecordFunction().Run(ctx, req, &resp)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+	if got := resp.Result.Value().(types.String).ValueString(); got != "v=DMARC1; p=reject; pct=100" {
+		t.Errorf("unexpected result %q", got)
+	}
+}
@@ -0,0 +1,414 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DNSSECKeyResource{}
+var _ resource.ResourceWithImportState = &DNSSECKeyResource{}
+var _ resource.ResourceWithValidateConfig = &DNSSECKeyResource{}
+
+// dnssecKeyTypes are the key roles PowerDNS supports: key signing, zone
+// signing and combined signing keys.
+var dnssecKeyTypes = []string{"ksk", "zsk", "csk"}
+
+func NewDNSSECKeyResource() resource.Resource {
+	return &DNSSECKeyResource{}
+}
+
+// DNSSECKeyResource manages a single DNSSEC key of a zone.
+type DNSSECKeyResource struct {
+	client *Client
+}
+
+// DNSSECKeyResourceModel describes the resource data model.
+type DNSSECKeyResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	ZoneID    types.Int64  `tfsdk:"zone_id"`
+	KeyID     types.Int64  `tfsdk:"key_id"`
+	KeyType   types.String `tfsdk:"key_type"`
+	Algorithm types.String `tfsdk:"algorithm"`
+	Bits      types.Int64  `tfsdk:"bits"`
+	Active    types.Bool   `tfsdk:"active"`
+	Published types.Bool   `tfsdk:"published"`
+	DNSKey    types.String `tfsdk:"dnskey"`
+	DS        types.List   `tfsdk:"ds"`
+}
+
+func (r *DNSSECKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dnssec_key"
+}
+
+func (r *DNSSECKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a DNSSEC key of a zone. Keys are generated on the server; the algorithm, size and type cannot change afterwards, " +
+			"but a key can be activated, deactivated, published and unpublished in place. " +
+			"To roll a key over, add the new key with `active = false`, wait for its DNSKEY to propagate, activate it and deactivate the old key, " +
+			"then remove the old key once its signatures have expired. For a KSK, update the DS at the registrar (see `poweradmin_zone_ds_records`) before retiring the old key.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Composite identifier in the format `zone_id/key_id`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"zone_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the zone",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"key_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the key on the server",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"key_type": schema.StringAttribute{
+				MarkdownDescription: "Key type: `ksk` (key signing key), `zsk` (zone signing key) or `csk` (combined signing key)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"algorithm": schema.StringAttribute{
+				MarkdownDescription: "DNSSEC algorithm, e.g. `ECDSAP256SHA256`, `ED25519` or `RSASHA256`. Defaults to the server's default algorithm.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"bits": schema.Int64Attribute{
+				MarkdownDescription: "Key size in bits, for algorithms with a variable size such as RSA. Defaults to the server's default for the algorithm.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplaceIfConfigured(),
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the key signs the zone. Defaults to `true`; create a key inactive to pre-publish it during a rollover.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"published": schema.BoolAttribute{
+				MarkdownDescription: "Whether the DNSKEY record is published in the zone. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"dnskey": schema.StringAttribute{
+				MarkdownDescription: "The DNSKEY record of the key",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ds": schema.ListAttribute{
+				MarkdownDescription: "DS records of the key, for key signing keys",
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DNSSECKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig checks the key type and size.
+func (r *DNSSECKeyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DNSSECKeyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.KeyType.IsNull() && !data.KeyType.IsUnknown() {
+		keyType := data.KeyType.ValueString()
+		if !slices.Contains(dnssecKeyTypes, keyType) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("key_type"),
+				"Invalid Key Type",
+				fmt.Sprintf("key_type must be one of %s, got: %q", strings.Join(dnssecKeyTypes, ", "), keyType),
+			)
+		}
+	}
+	if !data.Bits.IsNull() && !data.Bits.IsUnknown() && data.Bits.ValueInt64() <= 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("bits"), "Invalid Key Size", "bits must be a positive number of bits.")
+	}
+}
+
+func (r *DNSSECKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DNSSECKeyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneID := data.ZoneID.ValueInt64()
+	createReq := CreateDNSSECKeyRequest{
+		KeyType:   data.KeyType.ValueString(),
+		Active:    data.Active.ValueBool(),
+		Published: data.Published.ValueBool(),
+	}
+	if !data.Algorithm.IsNull() && !data.Algorithm.IsUnknown() {
+		createReq.Algorithm = data.Algorithm.ValueString()
+	}
+	if !data.Bits.IsNull() && !data.Bits.IsUnknown() {
+		createReq.Bits = int(data.Bits.ValueInt64())
+	}
+
+	tflog.Debug(ctx, "Creating DNSSEC key", map[string]interface{}{
+		"zone_id":   zoneID,
+		"key_type":  createReq.KeyType,
+		"algorithm": createReq.Algorithm,
+		"active":    createReq.Active,
+	})
+
+	key, err := r.client.CreateDNSSECKey(ctx, zoneID, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating DNSSEC Key",
+			fmt.Sprintf("Could not create %s for zone %d: %s", createReq.KeyType, zoneID, err.Error()),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%d/%d", zoneID, key.ID))
+	resp.Diagnostics.Append(r.updateModelFromKey(ctx, &data, key)...)
+
+	tflog.Debug(ctx, "DNSSEC key created successfully", map[string]interface{}{
+		"key_id": key.ID,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSSECKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DNSSECKeyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneID := data.ZoneID.ValueInt64()
+	keyID := int(data.KeyID.ValueInt64())
+
+	tflog.Debug(ctx, "Reading DNSSEC key", map[string]interface{}{
+		"zone_id": zoneID,
+		"key_id":  keyID,
+	})
+
+	key, err := r.client.GetDNSSECKey(ctx, zoneID, keyID)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading DNSSEC Key",
+			fmt.Sprintf("Could not read DNSSEC key %d of zone %d: %s", keyID, zoneID, err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.updateModelFromKey(ctx, &data, key)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSSECKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state DNSSECKeyResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneID := data.ZoneID.ValueInt64()
+	keyID := int(state.KeyID.ValueInt64())
+
+	// Only the flags that changed are sent
+	var updateReq UpdateDNSSECKeyRequest
+	if !data.Active.Equal(state.Active) {
+		active := data.Active.ValueBool()
+		updateReq.Active = &active
+	}
+	if !data.Published.Equal(state.Published) {
+		published := data.Published.ValueBool()
+		updateReq.Published = &published
+	}
+
+	tflog.Debug(ctx, "Updating DNSSEC key", map[string]interface{}{
+		"zone_id":   zoneID,
+		"key_id":    keyID,
+		"active":    data.Active.ValueBool(),
+		"published": data.Published.ValueBool(),
+	})
+
+	if err := r.client.UpdateDNSSECKey(ctx, zoneID, keyID, updateReq); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating DNSSEC Key",
+			fmt.Sprintf("Could not update DNSSEC key %d of zone %d: %s", keyID, zoneID, err.Error()),
+		)
+		return
+	}
+
+	key, err := r.client.GetDNSSECKey(ctx, zoneID, keyID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading DNSSEC Key",
+			fmt.Sprintf("Could not read DNSSEC key %d of zone %d after update: %s", keyID, zoneID, err.Error()),
+		)
+		return
+	}
+
+	data.ID = state.ID
+	resp.Diagnostics.Append(r.updateModelFromKey(ctx, &data, key)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSSECKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DNSSECKeyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneID := data.ZoneID.ValueInt64()
+	keyID := int(data.KeyID.ValueInt64())
+
+	tflog.Debug(ctx, "Deleting DNSSEC key", map[string]interface{}{
+		"zone_id": zoneID,
+		"key_id":  keyID,
+	})
+
+	err := r.client.DeleteDNSSECKey(ctx, zoneID, keyID)
+	if err != nil {
+		if IsNotFoundError(err) {
+			tflog.Info(ctx, "DNSSEC key already deleted, ignoring error", map[string]interface{}{
+				"zone_id": zoneID,
+				"key_id":  keyID,
+			})
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting DNSSEC Key",
+			fmt.Sprintf("Could not delete DNSSEC key %d of zone %d: %s", keyID, zoneID, err.Error()),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "DNSSEC key deleted successfully")
+}
+
+func (r *DNSSECKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	zoneID, keyID, err := parseImportIDPair(req.ID, "zone_id/key_id")
+	if err != nil {
+		resp.Diagnostics.AddError("Error Importing DNSSEC Key", err.Error())
+		return
+	}
+
+	data := DNSSECKeyResourceModel{
+		ID:        types.StringValue(req.ID),
+		ZoneID:    types.Int64Value(zoneID),
+		KeyID:     types.Int64Value(keyID),
+		KeyType:   types.StringNull(),
+		Algorithm: types.StringNull(),
+		Bits:      types.Int64Null(),
+		Active:    types.BoolNull(),
+		Published: types.BoolNull(),
+		DNSKey:    types.StringNull(),
+		DS:        types.ListNull(types.StringType),
+	}
+
+	// The remaining attributes are resolved by Read
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// updateModelFromKey copies the server's view of the key into the model.
+// The configured algorithm spelling is kept when the server reports the
+// same algorithm in another case.
+func (r *DNSSECKeyResource) updateModelFromKey(ctx context.Context, data *DNSSECKeyResourceModel, key *DNSSECKey) diag.Diagnostics {
+	data.KeyID = types.Int64Value(int64(key.ID))
+	data.KeyType = types.StringValue(strings.ToLower(key.KeyType))
+	if data.Algorithm.IsNull() || data.Algorithm.IsUnknown() || !strings.EqualFold(data.Algorithm.ValueString(), key.Algorithm) {
+		data.Algorithm = types.StringValue(key.Algorithm)
+	}
+	data.Bits = types.Int64Value(int64(key.Bits))
+	data.Active = types.BoolValue(key.Active)
+	data.Published = types.BoolValue(key.Published)
+	data.DNSKey = types.StringValue(key.DNSKey)
+
+	records := key.DS
+	if records == nil {
+		records = []string{} // zone signing keys have no DS records
+	}
+	ds, diags := types.ListValueFrom(ctx, types.StringType, records)
+	data.DS = ds
+	return diags
+}
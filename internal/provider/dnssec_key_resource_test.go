@@ -0,0 +1,118 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDNSSECKeyClient(t *testing.T) {
+	var created CreateDNSSECKeyRequest
+	var updated map[string]interface{}
+	var deleted bool
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v2/zones/10/dnssec/keys":
+			if err := json.NewDecoder(r.Body).Decode(&created); err != nil {
+				t.Errorf("failed to decode body: %v", err)
+			}
+			respondJSON(t, w, DNSSECKeyResponse{Key: DNSSECKey{ID: 4, KeyType: "zsk", Algorithm: "ECDSAP256SHA256", Bits: 256}})
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/zones/10/dnssec/keys/4":
+			respondJSON(t, w, DNSSECKeyResponse{Key: DNSSECKey{ID: 4, KeyType: "zsk", Active: true, Published: true}})
+		case r.Method == http.MethodPut && r.URL.Path == "/api/v2/zones/10/dnssec/keys/4":
+			if err := json.NewDecoder(r.Body).Decode(&updated); err != nil {
+				t.Errorf("failed to decode body: %v", err)
+			}
+			respondJSON(t, w, nil)
+		case r.Method == http.MethodDelete && r.URL.Path == "/api/v2/zones/10/dnssec/keys/4":
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+	ctx := context.Background()
+
+	key, err := client.CreateDNSSECKey(ctx, 10, CreateDNSSECKeyRequest{KeyType: "zsk", Published: true, Algorithm: "ECDSAP256SHA256"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if key.ID != 4 || created.KeyType != "zsk" || created.Active || !created.Published {
+		t.Errorf("unexpected create: request %+v, key %+v", created, key)
+	}
+
+	key, err = client.GetDNSSECKey(ctx, 10, 4)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !key.Active {
+		t.Errorf("expected an active key, got %+v", key)
+	}
+
+	active := false
+	if err := client.UpdateDNSSECKey(ctx, 10, 4, UpdateDNSSECKeyRequest{Active: &active}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := updated["published"]; ok || updated["active"] != false {
+		t.Errorf("expected only active=false in update, got %v", updated)
+	}
+
+	if err := client.DeleteDNSSECKey(ctx, 10, 4); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !deleted {
+		t.Error("expected a DELETE for key 4")
+	}
+}
+
+func TestAccDNSSECKeyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSSECKeyResourceConfig(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("poweradmin_dnssec_key.test", "key_id"),
+					resource.TestCheckResourceAttr("poweradmin_dnssec_key.test", "key_type", "zsk"),
+					resource.TestCheckResourceAttr("poweradmin_dnssec_key.test", "active", "false"),
+					resource.TestCheckResourceAttrSet("poweradmin_dnssec_key.test", "dnskey"),
+				),
+			},
+			{
+				Config: testAccDNSSECKeyResourceConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("poweradmin_dnssec_key.test", "active", "true"),
+				),
+			},
+			{
+				ResourceName:      "poweradmin_dnssec_key.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDNSSECKeyResourceConfig(active bool) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "poweradmin_zone" "test" {
+  name = "test-dnssec-key-acc.example.com"
+  type = "MASTER"
+}
+
+resource "poweradmin_dnssec_key" "test" {
+  zone_id   = poweradmin_zone.test.id
+  key_type  = "zsk"
+  algorithm = "ECDSAP256SHA256"
+  active    = %t
+}
+`, active)
+}
@@ -0,0 +1,553 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GlueRecordResource{}
+var _ resource.ResourceWithImportState = &GlueRecordResource{}
+var _ resource.ResourceWithValidateConfig = &GlueRecordResource{}
+var _ resource.ResourceWithModifyPlan = &GlueRecordResource{}
+
+func NewGlueRecordResource() resource.Resource {
+	return &GlueRecordResource{}
+}
+
+// GlueRecordResource manages the in-zone A/AAAA glue for a nameserver that
+// lives inside the zone it serves.
+type GlueRecordResource struct {
+	client *Client
+}
+
+// GlueRecordResourceModel describes the resource data model.
+type GlueRecordResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	ZoneID     types.Int64  `tfsdk:"zone_id"`
+	Nameserver DNSNameValue `tfsdk:"nameserver"`
+	Addresses  types.Set    `tfsdk:"addresses"`
+	TTL        types.Int64  `tfsdk:"ttl"`
+}
+
+func (r *GlueRecordResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_glue_record"
+}
+
+func (r *GlueRecordResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the A/AAAA glue for a nameserver that lives inside the zone it serves (e.g. `ns1.sub.example.com` for `sub.example.com`). " +
+			"IPv4 addresses are written to an A RRSet and IPv6 addresses to an AAAA RRSet at the nameserver name. " +
+			"The nameserver must be the target of an NS record in the zone, so glue cannot drift away from the delegation it supports. " +
+			"Creating fails when one of these RRSets already has records, e.g. from a `poweradmin_rrset`; import the glue instead.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Composite identifier in the format `zone_id/nameserver`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"zone_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the zone that contains the nameserver",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"nameserver": schema.StringAttribute{
+				MarkdownDescription: "Fully qualified nameserver hostname (e.g. `ns1.example.com`). Must be inside the zone and listed as an NS target in it. Case, a trailing dot and Unicode or punycode spelling do not cause a diff.",
+				Required:            true,
+				CustomType:          DNSNameType{},
+				PlanModifiers: []planmodifier.String{
+					requiresReplaceUnlessSameIDN(),
+				},
+			},
+			"addresses": schema.SetAttribute{
+				MarkdownDescription: "IPv4 and/or IPv6 addresses of the nameserver",
+				Required:            true,
+				ElementType:         types.StringType,
+			},
+			"ttl": schema.Int64Attribute{
+				MarkdownDescription: "Time to live (TTL) in seconds for the glue RRSets. Defaults to 3600.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(3600),
+			},
+		},
+	}
+}
+
+func (r *GlueRecordResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan rejects new records in a SLAVE zone while planning, instead of
+// failing deep in the apply.
+func (r *GlueRecordResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlannedZoneAcceptsRecords(ctx, r.client, req, &resp.Diagnostics)
+}
+
+// ValidateConfig rejects addresses that are not plain IPs; zone membership
+// needs the zone name and is checked against the API in Create/Update.
+func (r *GlueRecordResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data GlueRecordResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Addresses.IsNull() || data.Addresses.IsUnknown() {
+		return
+	}
+
+	var addresses []types.String
+	resp.Diagnostics.Append(data.Addresses.ElementsAs(ctx, &addresses, false)...)
+	for _, addr := range addresses {
+		if addr.IsUnknown() || addr.IsNull() {
+			continue
+		}
+		if _, err := netip.ParseAddr(addr.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("addresses"),
+				"Invalid Glue Address",
+				fmt.Sprintf("%q is not a valid IPv4 or IPv6 address.", addr.ValueString()),
+			)
+		}
+	}
+}
+
+func (r *GlueRecordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GlueRecordResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneID := data.ZoneID.ValueInt64()
+
+	// Zone IDs unknown at plan time are only checked now
+	if !validateZoneAcceptsRecords(ctx, r.client, zoneID, &resp.Diagnostics) {
+		return
+	}
+
+	relName, ok := r.checkNameserver(ctx, zoneID, data.Nameserver.ValueString(), &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	v4, v6, diags := glueAddressesByFamily(ctx, data.Addresses)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Glue replaces the whole A/AAAA RRSet and Delete removes it, so never
+	// take over one that exists, e.g. managed by a poweradmin_rrset
+	if !r.checkGlueAbsent(ctx, zoneID, relName, data.Nameserver.ValueString(), v4, v6, &resp.Diagnostics) {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating glue records", map[string]interface{}{
+		"zone_id":    zoneID,
+		"nameserver": data.Nameserver.ValueString(),
+	})
+
+	if !r.writeGlue(ctx, zoneID, relName, data.TTL.ValueInt64(), v4, v6, nil, nil, &resp.Diagnostics) {
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%d/%s", zoneID, data.Nameserver.ValueString()))
+
+	tflog.Trace(ctx, "Created glue records", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GlueRecordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GlueRecordResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneID := data.ZoneID.ValueInt64()
+	zoneName, err := r.client.GetZoneName(ctx, zoneID)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Glue Records",
+			fmt.Sprintf("Could not resolve zone name for zone ID %d: %s", zoneID, err),
+		)
+		return
+	}
+	relName, err := glueRelativeName(data.Nameserver.ValueString(), zoneName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Glue Records", err.Error())
+		return
+	}
+
+	var addresses []string
+	var ttl int64
+	found := false
+	for _, recordType := range []string{"A", "AAAA"} {
+		rrset, err := r.client.GetRRSetCached(ctx, zoneID, relName, recordType)
+		if err != nil {
+			if IsNotFoundError(err) {
+				continue
+			}
+			resp.Diagnostics.AddError(
+				"Error Reading Glue Records",
+				fmt.Sprintf("Could not read %s glue for %s: %s", recordType, data.Nameserver.ValueString(), err),
+			)
+			return
+		}
+		found = true
+		ttl = rrset.TTL
+		for _, rec := range rrset.Records {
+			addresses = append(addresses, rec.Content)
+		}
+	}
+
+	// Both families gone means the glue was removed outside of Terraform
+	if !found {
+		tflog.Info(ctx, "Glue records not found, removing from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	set, diags := types.SetValueFrom(ctx, types.StringType, normalizeGlueAddresses(ctx, data.Addresses, addresses))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Addresses = set
+	data.TTL = types.Int64Value(ttl)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GlueRecordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state GlueRecordResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneID := data.ZoneID.ValueInt64()
+	relName, ok := r.checkNameserver(ctx, zoneID, data.Nameserver.ValueString(), &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	v4, v6, diags := glueAddressesByFamily(ctx, data.Addresses)
+	resp.Diagnostics.Append(diags...)
+	oldV4, oldV6, diags := glueAddressesByFamily(ctx, state.Addresses)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating glue records", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	if !r.writeGlue(ctx, zoneID, relName, data.TTL.ValueInt64(), v4, v6, oldV4, oldV6, &resp.Diagnostics) {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GlueRecordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GlueRecordResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneID := data.ZoneID.ValueInt64()
+	zoneName, err := r.client.GetZoneName(ctx, zoneID)
+	if err != nil {
+		// Deleting the zone took its glue with it
+		if IsNotFoundError(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Glue Records",
+			fmt.Sprintf("Could not resolve zone name for zone ID %d: %s", zoneID, err),
+		)
+		return
+	}
+	relName, err := glueRelativeName(data.Nameserver.ValueString(), zoneName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting Glue Records", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting glue records", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	for _, recordType := range []string{"A", "AAAA"} {
+		if err := r.client.DeleteRRSet(ctx, zoneID, relName, recordType); err != nil && !IsNotFoundError(err) {
+			resp.Diagnostics.AddError(
+				"Error Deleting Glue Records",
+				fmt.Sprintf("Could not delete %s glue for %s: %s", recordType, data.Nameserver.ValueString(), err),
+			)
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Deleted glue records", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *GlueRecordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format: zone_id/nameserver
+	// Example: terraform import poweradmin_glue_record.ns1 123/ns1.example.com
+	zoneID, nameserver, err := parseGlueImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone_id"), zoneID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("nameserver"), nameserver)...)
+}
+
+// checkNameserver resolves the relative glue name and verifies the nameserver
+// is an NS target in the zone; returns false when it added an error.
+func (r *GlueRecordResource) checkNameserver(ctx context.Context, zoneID int64, nameserver string, diags *diag.Diagnostics) (string, bool) {
+	zoneName, err := r.client.GetZoneName(ctx, zoneID)
+	if err != nil {
+		diags.AddError(
+			"Error Resolving Zone",
+			fmt.Sprintf("Could not resolve zone name for zone ID %d: %s", zoneID, err),
+		)
+		return "", false
+	}
+
+	relName, err := glueRelativeName(nameserver, zoneName)
+	if err != nil {
+		diags.AddAttributeError(path.Root("nameserver"), "Nameserver Outside Zone", err.Error())
+		return "", false
+	}
+
+	nsSets, err := r.client.ListRRSets(ctx, zoneID, "NS")
+	if err != nil {
+		diags.AddError(
+			"Error Reading NS Records",
+			fmt.Sprintf("Could not list NS records for zone %s: %s", zoneName, err),
+		)
+		return "", false
+	}
+	if !isNSTarget(nsSets, nameserver) {
+		diags.AddAttributeError(
+			path.Root("nameserver"),
+			"Nameserver Is Not an NS Target",
+			fmt.Sprintf("%s is not the target of any NS record in zone %s, so glue for it would never be used. "+
+				"Add the NS record (e.g. with poweradmin_rrset) first, or check the nameserver spelling.", nameserver, zoneName),
+		)
+		return "", false
+	}
+	return relName, true
+}
+
+// checkGlueAbsent errors when an A or AAAA RRSet the glue would write already
+// has records on the server; returns false when it added an error.
+func (r *GlueRecordResource) checkGlueAbsent(ctx context.Context, zoneID int64, relName, nameserver string, v4, v6 []string, diags *diag.Diagnostics) bool {
+	families := []struct {
+		recordType string
+		addresses  []string
+	}{
+		{"A", v4},
+		{"AAAA", v6},
+	}
+	for _, f := range families {
+		if len(f.addresses) == 0 {
+			continue
+		}
+		hint := fmt.Sprintf("Import the glue to manage the existing records:\n\n  terraform import <address> %d/%s\n\n"+
+			"or remove the %s RRSet, e.g. from a poweradmin_rrset, first.", zoneID, nameserver, f.recordType)
+		if !checkRRSetAbsent(ctx, r.client, zoneID, relName, f.recordType, hint, diags) {
+			return false
+		}
+	}
+	return true
+}
+
+// writeGlue puts the A/AAAA RRSets for the wanted addresses and deletes a
+// family's RRSet when its last address was removed.
+func (r *GlueRecordResource) writeGlue(ctx context.Context, zoneID int64, relName string, ttl int64, v4, v6, oldV4, oldV6 []string, diags *diag.Diagnostics) bool {
+	families := []struct {
+		recordType string
+		want, had  []string
+	}{
+		{"A", v4, oldV4},
+		{"AAAA", v6, oldV6},
+	}
+	for _, f := range families {
+		if len(f.want) == 0 {
+			if len(f.had) == 0 {
+				continue
+			}
+			if err := r.client.DeleteRRSet(ctx, zoneID, relName, f.recordType); err != nil && !IsNotFoundError(err) {
+				diags.AddError("Error Removing Glue", fmt.Sprintf("Could not delete %s glue at %s: %s", f.recordType, relName, err))
+				return false
+			}
+			continue
+		}
+		records := make([]map[string]interface{}, len(f.want))
+		for i, addr := range f.want {
+			records[i] = map[string]interface{}{"content": addr, "disabled": false, "priority": 0}
+		}
+		rrsetData := map[string]interface{}{
+			"name":    relName,
+			"type":    f.recordType,
+			"ttl":     ttl,
+			"records": records,
+		}
+		if _, err := r.client.UpdateRRSet(ctx, zoneID, rrsetData); err != nil {
+			diags.AddError("Error Writing Glue", fmt.Sprintf("Could not write %s glue at %s: %s", f.recordType, relName, err))
+			return false
+		}
+	}
+	return true
+}
+
+// glueRelativeName converts a nameserver FQDN into the record name relative
+// to the zone, rejecting names outside the zone and the apex itself.
+func glueRelativeName(nameserver, zoneName string) (string, error) {
+	ns := canonicalDNSName(nameserver)
+	zone := canonicalDNSName(zoneName)
+	if ns == zone {
+		return "", fmt.Errorf("nameserver %s is the zone apex of %s; glue must be for a host inside the zone", nameserver, zoneName)
+	}
+	if !strings.HasSuffix(ns, "."+zone) {
+		return "", fmt.Errorf("nameserver %s is not inside zone %s; out-of-zone nameservers do not need glue here", nameserver, zoneName)
+	}
+	return strings.TrimSuffix(ns, "."+zone), nil
+}
+
+// isNSTarget reports whether any NS record in the sets points at nameserver,
+// in any spelling of it.
+func isNSTarget(nsSets []RRSet, nameserver string) bool {
+	for _, set := range nsSets {
+		for _, rec := range set.Records {
+			if sameDNSName(rec.Content, nameserver) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// glueAddressesByFamily splits the configured addresses into IPv4 and IPv6.
+// IPv4-mapped IPv6 addresses (::ffff:192.0.2.1) go to the A RRSet in their
+// IPv4 form, since an A record cannot hold the IPv6 spelling.
+func glueAddressesByFamily(ctx context.Context, set types.Set) ([]string, []string, diag.Diagnostics) {
+	var addresses []string
+	diags := set.ElementsAs(ctx, &addresses, false)
+	var v4, v6 []string
+	for _, addr := range addresses {
+		ip, err := netip.ParseAddr(addr)
+		if err != nil {
+			diags.AddAttributeError(path.Root("addresses"), "Invalid Glue Address", fmt.Sprintf("%q is not a valid IPv4 or IPv6 address.", addr))
+			continue
+		}
+		if ip.Unmap().Is4() {
+			v4 = append(v4, ip.Unmap().String())
+		} else {
+			v6 = append(v6, addr)
+		}
+	}
+	return v4, v6, diags
+}
+
+// normalizeGlueAddresses keeps the configured spelling of addresses the
+// server returned in canonical form (e.g. expanded IPv6, or IPv4 for an
+// IPv4-mapped address), so equal IPs do not show up as drift.
+func normalizeGlueAddresses(ctx context.Context, configured types.Set, fromAPI []string) []string {
+	var prior []string
+	if !configured.IsNull() && !configured.IsUnknown() {
+		configured.ElementsAs(ctx, &prior, false)
+	}
+	result := make([]string, len(fromAPI))
+	for i, addr := range fromAPI {
+		result[i] = addr
+		apiIP, err := netip.ParseAddr(addr)
+		if err != nil {
+			continue
+		}
+		for _, p := range prior {
+			if ip, err := netip.ParseAddr(p); err == nil && ip.Unmap() == apiIP.Unmap() {
+				result[i] = p
+				break
+			}
+		}
+	}
+	return result
+}
+
+// parseGlueImportID parses a "zone_id/nameserver" import ID.
+func parseGlueImportID(id string) (int64, string, error) {
+	zoneID, nameserver, err := parseRecordImportID(id)
+	if err != nil {
+		return 0, "", fmt.Errorf("import ID must be in format 'zone_id/nameserver', got: %s", id)
+	}
+	return zoneID, string(nameserver), nil
+}
@@ -0,0 +1,183 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestGlueRelativeName(t *testing.T) {
+	tests := []struct {
+		name       string
+		nameserver string
+		zone       string
+		want       string
+		wantErr    bool
+	}{
+		{"in-zone host", "ns1.example.com", "example.com", "ns1", false},
+		{"trailing dots", "ns1.example.com.", "example.com.", "ns1", false},
+		{"case-insensitive", "NS1.Example.COM", "example.com", "ns1", false},
+		{"multi-label", "a.ns.example.com", "example.com", "a.ns", false},
+		{"unicode zone", "ns1.münchen.de", "xn--mnchen-3ya.de", "ns1", false},
+		{"apex rejected", "example.com", "example.com", "", true},
+		{"out of zone rejected", "ns1.example.net", "example.com", "", true},
+		{"similar suffix rejected", "ns1.badexample.com", "example.com", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := glueRelativeName(tt.nameserver, tt.zone)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("glueRelativeName(%q, %q) error = %v, wantErr %v", tt.nameserver, tt.zone, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("glueRelativeName(%q, %q) = %q, want %q", tt.nameserver, tt.zone, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsNSTarget(t *testing.T) {
+	nsSets := []RRSet{
+		{Name: "@", Type: "NS", Records: []RRSetRecord{{Content: "ns1.example.com."}, {Content: "ns.other.net"}}},
+	}
+	if !isNSTarget(nsSets, "NS1.example.com") {
+		t.Error("expected ns1.example.com to match dotted NS content")
+	}
+	if !isNSTarget(nsSets, "ns.other.net.") {
+		t.Error("expected trailing dot on nameserver to be ignored")
+	}
+	if !isNSTarget([]RRSet{{Name: "@", Type: "NS", Records: []RRSetRecord{{Content: "ns1.xn--mnchen-3ya.de."}}}}, "ns1.münchen.de") {
+		t.Error("expected the Unicode nameserver to match its punycode NS content")
+	}
+	if isNSTarget(nsSets, "ns2.example.com") {
+		t.Error("expected ns2.example.com not to be an NS target")
+	}
+}
+
+func TestGlueAddressesByFamily(t *testing.T) {
+	ctx := context.Background()
+	set, _ := types.SetValueFrom(ctx, types.StringType, []string{"192.0.2.53", "2001:db8::53", "::ffff:198.51.100.1"})
+
+	v4, v6, diags := glueAddressesByFamily(ctx, set)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(v4) != 2 || len(v6) != 1 {
+		t.Errorf("expected 2 IPv4 and 1 IPv6 address, got %v and %v", v4, v6)
+	}
+	for _, addr := range v4 {
+		if strings.Contains(addr, ":") {
+			t.Errorf("expected A record content in IPv4 form, got %q", addr)
+		}
+	}
+}
+
+func TestNormalizeGlueAddresses(t *testing.T) {
+	ctx := context.Background()
+	configured, _ := types.SetValueFrom(ctx, types.StringType, []string{"2001:db8:0:0::53"})
+
+	got := normalizeGlueAddresses(ctx, configured, []string{"2001:db8::53", "192.0.2.1"})
+	if got[0] != "2001:db8:0:0::53" {
+		t.Errorf("expected configured IPv6 spelling preserved, got %q", got[0])
+	}
+	if got[1] != "192.0.2.1" {
+		t.Errorf("expected unmatched address kept from API, got %q", got[1])
+	}
+}
+
+func TestNormalizeGlueAddresses_IPv4Mapped(t *testing.T) {
+	ctx := context.Background()
+	configured, _ := types.SetValueFrom(ctx, types.StringType, []string{"::ffff:198.51.100.1"})
+
+	got := normalizeGlueAddresses(ctx, configured, []string{"198.51.100.1"})
+	if got[0] != "::ffff:198.51.100.1" {
+		t.Errorf("expected configured IPv4-mapped spelling preserved, got %q", got[0])
+	}
+}
+
+func TestCheckGlueAbsent(t *testing.T) {
+	// Only the AAAA RRSet exists on the server
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/AAAA") {
+			respondJSON(t, w, RRSetResponse{RRSet: RRSet{Name: "ns1", Type: "AAAA", Records: []RRSetRecord{{Content: "2001:db8::53"}}}})
+			return
+		}
+		respondError(t, w, http.StatusNotFound, "RRSet not found")
+	}
+	r := &GlueRecordResource{client: newTestClient(t, handler)}
+
+	var diags diag.Diagnostics
+	if !r.checkGlueAbsent(context.Background(), 1, "ns1", "ns1.example.com", []string{"192.0.2.53"}, nil, &diags) || diags.HasError() {
+		t.Errorf("expected IPv4-only glue to pass, got %v", diags)
+	}
+	if r.checkGlueAbsent(context.Background(), 1, "ns1", "ns1.example.com", []string{"192.0.2.53"}, []string{"2001:db8::53"}, &diags) {
+		t.Fatal("expected the existing AAAA RRSet to be rejected")
+	}
+	if !diags.HasError() || !strings.Contains(diags[0].Detail(), "terraform import <address> 1/ns1.example.com") {
+		t.Errorf("expected an import hint, got %v", diags)
+	}
+}
+
+func TestAccGlueRecordResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlueRecordResourceConfig("test-glue-acc.example.com", `"192.0.2.53"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("poweradmin_glue_record.test", "nameserver", "ns1.test-glue-acc.example.com"),
+					resource.TestCheckResourceAttr("poweradmin_glue_record.test", "addresses.#", "1"),
+					resource.TestCheckResourceAttrSet("poweradmin_glue_record.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "poweradmin_glue_record.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGlueRecordResourceConfig("test-glue-acc.example.com", `"192.0.2.53", "2001:db8::53"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("poweradmin_glue_record.test", "addresses.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGlueRecordResourceConfig(zoneName, addresses string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "poweradmin_zone" "test" {
+  name = %[1]q
+  type = "MASTER"
+}
+
+resource "poweradmin_rrset" "ns" {
+  zone_id = poweradmin_zone.test.id
+  name    = "@"
+  type    = "NS"
+
+  records = [
+    { content = "ns1.%[1]s." },
+  ]
+}
+
+resource "poweradmin_glue_record" "test" {
+  zone_id    = poweradmin_zone.test.id
+  nameserver = "ns1.%[1]s"
+  addresses  = [%[2]s]
+
+  depends_on = [poweradmin_rrset.ns]
+}
+`, zoneName, addresses)
+}
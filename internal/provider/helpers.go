@@ -4,11 +4,20 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/netip"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // parseImportIDPair parses a "parent_id/child_id" import ID, rejecting
@@ -54,3 +63,524 @@ func validateLookupChoice(hasID, hasName bool, what string, diags *diag.Diagnost
 	}
 	return true
 }
+
+// nonNilStrings maps a missing JSON array to an empty slice so list
+// attributes are empty rather than null.
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+// validateZoneAcceptsRecords errors when zoneID is a SLAVE zone, whose
+// contents are replaced by every transfer from its masters; returns false
+// when it added an error. Lookup failures are only logged and left for the
+// write itself to report.
+func validateZoneAcceptsRecords(ctx context.Context, client *Client, zoneID int64, diags *diag.Diagnostics) bool {
+	zoneType, err := client.GetZoneType(ctx, zoneID)
+	if err != nil {
+		tflog.Debug(ctx, "Could not determine zone type for record placement check", map[string]interface{}{
+			"zone_id": zoneID,
+			"error":   err.Error(),
+		})
+		return true
+	}
+	if !strings.EqualFold(zoneType, "SLAVE") {
+		return true
+	}
+	diags.AddAttributeError(
+		path.Root("zone_id"),
+		"Cannot Manage Records in a SLAVE Zone",
+		fmt.Sprintf("Zone %d is a SLAVE zone: its records are transferred from the masters and any local change is overwritten by the next transfer. "+
+			"Manage these records on the master server instead, or change the zone type to MASTER or NATIVE.", zoneID),
+	)
+	return false
+}
+
+// validatePlannedZoneAcceptsRecords runs validateZoneAcceptsRecords at plan
+// time for resources being created whose zone_id is already known; zone_id
+// forces replacement, so existing resources never need the check.
+func validatePlannedZoneAcceptsRecords(ctx context.Context, client *Client, req resource.ModifyPlanRequest, diags *diag.Diagnostics) {
+	if client == nil || req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() {
+		return
+	}
+	var zoneID types.Int64
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("zone_id"), &zoneID)...)
+	if diags.HasError() || zoneID.IsNull() || zoneID.IsUnknown() {
+		return
+	}
+	validateZoneAcceptsRecords(ctx, client, zoneID.ValueInt64(), diags)
+}
+
+// addCNAMEConflictError reports a write rejected by IsCNAMEConflictError,
+// naming the RRSet that is in the way instead of the raw HTTP error.
+func addCNAMEConflictError(diags *diag.Diagnostics, zoneID int64, name, recordType string, err error) {
+	if strings.EqualFold(recordType, "CNAME") {
+		diags.AddError(
+			"CNAME Conflict",
+			fmt.Sprintf("Cannot create a CNAME at %q in zone %d: other records already exist at that name, and a CNAME cannot share its name with any other record. "+
+				"Remove the existing RRSets at %q or choose a different name for the CNAME.\n\nAPI response: %s", name, zoneID, name, err),
+		)
+		return
+	}
+	diags.AddError(
+		"CNAME Conflict",
+		fmt.Sprintf("Cannot create a %s record at %q in zone %d: the CNAME RRSet at %q is in the way, and no other record may share a name with a CNAME. "+
+			"Remove or rename that CNAME, or choose a different name. If the CNAME belongs in Terraform, import it with "+
+			"`terraform import poweradmin_rrset.<name> %d/%s/CNAME`.\n\nAPI response: %s", recordType, name, zoneID, name, zoneID, name, err),
+	)
+}
+
+// maskContentLogs hides record content from provider logs when
+// sensitive_content is set or the content is held in a sensitive attribute.
+// Request and response bodies are masked whole, since the API may return
+// content that is not in state yet.
+func maskContentLogs(ctx context.Context, sensitive bool, contents ...string) context.Context {
+	if !sensitive {
+		return ctx
+	}
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "body", "content")
+	var known []string
+	for _, c := range contents {
+		if c != "" {
+			known = append(known, c)
+		}
+	}
+	if len(known) == 0 {
+		return ctx
+	}
+	ctx = tflog.MaskAllFieldValuesStrings(ctx, known...)
+	return tflog.MaskMessageStrings(ctx, known...)
+}
+
+// dynamicAttributes returns the attributes of an object or map passed to a
+// dynamic function parameter, so callers can set only the keys they need.
+func dynamicAttributes(v types.Dynamic) (map[string]attr.Value, error) {
+	switch value := v.UnderlyingValue().(type) {
+	case types.Object:
+		return value.Attributes(), nil
+	case types.Map:
+		return value.Elements(), nil
+	}
+	return nil, fmt.Errorf("expected an object such as { key = value }, got %s", v.UnderlyingValue().Type(context.Background()))
+}
+
+// attrStrings converts a string, or a list, tuple or set of strings, from a
+// dynamic value into a slice. Null yields nil.
+func attrStrings(v attr.Value) ([]string, error) {
+	if v == nil || v.IsNull() {
+		return nil, nil
+	}
+	if v.IsUnknown() {
+		return nil, fmt.Errorf("value is not known yet")
+	}
+	var elems []attr.Value
+	switch value := v.(type) {
+	case types.String:
+		return []string{value.ValueString()}, nil
+	case types.List:
+		elems = value.Elements()
+	case types.Tuple:
+		elems = value.Elements()
+	case types.Set:
+		elems = value.Elements()
+	default:
+		return nil, fmt.Errorf("expected a string or a list of strings")
+	}
+	result := make([]string, 0, len(elems))
+	for _, elem := range elems {
+		str, ok := elem.(types.String)
+		if !ok || str.IsNull() || str.IsUnknown() {
+			return nil, fmt.Errorf("expected a list of known strings")
+		}
+		result = append(result, str.ValueString())
+	}
+	return result, nil
+}
+
+// reversePTRName returns the full in-addr.arpa or ip6.arpa owner name of ip.
+func reversePTRName(ip netip.Addr) string {
+	ip = ip.Unmap()
+	if ip.Is4() {
+		return reverseZoneName(ip.AsSlice(), 32, 8, "in-addr.arpa")
+	}
+	return reverseZoneName(ip.AsSlice(), 128, 4, "ip6.arpa")
+}
+
+// findReverseZone picks the most specific zone containing ip's PTR name and
+// returns it with the PTR name relative to that zone. Returns nil when no
+// zone matches.
+func findReverseZone(zones []Zone, ip netip.Addr) (*Zone, string) {
+	return findZoneForName(zones, reversePTRName(ip))
+}
+
+// findZoneForName picks the most specific zone containing the fully qualified
+// name and returns it with the name relative to that zone ("@" for the apex).
+// Returns nil when no zone matches.
+func findZoneForName(zones []Zone, fqdn string) (*Zone, string) {
+	fqdn = canonicalDNSName(fqdn)
+	var best *Zone
+	var bestName, relative string
+	for i := range zones {
+		zoneName := canonicalDNSName(zones[i].Name)
+		var name string
+		switch {
+		case fqdn == zoneName:
+			name = "@"
+		case strings.HasSuffix(fqdn, "."+zoneName):
+			name = strings.TrimSuffix(fqdn, "."+zoneName)
+		default:
+			continue
+		}
+		if best == nil || len(zoneName) > len(bestName) {
+			best = &zones[i]
+			bestName = zoneName
+			relative = name
+		}
+	}
+	return best, relative
+}
+
+// resolveRecordFQDN finds the zone owning fqdn among the zones visible to the
+// client and returns it with the record name relative to it. The zone is nil
+// when none contains fqdn. The listing is shared by every record of the run.
+func resolveRecordFQDN(ctx context.Context, client *Client, fqdn string) (*Zone, string, error) {
+	zones, err := client.ListZonesCached(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("could not list zones: %w", err)
+	}
+	zone, name := findZoneForName(zones, fqdn)
+	return zone, name, nil
+}
+
+// ptrNameInZone returns the PTR owner name of ipAddress relative to zoneName
+// ("@" for the apex), erroring when the address is outside the zone.
+func ptrNameInZone(ipAddress, zoneName string) (string, error) {
+	ip, err := netip.ParseAddr(strings.TrimSpace(ipAddress))
+	if err != nil {
+		return "", fmt.Errorf("%q is not a valid IPv4 or IPv6 address", ipAddress)
+	}
+	ptrName := reversePTRName(ip)
+	zoneName = canonicalDNSName(zoneName)
+	if ptrName == zoneName {
+		return "@", nil
+	}
+	if !strings.HasSuffix(ptrName, "."+zoneName) {
+		return "", fmt.Errorf("%s (%s) is not inside zone %s", ip, ptrName, zoneName)
+	}
+	return strings.TrimSuffix(ptrName, "."+zoneName), nil
+}
+
+// resolvePTRName derives the PTR record name for ipAddress within zoneID.
+func resolvePTRName(ctx context.Context, client *Client, zoneID int64, ipAddress string) (string, error) {
+	zoneName, err := client.GetZoneName(ctx, zoneID)
+	if err != nil {
+		return "", fmt.Errorf("could not resolve zone name for zone ID %d: %w", zoneID, err)
+	}
+	return ptrNameInZone(ipAddress, zoneName)
+}
+
+// validatePTRAddressConfig requires exactly one of name and ip_address, and
+// ip_address only on PTR records.
+func validatePTRAddressConfig(name, recordType, ipAddress types.String, diags *diag.Diagnostics) {
+	if ipAddress.IsNull() {
+		if name.IsNull() {
+			diags.AddAttributeError(
+				path.Root("name"),
+				"Missing Record Name",
+				"name is required unless ip_address is set on a PTR record.",
+			)
+		}
+		return
+	}
+	if !name.IsNull() {
+		diags.AddAttributeError(
+			path.Root("ip_address"),
+			"Conflicting Record Name",
+			"Set either name or ip_address, not both; the name is derived from ip_address.",
+		)
+	}
+	if !recordType.IsUnknown() && !strings.EqualFold(recordType.ValueString(), "PTR") {
+		diags.AddAttributeError(
+			path.Root("ip_address"),
+			"Invalid ip_address",
+			fmt.Sprintf("ip_address is only supported on PTR records, not %s.", recordType.ValueString()),
+		)
+	}
+	if !ipAddress.IsUnknown() {
+		if _, err := netip.ParseAddr(strings.TrimSpace(ipAddress.ValueString())); err != nil {
+			diags.AddAttributeError(
+				path.Root("ip_address"),
+				"Invalid ip_address",
+				fmt.Sprintf("%q is not a valid IPv4 or IPv6 address.", ipAddress.ValueString()),
+			)
+		}
+	}
+}
+
+// planPTRName plans the name derived from ip_address, checking at plan time
+// that the address belongs to the zone. The name stays unknown until both
+// ip_address and zone_id are known.
+func planPTRName(ctx context.Context, client *Client, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+	var ipAddress types.String
+	var zoneID types.Int64
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("ip_address"), &ipAddress)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("zone_id"), &zoneID)...)
+	if resp.Diagnostics.HasError() || ipAddress.IsNull() {
+		return
+	}
+	if ipAddress.IsUnknown() || zoneID.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("name"), NewDNSNameUnknown())...)
+		return
+	}
+
+	name, err := resolvePTRName(ctx, client, zoneID.ValueInt64(), ipAddress.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("ip_address"), "Invalid ip_address", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+// defaultRecordTTL is the TTL of records that set none, in zones without a
+// default_record_ttl.
+const defaultRecordTTL = 3600
+
+// zoneRecordTTL returns the TTL records in a zone get when they set none,
+// read once per zone and run. When the zone's default cannot be read, e.g.
+// with a key lacking metadata permission, it returns defaultRecordTTL with
+// the error for the caller to warn about, and keeps using it for the zone.
+func zoneRecordTTL(ctx context.Context, client *Client, zoneID int64) (int64, error) {
+	if cached, ok := client.zoneRecordTTLs.Load(zoneID); ok {
+		if ttl, ok := cached.(int64); ok {
+			return ttl, nil
+		}
+	}
+	ttl, ok, err := client.GetZoneDefaultTTL(ctx, zoneID)
+	if err != nil {
+		err = fmt.Errorf("could not read the default record TTL of zone %d, assuming %d seconds: %w", zoneID, defaultRecordTTL, err)
+		ttl = defaultRecordTTL
+	} else if !ok {
+		ttl = defaultRecordTTL
+	}
+	client.zoneRecordTTLs.Store(zoneID, ttl)
+	return ttl, err
+}
+
+// planRecordTTL plans the zone's default record TTL when ttl is not
+// configured. A prior TTL of the same length is kept as written, and the TTL
+// stays unknown until zone_id is known.
+func planRecordTTL(ctx context.Context, client *Client, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+	var configured TTLValue
+	var zoneID types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("ttl"), &configured)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("zone_id"), &zoneID)...)
+	if resp.Diagnostics.HasError() || !configured.IsNull() {
+		return
+	}
+	if zoneID.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ttl"), TTLValue{StringValue: types.StringUnknown()})...)
+		return
+	}
+
+	seconds, err := zoneRecordTTL(ctx, client, zoneID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(path.Root("ttl"), "Zone Default Record TTL Unavailable", err.Error())
+	}
+	ttl := NewTTLValue(seconds)
+	if !req.State.Raw.IsNull() {
+		var prior TTLValue
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("ttl"), &prior)...)
+		if !prior.IsNull() && prior.ValueSeconds() == seconds {
+			ttl = prior
+		}
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ttl"), ttl)...)
+}
+
+// resolveRecordTTL fills in a TTL left unknown at plan time with the zone's
+// default record TTL.
+func resolveRecordTTL(ctx context.Context, client *Client, zoneID int64, ttl *TTLValue, diags *diag.Diagnostics) {
+	if !ttl.IsUnknown() {
+		return
+	}
+	seconds, err := zoneRecordTTL(ctx, client, zoneID)
+	if err != nil {
+		diags.AddWarning("Zone Default Record TTL Unavailable", err.Error())
+	}
+	*ttl = NewTTLValue(seconds)
+}
+
+// aliasConflictTypes cannot share an owner name with an ALIAS: PowerDNS
+// answers A/AAAA queries from the ALIAS target, and a CNAME excludes all
+// other data.
+var aliasConflictTypes = []string{"A", "AAAA", "CNAME"}
+
+// isALIASType reports whether recordType is ALIAS in any spelling.
+func isALIASType(recordType string) bool {
+	return strings.EqualFold(recordType, "ALIAS")
+}
+
+// validateALIASTarget checks an ALIAS content is a host name; like CNAME
+// targets, a trailing dot is optional and preserved in state.
+func validateALIASTarget(content string) error {
+	target := strings.TrimSuffix(strings.TrimSpace(content), ".")
+	if target == "" || strings.ContainsAny(target, " \t") {
+		return fmt.Errorf("%q is not a valid ALIAS target; expected a host name such as lb.example.net", content)
+	}
+	if _, err := netip.ParseAddr(target); err == nil {
+		return fmt.Errorf("%q is an IP address; ALIAS targets must be host names (use an A or AAAA record for addresses)", content)
+	}
+	return nil
+}
+
+// validateALIASPlacement errors when an ALIAS named name is not at the apex
+// of zoneID or would sit beside A, AAAA or CNAME records; returns false when
+// it added an error. Lookup failures are only logged and left for the write
+// itself to report.
+func validateALIASPlacement(ctx context.Context, client *Client, zoneID int64, name string, diags *diag.Diagnostics) bool {
+	zoneName, err := client.GetZoneName(ctx, zoneID)
+	if err != nil {
+		tflog.Debug(ctx, "Could not determine zone name for ALIAS placement check", map[string]interface{}{
+			"zone_id": zoneID,
+			"error":   err.Error(),
+		})
+		return true
+	}
+	if canonicalOwnerName(name, zoneName) != "@" {
+		diags.AddAttributeError(
+			path.Root("name"),
+			"ALIAS Record Not at Zone Apex",
+			fmt.Sprintf("ALIAS records are only supported at the zone apex (\"@\" or %q), got %q. Use a CNAME below the apex.", zoneName, name),
+		)
+		return false
+	}
+
+	rrsets, err := client.ListRRSets(ctx, zoneID, "")
+	if err != nil {
+		tflog.Debug(ctx, "Could not list RRSets for ALIAS conflict check", map[string]interface{}{
+			"zone_id": zoneID,
+			"error":   err.Error(),
+		})
+		return true
+	}
+	for _, rrset := range rrsets {
+		if len(rrset.Records) == 0 || canonicalOwnerName(rrset.Name, zoneName) != "@" {
+			continue
+		}
+		for _, conflict := range aliasConflictTypes {
+			if strings.EqualFold(rrset.Type, conflict) {
+				diags.AddAttributeError(
+					path.Root("type"),
+					"Conflicting Records at Zone Apex",
+					fmt.Sprintf("Zone %s already has %s records at the apex, which cannot coexist with an ALIAS. Remove them first.", zoneName, strings.ToUpper(rrset.Type)),
+				)
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// validatePlannedALIAS runs validateALIASPlacement at plan time when an
+// ALIAS is created or renamed and zone_id and name are already known.
+func validatePlannedALIAS(ctx context.Context, client *Client, req resource.ModifyPlanRequest, diags *diag.Diagnostics) {
+	if client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+	var zoneID types.Int64
+	var name DNSNameValue
+	var recordType types.String
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("zone_id"), &zoneID)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("type"), &recordType)...)
+	if diags.HasError() || !isALIASType(recordType.ValueString()) ||
+		zoneID.IsUnknown() || name.IsUnknown() || name.IsNull() {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		var priorName DNSNameValue
+		var priorType types.String
+		diags.Append(req.State.GetAttribute(ctx, path.Root("name"), &priorName)...)
+		diags.Append(req.State.GetAttribute(ctx, path.Root("type"), &priorType)...)
+		if diags.HasError() || (priorName.Equal(name) && isALIASType(priorType.ValueString())) {
+			return
+		}
+	}
+	validateALIASPlacement(ctx, client, zoneID.ValueInt64(), name.ValueString(), diags)
+}
+
+// isLUAType reports whether recordType is LUA in any spelling.
+func isLUAType(recordType string) bool {
+	return strings.EqualFold(recordType, "LUA")
+}
+
+// validateLUAContent checks LUA content names the type the snippet answers
+// for, followed by the snippet. The Lua code itself is only checked by
+// PowerDNS when the record is queried.
+func validateLUAContent(content string) error {
+	recordType, snippet, ok := splitLUAContent(content)
+	if !ok || snippet == "" {
+		return fmt.Errorf("%q is not valid LUA content; expected a record type followed by a Lua snippet, such as A \"ifportup(443, {'192.0.2.1', '192.0.2.2'})\"", content)
+	}
+	if isLUAType(recordType) || strings.IndexFunc(recordType, func(r rune) bool {
+		return (r < 'A' || r > 'Z') && (r < '0' || r > '9')
+	}) >= 0 {
+		return fmt.Errorf("%q is not a record type a LUA snippet can answer for, in content %q", recordType, content)
+	}
+	if strings.HasPrefix(snippet, `"`) && (len(snippet) < 2 || !strings.HasSuffix(snippet, `"`)) {
+		return fmt.Errorf("the Lua snippet of %q starts with a quote but does not end with one", content)
+	}
+	return nil
+}
+
+// rejectForbiddenLUA errors when recordType is LUA and the provider sets
+// forbid_lua_records; returns false when it added an error.
+func rejectForbiddenLUA(client *Client, recordType string, diags *diag.Diagnostics) bool {
+	if client == nil || !client.ForbidLUARecords || !isLUAType(recordType) {
+		return true
+	}
+	diags.AddAttributeError(
+		path.Root("type"),
+		"LUA Records Forbidden",
+		"The provider sets forbid_lua_records, so LUA records, which run code on the DNS server, cannot be managed. "+
+			"Use static records, or remove forbid_lua_records from the provider configuration.",
+	)
+	return false
+}
+
+// validatePlannedLUA runs rejectForbiddenLUA at plan time when a record is
+// created or changed and its type is known; unchanged LUA records already on
+// the server still refresh, and can be destroyed.
+func validatePlannedLUA(ctx context.Context, client *Client, req resource.ModifyPlanRequest, diags *diag.Diagnostics) {
+	if client == nil || req.Plan.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+	var recordType types.String
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("type"), &recordType)...)
+	if diags.HasError() || recordType.IsUnknown() {
+		return
+	}
+	rejectForbiddenLUA(client, recordType.ValueString(), diags)
+}
+
+// requiresReplaceUnlessSameIDN forces replacement when a name changes, except
+// between spellings of the same name: Unicode and punycode (e.g. a zone
+// imported in the punycode form the API lists, configured in Unicode), case
+// and a trailing dot.
+func requiresReplaceUnlessSameIDN() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !sameDNSName(req.StateValue.ValueString(), req.PlanValue.ValueString())
+		},
+		"Changing the name forces replacement unless only its spelling changes (Unicode or punycode, case, trailing dot).",
+		"Changing the name forces replacement unless only its spelling changes (Unicode or punycode, case, trailing dot).",
+	)
+}
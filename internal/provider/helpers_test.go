@@ -3,7 +3,19 @@
 
 package provider
 
-import "testing"
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/netip"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
 
 func TestParseImportIDPair(t *testing.T) {
 	tests := []struct {
@@ -32,3 +44,271 @@ func TestParseImportIDPair(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateZoneAcceptsRecords(t *testing.T) {
+	tests := []struct {
+		name     string
+		zoneType string
+		status   int
+		want     bool
+	}{
+		{"master accepted", "MASTER", http.StatusOK, true},
+		{"native accepted", "NATIVE", http.StatusOK, true},
+		{"slave rejected", "SLAVE", http.StatusOK, false},
+		{"lookup failure left to the write", "", http.StatusInternalServerError, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if tt.status != http.StatusOK {
+					respondError(t, w, tt.status, "boom")
+					return
+				}
+				respondJSON(t, w, ZoneResponse{Zone: Zone{ID: 7, Name: "example.com", Type: tt.zoneType}})
+			})
+
+			var diags diag.Diagnostics
+			got := validateZoneAcceptsRecords(context.Background(), client, 7, &diags)
+			if got != tt.want {
+				t.Errorf("validateZoneAcceptsRecords() = %v, want %v", got, tt.want)
+			}
+			if diags.HasError() == tt.want {
+				t.Errorf("unexpected diagnostics: %v", diags)
+			}
+		})
+	}
+}
+
+func TestMaskContentLogs(t *testing.T) {
+	const secret = "acme-challenge-token-123"
+
+	for _, sensitive := range []bool{true, false} {
+		var out bytes.Buffer
+		ctx := tflogtest.RootLogger(context.Background(), &out)
+		ctx = maskContentLogs(ctx, sensitive, secret)
+
+		tflog.Debug(ctx, "Creating record", map[string]interface{}{"name": "_acme-challenge", "note": "value " + secret})
+		tflog.Debug(ctx, "Request body", map[string]interface{}{"body": `{"content":"other-secret"}`})
+
+		logged := out.String()
+		if leaked := strings.Contains(logged, secret) || strings.Contains(logged, "other-secret"); leaked == sensitive {
+			t.Errorf("sensitive=%v: unexpected log output %s", sensitive, logged)
+		}
+		if !strings.Contains(logged, "_acme-challenge") {
+			t.Errorf("sensitive=%v: unrelated fields must stay visible, got %s", sensitive, logged)
+		}
+	}
+}
+
+func TestFindReverseZone(t *testing.T) {
+	zones := []Zone{
+		{ID: 1, Name: "example.com"},
+		{ID: 2, Name: "10.in-addr.arpa"},
+		{ID: 3, Name: "20.10.in-addr.arpa"},
+		{ID: 4, Name: "8.b.d.0.1.0.0.2.ip6.arpa."},
+		{ID: 5, Name: "2.0.192.in-addr.arpa"},
+	}
+	tests := []struct {
+		ip       string
+		wantID   int
+		wantName string
+	}{
+		{"10.20.30.40", 3, "40.30"},
+		{"10.99.0.1", 2, "1.0.99"},
+		{"192.0.2.10", 5, "10"},
+		{"::ffff:192.0.2.10", 5, "10"},
+		{"2001:db8::1", 4, "1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0"},
+		{"198.51.100.1", 0, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ip, func(t *testing.T) {
+			zone, name := findReverseZone(zones, netip.MustParseAddr(tt.ip))
+			if tt.wantID == 0 {
+				if zone != nil {
+					t.Fatalf("expected no zone, got %s", zone.Name)
+				}
+				return
+			}
+			if zone == nil || zone.ID != tt.wantID || name != tt.wantName {
+				t.Errorf("findReverseZone() = %v, %q, want zone %d, %q", zone, name, tt.wantID, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestFindZoneForName(t *testing.T) {
+	zones := []Zone{
+		{ID: 1, Name: "example.com"},
+		{ID: 2, Name: "eu.example.com."},
+		{ID: 3, Name: "xn--bcher-kva.example"},
+		{ID: 4, Name: "ample.com"},
+	}
+	tests := []struct {
+		fqdn     string
+		wantID   int
+		wantName string
+	}{
+		{"api.eu.example.com", 2, "api"},
+		{"API.EU.Example.com.", 2, "api"},
+		{"eu.example.com", 2, "@"},
+		{"www.example.com", 1, "www"},
+		{"a.b.example.com", 1, "a.b"},
+		{"example.com", 1, "@"},
+		{"www.bücher.example", 3, "www"},
+		{"www.sample.com", 0, ""},
+		{"example.org", 0, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fqdn, func(t *testing.T) {
+			zone, name := findZoneForName(zones, tt.fqdn)
+			if tt.wantID == 0 {
+				if zone != nil {
+					t.Fatalf("expected no zone, got %s", zone.Name)
+				}
+				return
+			}
+			if zone == nil || zone.ID != tt.wantID || name != tt.wantName {
+				t.Errorf("findZoneForName() = %v, %q, want zone %d, %q", zone, name, tt.wantID, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestPTRNameInZone(t *testing.T) {
+	tests := []struct {
+		ip      string
+		zone    string
+		want    string
+		wantErr bool
+	}{
+		{"192.0.2.10", "2.0.192.in-addr.arpa", "10", false},
+		{"192.0.2.10", "0.192.in-addr.arpa.", "10.2", false},
+		{"2001:db8::1", "0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa", "1.0.0.0.0.0.0.0.0.0.0.0", false},
+		{"192.0.3.10", "2.0.192.in-addr.arpa", "", true},
+		{"192.0.2.10", "example.com", "", true},
+		{"not-an-ip", "2.0.192.in-addr.arpa", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ip+" in "+tt.zone, func(t *testing.T) {
+			got, err := ptrNameInZone(tt.ip, tt.zone)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ptrNameInZone() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ptrNameInZone() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidatePTRAddressConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		recName   types.String
+		recType   types.String
+		ipAddress types.String
+		wantErr   bool
+	}{
+		{"name only", types.StringValue("www"), types.StringValue("A"), types.StringNull(), false},
+		{"ip_address on PTR", types.StringNull(), types.StringValue("ptr"), types.StringValue("192.0.2.10"), false},
+		{"unknown ip_address", types.StringNull(), types.StringValue("PTR"), types.StringUnknown(), false},
+		{"neither", types.StringNull(), types.StringValue("A"), types.StringNull(), true},
+		{"both", types.StringValue("10"), types.StringValue("PTR"), types.StringValue("192.0.2.10"), true},
+		{"ip_address on A", types.StringNull(), types.StringValue("A"), types.StringValue("192.0.2.10"), true},
+		{"bad ip_address", types.StringNull(), types.StringValue("PTR"), types.StringValue("192.0.2"), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			validatePTRAddressConfig(tt.recName, tt.recType, tt.ipAddress, &diags)
+			if diags.HasError() != tt.wantErr {
+				t.Errorf("validatePTRAddressConfig() errors = %v, wantErr %v", diags, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateALIASTarget(t *testing.T) {
+	for _, content := range []string{"lb.example.net", "lb.example.net.", "cdn"} {
+		if err := validateALIASTarget(content); err != nil {
+			t.Errorf("validateALIASTarget(%q) = %v, want nil", content, err)
+		}
+	}
+	for _, content := range []string{"", ".", "192.0.2.1", "2001:db8::1", "lb example.net"} {
+		if err := validateALIASTarget(content); err == nil {
+			t.Errorf("validateALIASTarget(%q) = nil, want error", content)
+		}
+	}
+}
+
+func TestValidateLUAContent(t *testing.T) {
+	for _, content := range []string{
+		"A ifportup(443, {'192.0.2.1', '192.0.2.2'})",
+		`A "ifportup(443, {'192.0.2.1', '192.0.2.2'})"`,
+		"cname pickrandom({'a.example.net', 'b.example.net'})",
+		`TXT ";include('config') return 'ok'"`,
+	} {
+		if err := validateLUAContent(content); err != nil {
+			t.Errorf("validateLUAContent(%q) = %v, want nil", content, err)
+		}
+	}
+	for _, content := range []string{"", "A", "ifportup(443, {'192.0.2.1'})", "LUA \"x\"", `A "ifportup(443, {'192.0.2.1'})`} {
+		if err := validateLUAContent(content); err == nil {
+			t.Errorf("validateLUAContent(%q) = nil, want error", content)
+		}
+	}
+}
+
+func TestRejectForbiddenLUA(t *testing.T) {
+	tests := []struct {
+		forbid     bool
+		recordType string
+		want       bool
+	}{
+		{false, "LUA", true},
+		{true, "lua", false},
+		{true, "A", true},
+	}
+	for _, tt := range tests {
+		var diags diag.Diagnostics
+		got := rejectForbiddenLUA(&Client{ForbidLUARecords: tt.forbid}, tt.recordType, &diags)
+		if got != tt.want || diags.HasError() == tt.want {
+			t.Errorf("forbid=%v type=%s: got %v with diagnostics %v, want %v", tt.forbid, tt.recordType, got, diags, tt.want)
+		}
+	}
+}
+
+func TestValidateALIASPlacement(t *testing.T) {
+	tests := []struct {
+		name   string
+		record string
+		rrsets []RRSet
+		want   bool
+	}{
+		{"apex shorthand", "@", []RRSet{{Name: "www", Type: "A", Records: []RRSetRecord{{Content: "192.0.2.1"}}}}, true},
+		{"apex fqdn", "Example.com.", []RRSet{{Name: "example.com", Type: "MX", Records: []RRSetRecord{{Content: "mail.example.com"}}}}, true},
+		{"below apex", "www", nil, false},
+		{"apex A conflict", "@", []RRSet{{Name: "@", Type: "A", Records: []RRSetRecord{{Content: "192.0.2.1"}}}}, false},
+		{"apex CNAME conflict", "@", []RRSet{{Name: "example.com", Type: "cname", Records: []RRSetRecord{{Content: "lb.example.net"}}}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if strings.HasSuffix(r.URL.Path, "/rrsets") {
+					respondJSON(t, w, RRSetListResponse{RRSets: tt.rrsets})
+					return
+				}
+				respondJSON(t, w, ZoneResponse{Zone: Zone{ID: 7, Name: "example.com", Type: "MASTER"}})
+			})
+
+			var diags diag.Diagnostics
+			got := validateALIASPlacement(context.Background(), client, 7, tt.record, &diags)
+			if got != tt.want {
+				t.Errorf("validateALIASPlacement() = %v, want %v (diags %v)", got, tt.want, diags)
+			}
+			if diags.HasError() == tt.want {
+				t.Errorf("unexpected diagnostics: %v", diags)
+			}
+		})
+	}
+}
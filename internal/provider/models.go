@@ -50,6 +50,22 @@ type ZoneResponse struct {
 	Zone Zone `json:"zone"`
 }
 
+// ZoneOwner represents a user owning a zone in a zone owners API response.
+type ZoneOwner struct {
+	UserID   int    `json:"user_id"`
+	Username string `json:"username"`
+}
+
+// ZoneOwnerListResponse represents the response from listing zone owners.
+type ZoneOwnerListResponse struct {
+	Owners []ZoneOwner `json:"owners"`
+}
+
+// ZoneOwnerRequest represents the request to add an owner to a zone.
+type ZoneOwnerRequest struct {
+	UserID int `json:"user_id"`
+}
+
 // CreateZoneResponse represents the response from creating a zone.
 type CreateZoneResponse struct {
 	ZoneID int `json:"zone_id"`
@@ -74,6 +90,20 @@ type UpdateZoneRequest struct {
 	Description *string `json:"description,omitempty"`
 }
 
+// ZoneDefaults represents the server-side defaults Poweradmin applies to new
+// zones and records.
+type ZoneDefaults struct {
+	Hostmaster  string   `json:"hostmaster"`
+	Nameservers []string `json:"nameservers"`
+	TTL         int      `json:"ttl"`
+	RecordTypes []string `json:"record_types"`
+}
+
+// ZoneDefaultsResponse represents the response for the DNS defaults endpoint.
+type ZoneDefaultsResponse struct {
+	Defaults ZoneDefaults `json:"defaults"`
+}
+
 // Record represents a DNS record in Poweradmin.
 type Record struct {
 	ID        RecordID `json:"id,omitempty"`
@@ -85,11 +115,18 @@ type Record struct {
 	Priority  int      `json:"priority,omitempty"` // For MX, SRV records
 	Disabled  bool     `json:"disabled"`
 	CreatePTR bool     `json:"create_ptr,omitempty"`
+
+	// ChangeDate is the Unix time PowerDNS last saw the record change; the
+	// timestamps are only reported by Poweradmin versions that keep them.
+	ChangeDate int64  `json:"change_date,omitempty"`
+	CreatedAt  string `json:"created_at,omitempty"`
+	UpdatedAt  string `json:"updated_at,omitempty"`
 }
 
 // RecordListResponse represents the response from listing records.
 type RecordListResponse struct {
-	Records []Record `json:"records"`
+	Records    []Record    `json:"records"`
+	Pagination *Pagination `json:"pagination,omitempty"`
 }
 
 // RecordResponse represents the response for a single record.
@@ -195,6 +232,19 @@ type PermissionResponse struct {
 	Permission Permission `json:"permission"`
 }
 
+// PermissionTemplate represents a permission template in Poweradmin.
+type PermissionTemplate struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Descr string `json:"descr"`
+}
+
+// PermissionTemplateListResponse represents the response from listing
+// permission templates.
+type PermissionTemplateListResponse struct {
+	PermissionTemplates []PermissionTemplate `json:"permission_templates"`
+}
+
 // Group represents a user group in Poweradmin.
 type Group struct {
 	ID          int    `json:"id,omitempty"`
@@ -239,6 +289,18 @@ type UpdateGroupRequest struct {
 	Description *string `json:"description"`
 }
 
+// UserPermissionRequest represents the request to assign a permission
+// directly to a user.
+type UserPermissionRequest struct {
+	PermissionID int `json:"permission_id"`
+}
+
+// PermissionTemplateItemRequest represents the request to add a permission
+// to a permission template.
+type PermissionTemplateItemRequest struct {
+	PermissionID int `json:"permission_id"`
+}
+
 // GroupMemberRequest represents the request to add a member to a group.
 type GroupMemberRequest struct {
 	UserID int `json:"user_id"`
@@ -336,3 +398,70 @@ type UpdateZoneTemplateRecordRequest struct {
 type createResponseID struct {
 	ID int `json:"id"`
 }
+
+// ZoneLogEntry represents a single entry from a zone's change log.
+type ZoneLogEntry struct {
+	ID        int    `json:"id"`
+	ZoneID    int    `json:"zone_id"`
+	User      string `json:"user"`
+	Event     string `json:"event"`
+	CreatedAt string `json:"created_at"`
+}
+
+// ZoneLogListResponse represents the response from listing a zone's change log.
+type ZoneLogListResponse struct {
+	Logs []ZoneLogEntry `json:"logs"`
+}
+
+// UserLogEntry represents a single entry from the user activity log.
+type UserLogEntry struct {
+	ID        int    `json:"id"`
+	User      string `json:"user"`
+	Event     string `json:"event"`
+	CreatedAt string `json:"created_at"`
+}
+
+// UserLogListResponse represents the response from listing the user activity log.
+type UserLogListResponse struct {
+	Logs []UserLogEntry `json:"logs"`
+}
+
+// UserLogFilter narrows a user activity log query. Zero values are not sent.
+type UserLogFilter struct {
+	Username string
+	Since    string // RFC 3339
+	Until    string // RFC 3339
+	Limit    int64
+}
+
+// ZoneMetadata represents the values of one zone metadata kind
+// (e.g. ALLOW-AXFR-FROM).
+type ZoneMetadata struct {
+	Kind     string   `json:"kind"`
+	Metadata []string `json:"metadata"`
+}
+
+// LoginRequest represents the request to open an API session.
+type LoginRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// Session represents an open API session.
+type Session struct {
+	Token     string `json:"token"`
+	ExpiresAt string `json:"expires_at,omitempty"`
+}
+
+// Supermaster represents a supermaster (autoprimary) entry: a master allowed
+// to provision SLAVE zones by sending NOTIFYs.
+type Supermaster struct {
+	IP         string `json:"ip"`
+	Nameserver string `json:"nameserver"`
+	Account    string `json:"account"`
+}
+
+// SupermasterListResponse represents the response from listing supermasters.
+type SupermasterListResponse struct {
+	Supermasters []Supermaster `json:"supermasters"`
+}
@@ -0,0 +1,122 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Zone and owner name attributes accept any case, an optional trailing dot
+// and Unicode labels, while the API stores lower-case punycode without the
+// dot. Values are compared in that canonical form, so state keeps whichever
+// spelling was configured. Zone template record names are not DNS names yet
+// and keep a plain string type.
+
+var (
+	_ basetypes.StringTypable                    = DNSNameType{}
+	_ basetypes.StringValuableWithSemanticEquals = DNSNameValue{}
+)
+
+// DNSNameType is the attribute type of DNSNameValue.
+type DNSNameType struct {
+	basetypes.StringType
+}
+
+func (t DNSNameType) String() string {
+	return "DNSNameType"
+}
+
+func (t DNSNameType) Equal(o attr.Type) bool {
+	other, ok := o.(DNSNameType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t DNSNameType) ValueType(ctx context.Context) attr.Value {
+	return DNSNameValue{}
+}
+
+func (t DNSNameType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return DNSNameValue{StringValue: in}, nil
+}
+
+func (t DNSNameType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return DNSNameValue{StringValue: stringValue}, nil
+}
+
+// DNSNameValue is a zone or owner name, relative or fully qualified.
+type DNSNameValue struct {
+	basetypes.StringValue
+}
+
+// NewDNSNameValue returns a known name.
+func NewDNSNameValue(name string) DNSNameValue {
+	return DNSNameValue{StringValue: basetypes.NewStringValue(name)}
+}
+
+// NewDNSNameNull returns a null name.
+func NewDNSNameNull() DNSNameValue {
+	return DNSNameValue{StringValue: basetypes.NewStringNull()}
+}
+
+// NewDNSNameUnknown returns an unknown name.
+func NewDNSNameUnknown() DNSNameValue {
+	return DNSNameValue{StringValue: basetypes.NewStringUnknown()}
+}
+
+func (v DNSNameValue) Type(ctx context.Context) attr.Type {
+	return DNSNameType{}
+}
+
+func (v DNSNameValue) Equal(o attr.Value) bool {
+	other, ok := o.(DNSNameValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals treats spellings of the same name as equal, so
+// "WWW.Example.com.", "www.example.com" and "münchen" against the API's
+// "xn--mnchen-3ya" do not produce a diff.
+func (v DNSNameValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	newValue, ok := newValuable.(DNSNameValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+	return sameDNSName(v.ValueString(), newValue.ValueString()), diags
+}
+
+// canonicalDNSName returns the form the API stores a name in: punycode labels
+// in lower case without a trailing dot.
+func canonicalDNSName(name string) string {
+	return strings.ToLower(strings.TrimSuffix(toASCIIName(name), "."))
+}
+
+// sameDNSName reports whether two spellings name the same owner.
+func sameDNSName(a, b string) bool {
+	return canonicalDNSName(a) == canonicalDNSName(b)
+}
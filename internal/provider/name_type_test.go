@@ -0,0 +1,50 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDNSNameValueSemanticEquals(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		prior, updated string
+		want           bool
+	}{
+		{"www", "www", true},
+		{"WWW", "www", true},
+		{"www.Example.com.", "www.example.com", true},
+		{"münchen.example", "xn--mnchen-3ya.example", true},
+		{"MÜNCHEN.example.", "xn--mnchen-3ya.example", true},
+		{"_dmarc", "_DMARC", true},
+		{"www", "www.example.com", false},
+		{"www", "ww", false},
+	}
+	for _, tt := range tests {
+		got, diags := NewDNSNameValue(tt.prior).StringSemanticEquals(ctx, NewDNSNameValue(tt.updated))
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if got != tt.want {
+			t.Errorf("StringSemanticEquals(%q, %q) = %v, want %v", tt.prior, tt.updated, got, tt.want)
+		}
+	}
+}
+
+func TestCanonicalDNSName(t *testing.T) {
+	tests := map[string]string{
+		"www":              "www",
+		"WWW.Example.com.": "www.example.com",
+		"München.example":  "xn--mnchen-3ya.example",
+		"@":                "@",
+		"*.Example.com":    "*.example.com",
+	}
+	for name, want := range tests {
+		if got := canonicalDNSName(name); got != want {
+			t.Errorf("canonicalDNSName(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
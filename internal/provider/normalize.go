@@ -4,15 +4,22 @@
 package provider
 
 import (
+	"net/netip"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
+	"unicode"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/net/idna"
 )
 
 // Helpers that keep state matching the plan without masking real drift:
 // preserving configured spellings the API normalizes (case, zone suffixes,
-// trailing dots, TXT quotes) and mapping empty API responses back to the
-// configured null/empty form. Used by the zone, record, rrset, and template resources.
+// trailing dots, TXT quotes, IPv6 addresses) and mapping empty API responses
+// back to the configured null/empty form. Used by the zone, record, rrset,
+// and template resources.
 
 // normalizeTypeCase preserves the configured type spelling when the API
 // returns the same type in different case (the server uppercases types).
@@ -36,6 +43,31 @@ func normalizeEmptyString(configured types.String, fromAPI string) types.String
 	return types.StringNull()
 }
 
+// changeDateValue formats a PowerDNS change_date (Unix time) as an RFC 3339
+// UTC timestamp; 0, which the API reports when it keeps none, becomes null.
+func changeDateValue(unix int64) types.String {
+	if unix <= 0 {
+		return types.StringNull()
+	}
+	return types.StringValue(time.Unix(unix, 0).UTC().Format(time.RFC3339))
+}
+
+// normalizeMasters preserves the configured masters when the API returns the
+// same servers in the same order spelled differently, e.g. with other
+// separators, without the default port 53, or with IPv6 brackets added or
+// dropped.
+func normalizeMasters(configured types.String, fromAPI string) types.String {
+	if !configured.IsNull() && !configured.IsUnknown() && fromAPI != "" {
+		want, err := parseMasters(configured.ValueString())
+		if err == nil {
+			if got, err := parseMasters(fromAPI); err == nil && slices.Equal(got, want) {
+				return configured
+			}
+		}
+	}
+	return normalizeEmptyString(configured, fromAPI)
+}
+
 // normalizeTXTQuotes preserves the configured TXT content when the API returns
 // it wrapped in the quotes that the server's txt_auto_quote setting adds.
 func normalizeTXTQuotes(configured, fromAPI, recordType string) string {
@@ -45,23 +77,126 @@ func normalizeTXTQuotes(configured, fromAPI, recordType string) string {
 	return fromAPI
 }
 
+// normalizeAutoQuotedTXT preserves the configured unquoted TXT content when
+// the API returns the quoted form auto_quote_txt sent for it.
+func normalizeAutoQuotedTXT(configured, fromAPI string) string {
+	if configured != "" && !strings.HasPrefix(configured, `"`) && strings.HasPrefix(fromAPI, `"`) &&
+		strings.Join(splitTXTStrings(fromAPI), "") == configured {
+		return configured
+	}
+	return fromAPI
+}
+
+// quoteTXTContent wraps unquoted TXT content in quotes, escaping quotes and
+// backslashes and splitting it into strings of at most 255 bytes. Content
+// that already starts with a quote is returned unchanged.
+func quoteTXTContent(content string) string {
+	if strings.HasPrefix(content, `"`) {
+		return content
+	}
+	escaper := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	var chunks []string
+	for {
+		n := min(len(content), maxTXTStringLength)
+		chunks = append(chunks, `"`+escaper.Replace(content[:n])+`"`)
+		content = content[n:]
+		if content == "" {
+			return strings.Join(chunks, " ")
+		}
+	}
+}
+
+// splitLUAContent splits LUA record content ("A \"ifportup(...)\"") into the
+// upper-cased type the snippet answers for and the snippet itself.
+func splitLUAContent(content string) (string, string, bool) {
+	content = strings.TrimSpace(content)
+	i := strings.IndexFunc(content, unicode.IsSpace)
+	if i < 0 {
+		return strings.ToUpper(content), "", false
+	}
+	return strings.ToUpper(content[:i]), strings.TrimSpace(content[i:]), true
+}
+
+// quoteLUAContent wraps the snippet of LUA content in the quotes PowerDNS
+// expects, escaping quotes and backslashes, so configurations can write
+// `A ifportup(443, {'192.0.2.1'})`. Content whose snippet is already quoted
+// only has its type upper-cased.
+func quoteLUAContent(content string) string {
+	recordType, snippet, ok := splitLUAContent(content)
+	if !ok {
+		return content
+	}
+	if !strings.HasPrefix(snippet, `"`) {
+		snippet = `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(snippet) + `"`
+	}
+	return recordType + " " + snippet
+}
+
+// normalizeQuotedLUA preserves the configured LUA content when the API
+// returns the quoted form quoteLUAContent sent for it.
+func normalizeQuotedLUA(configured, fromAPI string) string {
+	if configured != "" && quoteLUAContent(configured) == fromAPI {
+		return configured
+	}
+	return fromAPI
+}
+
+// autoQuoteTXT reports whether TXT content is quoted before sending: the
+// resource's auto_quote_txt when set, otherwise the provider's.
+func autoQuoteTXT(client *Client, setting types.Bool, recordType string) bool {
+	if !strings.EqualFold(recordType, "TXT") {
+		return false
+	}
+	if !setting.IsNull() && !setting.IsUnknown() {
+		return setting.ValueBool()
+	}
+	return client != nil && client.AutoQuoteTXT
+}
+
 // normalizeRecordContent preserves the configured content value when the API
-// strips trailing dots from FQDN content (CNAME, MX, NS, PTR, SRV records).
-func normalizeRecordContent(configured, fromAPI string) string {
-	if configured != "" && strings.TrimSuffix(configured, ".") == fromAPI {
+// strips trailing dots from FQDN content (CNAME, MX, NS, PTR, SRV records),
+// returns an A/AAAA address in another spelling or a LUA snippet quoted.
+func normalizeRecordContent(configured, fromAPI, recordType string) string {
+	if sameRecordContent(configured, fromAPI, recordType) {
 		return configured
 	}
 	return fromAPI
 }
 
+// sameRecordContent reports whether configured content is stored as fromAPI:
+// identical, differing only by a trailing dot, for A and AAAA records the
+// same address written differently (2001:db8:0:0::1 as 2001:db8::1), or for
+// LUA records the snippet in the quotes quoteLUAContent adds.
+func sameRecordContent(configured, fromAPI, recordType string) bool {
+	if configured == "" {
+		return false
+	}
+	if configured == fromAPI || strings.TrimSuffix(configured, ".") == fromAPI {
+		return true
+	}
+	if isLUAType(recordType) {
+		return quoteLUAContent(configured) == fromAPI
+	}
+	if !strings.EqualFold(recordType, "A") && !strings.EqualFold(recordType, "AAAA") {
+		return false
+	}
+	want, err := netip.ParseAddr(configured)
+	if err != nil {
+		return false
+	}
+	got, err := netip.ParseAddr(fromAPI)
+	return err == nil && got == want
+}
+
 // normalizeRecordName preserves the configured name when it is the FQDN form
 // of the relative name the API returned (zone suffix stripped, "@" for apex),
 // preventing "inconsistent result after apply" errors without masking real drift.
 func normalizeRecordName(configured, fromAPI, zoneName string) string {
-	if configured == fromAPI {
+	ascii := toASCIIName(configured)
+	if configured == fromAPI || ascii == fromAPI {
 		return configured
 	}
-	relative := strings.TrimSuffix(configured, ".")
+	relative := strings.TrimSuffix(ascii, ".")
 	zoneName = strings.TrimSuffix(zoneName, ".")
 	if zoneName == "" {
 		// Zone name unknown (lookup failed): trust the configured name the
@@ -82,3 +217,75 @@ func normalizeRecordName(configured, fromAPI, zoneName string) string {
 	}
 	return fromAPI
 }
+
+// toASCIIName converts the Unicode labels of a zone or owner name to their
+// punycode form ("münchen.example" -> "xn--mnchen-3ya.example"), which is
+// what the API stores. ASCII labels, including "@", "*" and underscore
+// labels, are left alone, as is any label IDNA rejects so the API reports it.
+func toASCIIName(name string) string {
+	if isASCII(name) {
+		return name
+	}
+	labels := strings.Split(name, ".")
+	for i, label := range labels {
+		if isASCII(label) {
+			continue
+		}
+		if converted, err := idna.Lookup.ToASCII(label); err == nil {
+			labels[i] = converted
+		}
+	}
+	return strings.Join(labels, ".")
+}
+
+// normalizeIDNName preserves a configured Unicode name when the API returns
+// its punycode form.
+func normalizeIDNName(configured, fromAPI string) string {
+	if !isASCII(configured) && strings.EqualFold(toASCIIName(configured), fromAPI) {
+		return configured
+	}
+	return fromAPI
+}
+
+// splitEmbeddedPriority moves a priority that some API versions prepend to
+// MX ("10 mail.example.com") and SRV ("10 5 5060 sip.example.com") content
+// back into the priority field, so state matches configs that set priority
+// separately. A non-zero priority reported alongside wins over the embedded
+// one. Content of any other shape is returned unchanged.
+func splitEmbeddedPriority(recordType, content string, priority int64) (string, int64) {
+	fields := strings.Fields(content)
+	var want int
+	switch strings.ToUpper(recordType) {
+	case "MX":
+		want = 2
+	case "SRV":
+		want = 4
+	default:
+		return content, priority
+	}
+	if len(fields) != want {
+		return content, priority
+	}
+	embedded, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil || embedded < 0 || embedded > 65535 {
+		return content, priority
+	}
+	if priority == 0 {
+		priority = embedded
+	}
+	return strings.Join(fields[1:], " "), priority
+}
+
+// normalizeRecordPriority applies splitEmbeddedPriority to an API record.
+func normalizeRecordPriority(record *Record) {
+	content, priority := splitEmbeddedPriority(record.Type, record.Content, int64(record.Priority))
+	record.Content, record.Priority = content, int(priority)
+}
+
+// normalizeRRSetPriorities applies splitEmbeddedPriority to an API RRSet.
+func normalizeRRSetPriorities(rrset *RRSet) {
+	for i := range rrset.Records {
+		rec := &rrset.Records[i]
+		rec.Content, rec.Priority = splitEmbeddedPriority(rrset.Type, rec.Content, rec.Priority)
+	}
+}
@@ -4,6 +4,7 @@
 package provider
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -33,6 +34,10 @@ func TestNormalizeRecordName(t *testing.T) {
 		{"unknown zone name trusts configured", "www.example.com", "www", "", "www.example.com"},
 		{"unknown zone name keeps identical value", "www", "www", "", "www"},
 		{"unknown zone name with empty configured takes api value", "", "www", "", "www"},
+		{"unicode relative preserved", "bücher", "xn--bcher-kva", "example.com", "bücher"},
+		{"unicode fqdn preserved", "www.münchen.example", "www", "xn--mnchen-3ya.example", "www.münchen.example"},
+		{"unicode apex preserved", "münchen.example", "@", "xn--mnchen-3ya.example", "münchen.example"},
+		{"unicode rename surfaces", "bücher", "xn--bcher-kva2", "example.com", "xn--bcher-kva2"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -43,6 +48,51 @@ func TestNormalizeRecordName(t *testing.T) {
 	}
 }
 
+func TestToASCIIName(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"ascii unchanged", "www.example.com", "www.example.com"},
+		{"apex unchanged", "@", "@"},
+		{"underscore labels unchanged", "_sip._tcp.example.com", "_sip._tcp.example.com"},
+		{"unicode zone", "münchen.example", "xn--mnchen-3ya.example"},
+		{"unicode label with trailing dot", "www.münchen.example.", "www.xn--mnchen-3ya.example."},
+		{"uppercase unicode lowered", "MÜNCHEN.example", "xn--mnchen-3ya.example"},
+		{"wildcard kept", "*.bücher.example", "*.xn--bcher-kva.example"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := toASCIIName(tt.in); got != tt.want {
+				t.Errorf("toASCIIName(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeIDNName(t *testing.T) {
+	tests := []struct {
+		name       string
+		configured string
+		fromAPI    string
+		want       string
+	}{
+		{"unicode preserved", "münchen.example", "xn--mnchen-3ya.example", "münchen.example"},
+		{"ascii takes api value", "example.com", "example.com", "example.com"},
+		{"ascii case drift surfaces", "Example.com", "example.com", "example.com"},
+		{"unicode rename surfaces", "münchen.example", "xn--bcher-kva.example", "xn--bcher-kva.example"},
+		{"empty configured takes api value", "", "xn--mnchen-3ya.example", "xn--mnchen-3ya.example"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := normalizeIDNName(tt.configured, tt.fromAPI); got != tt.want {
+				t.Errorf("normalizeIDNName(%q, %q) = %q, want %q", tt.configured, tt.fromAPI, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestNormalizeTypeCase(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -87,6 +137,64 @@ func TestNormalizeEmptyString(t *testing.T) {
 	}
 }
 
+func TestParseMasters(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    []string
+		wantErr bool
+	}{
+		{"single ipv4", "192.0.2.1", []string{"192.0.2.1"}, false},
+		{"ipv4 with port", "192.0.2.1:5300", []string{"192.0.2.1:5300"}, false},
+		{"default port dropped", "192.0.2.1:53", []string{"192.0.2.1"}, false},
+		{"bare ipv6", "2001:db8::1", []string{"2001:db8::1"}, false},
+		{"bracketed ipv6", "[2001:db8::1]", []string{"2001:db8::1"}, false},
+		{"bracketed ipv6 with port", "[2001:db8::1]:5300", []string{"[2001:db8::1]:5300"}, false},
+		{"ipv6 canonicalized", "2001:DB8:0::1", []string{"2001:db8::1"}, false},
+		{"mixed separators", "192.0.2.1, 192.0.2.2:5300;[2001:db8::1]:5300", []string{"192.0.2.1", "192.0.2.2:5300", "[2001:db8::1]:5300"}, false},
+		{"hostname rejected", "ns1.example.net", nil, true},
+		{"port zero rejected", "192.0.2.1:0", nil, true},
+		{"port out of range rejected", "192.0.2.1:70000", nil, true},
+		{"unbracketed ipv6 port rejected", "2001:db8::1:5300:", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseMasters(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseMasters(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			}
+			if !tt.wantErr && strings.Join(got, " ") != strings.Join(tt.want, " ") {
+				t.Errorf("parseMasters(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeMasters(t *testing.T) {
+	tests := []struct {
+		name       string
+		configured types.String
+		fromAPI    string
+		want       types.String
+	}{
+		{"identical kept", types.StringValue("192.0.2.1:5300"), "192.0.2.1:5300", types.StringValue("192.0.2.1:5300")},
+		{"separators preserved", types.StringValue("192.0.2.1; 192.0.2.2"), "192.0.2.1,192.0.2.2", types.StringValue("192.0.2.1; 192.0.2.2")},
+		{"default port preserved", types.StringValue("192.0.2.1:53"), "192.0.2.1", types.StringValue("192.0.2.1:53")},
+		{"ipv6 brackets preserved", types.StringValue("[2001:db8::1]"), "2001:db8::1", types.StringValue("[2001:db8::1]")},
+		{"changed port surfaces", types.StringValue("192.0.2.1:5300"), "192.0.2.1:5301", types.StringValue("192.0.2.1:5301")},
+		{"reordered masters surface", types.StringValue("192.0.2.1,192.0.2.2"), "192.0.2.2,192.0.2.1", types.StringValue("192.0.2.2,192.0.2.1")},
+		{"dropped value surfaces as null", types.StringValue("192.0.2.1"), "", types.StringNull()},
+		{"null takes api value", types.StringNull(), "192.0.2.1", types.StringValue("192.0.2.1")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := normalizeMasters(tt.configured, tt.fromAPI); !got.Equal(tt.want) {
+				t.Errorf("normalizeMasters(%v, %q) = %v, want %v", tt.configured, tt.fromAPI, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestNormalizeTXTQuotes(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -111,23 +219,122 @@ func TestNormalizeTXTQuotes(t *testing.T) {
 	}
 }
 
+func TestQuoteTXTContent(t *testing.T) {
+	long := strings.Repeat("a", 300)
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"short content quoted", "v=spf1 -all", `"v=spf1 -all"`},
+		{"already quoted unchanged", `"part1" "part2"`, `"part1" "part2"`},
+		{"quotes and backslashes escaped", `say "hi" \o/`, `"say \"hi\" \\o/"`},
+		{"long content split", long, `"` + long[:255] + `" "` + long[255:] + `"`},
+		{"empty content", "", `""`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := quoteTXTContent(tt.content)
+			if got != tt.want {
+				t.Errorf("quoteTXTContent(%q) = %q, want %q", tt.content, got, tt.want)
+			}
+			// The API's quoted form reads back as the configured content
+			if tt.content != "" && normalizeAutoQuotedTXT(tt.content, got) != tt.content {
+				t.Errorf("normalizeAutoQuotedTXT(%q, %q) did not round-trip", tt.content, got)
+			}
+		})
+	}
+
+	if got := normalizeAutoQuotedTXT("v=spf1 -all", `"v=spf1 ~all"`); got != `"v=spf1 ~all"` {
+		t.Errorf("expected a real change to surface, got %q", got)
+	}
+}
+
+func TestQuoteLUAContent(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"snippet quoted", "A ifportup(443, {'192.0.2.1', '192.0.2.2'})", `A "ifportup(443, {'192.0.2.1', '192.0.2.2'})"`},
+		{"type upper-cased", `a "ifportup(443, {'192.0.2.1'})"`, `A "ifportup(443, {'192.0.2.1'})"`},
+		{"already quoted unchanged", `CNAME "pickrandom({'a.example.net', 'b.example.net'})"`, `CNAME "pickrandom({'a.example.net', 'b.example.net'})"`},
+		{"quotes and backslashes escaped", `TXT 'a' .. "b" .. '\n'`, `TXT "'a' .. \"b\" .. '\\n'"`},
+		{"tab separated", "AAAA\tpickrandom({'2001:db8::1'})", `AAAA "pickrandom({'2001:db8::1'})"`},
+		{"no snippet unchanged", "A", "A"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := quoteLUAContent(tt.content); got != tt.want {
+				t.Errorf("quoteLUAContent(%q) = %q, want %q", tt.content, got, tt.want)
+			}
+		})
+	}
+
+	configured := "A ifportup(443, {'192.0.2.1'})"
+	if !sameRecordContent(configured, `A "ifportup(443, {'192.0.2.1'})"`, "LUA") {
+		t.Error("expected the quoted form the API returns to match the configured content")
+	}
+	if sameRecordContent(configured, `A "ifportup(443, {'192.0.2.9'})"`, "LUA") {
+		t.Error("expected a real change to surface")
+	}
+	if got := normalizeQuotedLUA(configured, `A "ifportup(443, {'192.0.2.1'})"`); got != configured {
+		t.Errorf("normalizeQuotedLUA() = %q, want the configured content", got)
+	}
+}
+
 func TestNormalizeRecordContent(t *testing.T) {
 	tests := []struct {
 		name       string
 		configured string
 		fromAPI    string
+		recordType string
 		want       string
 	}{
-		{"trailing dot preserved", "mail.example.com.", "mail.example.com", "mail.example.com."},
-		{"identical values", "mail.example.com", "mail.example.com", "mail.example.com"},
-		{"api keeps dot", "mail.example.com.", "mail.example.com.", "mail.example.com."},
-		{"external change surfaces", "mail.example.com.", "other.example.com", "other.example.com"},
-		{"empty configured takes api value", "", "mail.example.com", "mail.example.com"},
+		{"trailing dot preserved", "mail.example.com.", "mail.example.com", "CNAME", "mail.example.com."},
+		{"identical values", "mail.example.com", "mail.example.com", "CNAME", "mail.example.com"},
+		{"api keeps dot", "mail.example.com.", "mail.example.com.", "CNAME", "mail.example.com."},
+		{"external change surfaces", "mail.example.com.", "other.example.com", "CNAME", "other.example.com"},
+		{"empty configured takes api value", "", "mail.example.com", "CNAME", "mail.example.com"},
+		{"expanded ipv6 preserved", "2001:db8:0:0::1", "2001:db8::1", "AAAA", "2001:db8:0:0::1"},
+		{"uppercase ipv6 preserved", "2001:DB8::1", "2001:db8::1", "aaaa", "2001:DB8::1"},
+		{"changed ipv6 surfaces", "2001:db8:0:0::1", "2001:db8::2", "AAAA", "2001:db8::2"},
+		{"ipv4-mapped ipv6 is not ipv4", "::ffff:192.0.2.1", "192.0.2.1", "AAAA", "192.0.2.1"},
+		{"address spelling ignored for other types", "2001:db8:0:0::1", "2001:db8::1", "TXT", "2001:db8::1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := normalizeRecordContent(tt.configured, tt.fromAPI, tt.recordType); got != tt.want {
+				t.Errorf("normalizeRecordContent(%q, %q, %q) = %q, want %q", tt.configured, tt.fromAPI, tt.recordType, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitEmbeddedPriority(t *testing.T) {
+	tests := []struct {
+		name         string
+		recordType   string
+		content      string
+		priority     int64
+		wantContent  string
+		wantPriority int64
+	}{
+		{"mx embedded", "MX", "10 mail.example.com", 0, "mail.example.com", 10},
+		{"mx lowercase type", "mx", "10 mail.example.com.", 0, "mail.example.com.", 10},
+		{"mx separate", "MX", "mail.example.com", 10, "mail.example.com", 10},
+		{"mx both, reported priority wins", "MX", "5 mail.example.com", 10, "mail.example.com", 10},
+		{"srv embedded", "SRV", "10 60 5060 sip.example.com", 0, "60 5060 sip.example.com", 10},
+		{"srv separate", "SRV", "60 5060 sip.example.com", 10, "60 5060 sip.example.com", 10},
+		{"mx non-numeric first field", "MX", "mail example", 0, "mail example", 0},
+		{"txt untouched", "TXT", "10 apples", 0, "10 apples", 0},
+		{"a untouched", "A", "192.0.2.1", 0, "192.0.2.1", 0},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := normalizeRecordContent(tt.configured, tt.fromAPI); got != tt.want {
-				t.Errorf("normalizeRecordContent(%q, %q) = %q, want %q", tt.configured, tt.fromAPI, got, tt.want)
+			content, priority := splitEmbeddedPriority(tt.recordType, tt.content, tt.priority)
+			if content != tt.wantContent || priority != tt.wantPriority {
+				t.Errorf("splitEmbeddedPriority() = %q, %d, want %q, %d", content, priority, tt.wantContent, tt.wantPriority)
 			}
 		})
 	}
@@ -0,0 +1,239 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PermissionCheckDataSource{}
+
+func NewPermissionCheckDataSource() datasource.DataSource {
+	return &PermissionCheckDataSource{}
+}
+
+// PermissionCheckDataSource defines the data source implementation.
+type PermissionCheckDataSource struct {
+	client *Client
+}
+
+// PermissionCheckDataSourceModel describes the data source data model.
+type PermissionCheckDataSourceModel struct {
+	Permissions   []types.String `tfsdk:"permissions"`
+	FailOnMissing types.Bool     `tfsdk:"fail_on_missing"`
+	Username      types.String   `tfsdk:"username"`
+	Granted       types.List     `tfsdk:"granted"`
+	Missing       types.List     `tfsdk:"missing"`
+	AllGranted    types.Bool     `tfsdk:"all_granted"`
+}
+
+func (d *PermissionCheckDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permission_check"
+}
+
+func (d *PermissionCheckDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Checks which of the given permissions the provider's credentials effectively hold, so a configuration can fail while planning with a readable message " +
+			"instead of midway through an apply. Administrators hold every permission.",
+
+		Attributes: map[string]schema.Attribute{
+			"permissions": schema.ListAttribute{
+				MarkdownDescription: "Names of the permissions to check, e.g. `[\"zone_master_add\", \"zone_content_edit_own\"]`. Names Poweradmin does not know produce a warning.",
+				Required:            true,
+				ElementType:         types.StringType,
+			},
+			"fail_on_missing": schema.BoolAttribute{
+				MarkdownDescription: "Fail the read, naming the missing permissions, when any of them is not held. Defaults to false.",
+				Optional:            true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Username of the authenticated user whose permissions were checked",
+				Computed:            true,
+			},
+			"granted": schema.ListAttribute{
+				MarkdownDescription: "The checked permissions the user holds, in the order given",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"missing": schema.ListAttribute{
+				MarkdownDescription: "The checked permissions the user lacks, in the order given",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"all_granted": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user holds every checked permission",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *PermissionCheckDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PermissionCheckDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PermissionCheckDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requested := make([]string, 0, len(data.Permissions))
+	for _, permission := range data.Permissions {
+		if permission.IsUnknown() {
+			resp.Diagnostics.AddError(
+				"Unknown configuration value",
+				"A permissions value is unknown at plan time. Data sources cannot be read until all configuration values are known.",
+			)
+			return
+		}
+		if !permission.IsNull() {
+			requested = append(requested, permission.ValueString())
+		}
+	}
+
+	user, err := d.client.GetCurrentUser(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Current User", fmt.Sprintf("Could not read the authenticated user: %s", err.Error()))
+		return
+	}
+	held, err := effectivePermissions(ctx, d.client, user)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Permissions",
+			fmt.Sprintf("Could not read the permissions of user %s: %s", user.Username, err.Error()),
+		)
+		return
+	}
+	d.warnUnknownPermissions(ctx, requested, &resp.Diagnostics)
+
+	granted, missing := checkPermissions(requested, held, user.IsAdmin)
+
+	tflog.Debug(ctx, "Checked permissions", map[string]interface{}{
+		"username": user.Username,
+		"granted":  granted,
+		"missing":  missing,
+	})
+
+	if len(missing) > 0 && data.FailOnMissing.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("permissions"),
+			"Missing Permissions",
+			fmt.Sprintf("User %s lacks the permissions %s, which this configuration requires. "+
+				"Grant them through the user's permission template or directly, or run with other credentials.", user.Username, strings.Join(missing, ", ")),
+		)
+		return
+	}
+
+	data.Username = types.StringValue(user.Username)
+	data.AllGranted = types.BoolValue(len(missing) == 0)
+	var diags diag.Diagnostics
+	data.Granted, diags = types.ListValueFrom(ctx, types.StringType, granted)
+	resp.Diagnostics.Append(diags...)
+	data.Missing, diags = types.ListValueFrom(ctx, types.StringType, missing)
+	resp.Diagnostics.Append(diags...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// warnUnknownPermissions warns about requested names Poweradmin does not
+// know, usually typos that would otherwise read as missing. Lookup failures
+// are only logged.
+func (d *PermissionCheckDataSource) warnUnknownPermissions(ctx context.Context, requested []string, diags *diag.Diagnostics) {
+	known, err := d.client.ListPermissions(ctx)
+	if err != nil {
+		tflog.Debug(ctx, "Could not list permissions to check names", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return
+	}
+	for _, name := range requested {
+		if !slices.ContainsFunc(known, func(p Permission) bool { return p.Name == name }) {
+			diags.AddAttributeWarning(
+				path.Root("permissions"),
+				"Unknown Permission",
+				fmt.Sprintf("Poweradmin has no permission named %q; check the spelling against the poweradmin_permission data source.", name),
+			)
+		}
+	}
+}
+
+// effectivePermissions returns the names of the permissions user holds: the
+// ones the API reports with the user or, when it reports none, those of the
+// user's permission template and the ones assigned to the user directly.
+func effectivePermissions(ctx context.Context, client *Client, user *User) (map[string]bool, error) {
+	held := map[string]bool{}
+	for _, name := range user.Permissions {
+		held[name] = true
+	}
+	if len(held) > 0 || user.IsAdmin {
+		return held, nil
+	}
+	var permissions []Permission
+	if user.PermTempl != 0 {
+		items, err := client.ListPermissionTemplateItems(ctx, user.PermTempl)
+		if err != nil {
+			return nil, err
+		}
+		permissions = append(permissions, items...)
+	}
+	direct, err := client.ListUserPermissions(ctx, user.UserID)
+	if err != nil && !IsNotFoundError(err) {
+		return nil, err
+	}
+	for _, permission := range append(permissions, direct...) {
+		held[permission.Name] = true
+	}
+	return held, nil
+}
+
+// checkPermissions splits the requested permission names into those held
+// and those missing, in request order without repeats. Administrators hold
+// every permission.
+func checkPermissions(requested []string, held map[string]bool, isAdmin bool) ([]string, []string) {
+	granted, missing := []string{}, []string{}
+	for _, name := range requested {
+		if slices.Contains(granted, name) || slices.Contains(missing, name) {
+			continue
+		}
+		if isAdmin || held[name] {
+			granted = append(granted, name)
+		} else {
+			missing = append(missing, name)
+		}
+	}
+	return granted, missing
+}
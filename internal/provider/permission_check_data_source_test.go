@@ -0,0 +1,75 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestCheckPermissions(t *testing.T) {
+	held := map[string]bool{"zone_content_view_own": true, "zone_content_edit_own": true}
+	requested := []string{"zone_content_edit_own", "zone_master_add", "zone_content_edit_own", "zone_content_view_own"}
+
+	granted, missing := checkPermissions(requested, held, false)
+	if strings.Join(granted, ",") != "zone_content_edit_own,zone_content_view_own" || strings.Join(missing, ",") != "zone_master_add" {
+		t.Errorf("got granted %v, missing %v", granted, missing)
+	}
+
+	granted, missing = checkPermissions(requested, nil, true)
+	if len(granted) != 3 || len(missing) != 0 {
+		t.Errorf("expected an administrator to hold every permission, got granted %v, missing %v", granted, missing)
+	}
+}
+
+func TestEffectivePermissions(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/permission-templates/2/items":
+			respondJSON(t, w, PermissionListResponse{Permissions: []Permission{{ID: 43, Name: "zone_content_view_own"}}})
+		case "/api/v2/users/7/permissions":
+			respondJSON(t, w, PermissionListResponse{Permissions: []Permission{{ID: 41, Name: "zone_master_add"}}})
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+	ctx := context.Background()
+
+	// Reported with the user: no further lookups
+	held, err := effectivePermissions(ctx, client, &User{UserID: 7, PermTempl: 2, Permissions: []string{"zone_content_edit_own"}})
+	if err != nil || len(held) != 1 || !held["zone_content_edit_own"] {
+		t.Errorf("expected the reported permissions, got %v (err %v)", held, err)
+	}
+
+	// Not reported: the template's and the direct ones
+	held, err = effectivePermissions(ctx, client, &User{UserID: 7, PermTempl: 2})
+	if err != nil || len(held) != 2 || !held["zone_content_view_own"] || !held["zone_master_add"] {
+		t.Errorf("expected template and direct permissions, got %v (err %v)", held, err)
+	}
+}
+
+func TestAccPermissionCheckDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+data "poweradmin_permission_check" "test" {
+  permissions = ["zone_master_add", "zone_content_edit_own"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.poweradmin_permission_check.test", "username"),
+					resource.TestCheckResourceAttrSet("data.poweradmin_permission_check.test", "all_granted"),
+					resource.TestCheckResourceAttrSet("data.poweradmin_permission_check.test", "missing.#"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,333 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &PermissionTemplateItemResource{}
+var _ resource.ResourceWithImportState = &PermissionTemplateItemResource{}
+var _ resource.ResourceWithModifyPlan = &PermissionTemplateItemResource{}
+
+func NewPermissionTemplateItemResource() resource.Resource {
+	return &PermissionTemplateItemResource{}
+}
+
+// PermissionTemplateItemResource defines the resource implementation.
+type PermissionTemplateItemResource struct {
+	client *Client
+}
+
+// PermissionTemplateItemResourceModel describes the resource data model.
+type PermissionTemplateItemResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	TemplateID     types.Int64  `tfsdk:"template_id"`
+	PermissionName types.String `tfsdk:"permission_name"`
+	PermissionID   types.Int64  `tfsdk:"permission_id"`
+}
+
+func (r *PermissionTemplateItemResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permission_template_item"
+}
+
+func (r *PermissionTemplateItemResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Adds a single permission to a Poweradmin permission template, for templates owned centrally that several teams contribute items to. " +
+			"Creating an item the template already has fails with an import hint instead of silently adopting it.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Composite identifier in the format `template_id/permission_name`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"template_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the permission template",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"permission_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the permission to add (e.g., `zone_content_edit_own`)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permission_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the permission",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *PermissionTemplateItemResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan resolves permission_id and rejects unknown permissions and
+// items the template already has when an item is created. Lookup failures
+// are only logged and left for Create to report.
+func (r *PermissionTemplateItemResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() {
+		return
+	}
+	var data PermissionTemplateItemResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.TemplateID.IsUnknown() || data.PermissionName.IsUnknown() {
+		return
+	}
+
+	permissionID, ok := r.resolveNewItem(ctx, data, &resp.Diagnostics)
+	if ok && permissionID != 0 {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("permission_id"), int64(permissionID))...)
+	}
+}
+
+// resolveNewItem returns the ID of the configured permission, erroring when
+// it does not exist or the template already grants it; returns false when it
+// added an error. A zero ID with true means a lookup failed and was logged.
+func (r *PermissionTemplateItemResource) resolveNewItem(ctx context.Context, data PermissionTemplateItemResourceModel, diags *diag.Diagnostics) (int, bool) {
+	templateID := int(data.TemplateID.ValueInt64())
+	name := data.PermissionName.ValueString()
+
+	permissions, err := r.client.ListPermissions(ctx)
+	if err != nil {
+		tflog.Debug(ctx, "Could not list permissions", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return 0, true
+	}
+	permissionID := 0
+	for _, p := range permissions {
+		if p.Name == name {
+			permissionID = p.ID
+			break
+		}
+	}
+	if permissionID == 0 {
+		diags.AddAttributeError(
+			path.Root("permission_name"),
+			"Unknown Permission",
+			fmt.Sprintf("Permission %q does not exist; use a name listed by the poweradmin_permission data source.", name),
+		)
+		return 0, false
+	}
+
+	items, err := r.client.ListPermissionTemplateItems(ctx, templateID)
+	if err != nil {
+		tflog.Debug(ctx, "Could not list permission template items", map[string]interface{}{
+			"template_id": templateID,
+			"error":       err.Error(),
+		})
+		return permissionID, true
+	}
+	for _, item := range items {
+		if item.ID == permissionID {
+			diags.AddAttributeError(
+				path.Root("permission_name"),
+				"Permission Already In Template",
+				fmt.Sprintf("Permission template %d already grants %q, possibly managed by another configuration. Import it to manage it here:\n\n  terraform import <address> %d/%s",
+					templateID, name, templateID, name),
+			)
+			return 0, false
+		}
+	}
+	return permissionID, true
+}
+
+func (r *PermissionTemplateItemResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PermissionTemplateItemResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	templateID := int(data.TemplateID.ValueInt64())
+	name := data.PermissionName.ValueString()
+
+	// Check again: another configuration may have added the item since plan
+	permissionID, ok := r.resolveNewItem(ctx, data, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	if permissionID == 0 {
+		permission, err := r.client.FindPermissionByName(ctx, name)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Resolving Permission",
+				fmt.Sprintf("Could not resolve permission %q: %s", name, err.Error()),
+			)
+			return
+		}
+		permissionID = permission.ID
+	}
+
+	tflog.Debug(ctx, "Adding permission to template", map[string]interface{}{
+		"template_id":   templateID,
+		"permission_id": permissionID,
+	})
+
+	err := r.client.AddPermissionTemplateItem(ctx, templateID, permissionID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Adding Permission Template Item",
+			fmt.Sprintf("Could not add permission %q to template %d: %s", name, templateID, err.Error()),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%d/%s", templateID, name))
+	data.PermissionID = types.Int64Value(int64(permissionID))
+
+	tflog.Debug(ctx, "Permission template item created successfully")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PermissionTemplateItemResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PermissionTemplateItemResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	templateID := int(data.TemplateID.ValueInt64())
+
+	tflog.Debug(ctx, "Reading permission template item", map[string]interface{}{
+		"template_id":     templateID,
+		"permission_name": data.PermissionName.ValueString(),
+	})
+
+	items, err := r.client.ListPermissionTemplateItems(ctx, templateID)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading Permission Template Item",
+			fmt.Sprintf("Could not read items of permission template %d: %s", templateID, err.Error()),
+		)
+		return
+	}
+
+	// Match by ID once known; imports only carry the name
+	var found *Permission
+	for i := range items {
+		if (data.PermissionID.IsNull() && items[i].Name == data.PermissionName.ValueString()) ||
+			(!data.PermissionID.IsNull() && int64(items[i].ID) == data.PermissionID.ValueInt64()) {
+			found = &items[i]
+			break
+		}
+	}
+
+	if found == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.PermissionID = types.Int64Value(int64(found.ID))
+	data.PermissionName = types.StringValue(found.Name)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PermissionTemplateItemResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All configurable attributes use RequiresReplace, so Update should never be called
+	resp.Diagnostics.AddError(
+		"Error Updating Permission Template Item",
+		"Permission template items do not support in-place updates. All changes require replacement.",
+	)
+}
+
+func (r *PermissionTemplateItemResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PermissionTemplateItemResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	templateID := int(data.TemplateID.ValueInt64())
+	permissionID := int(data.PermissionID.ValueInt64())
+
+	tflog.Debug(ctx, "Removing permission from template", map[string]interface{}{
+		"template_id":   templateID,
+		"permission_id": permissionID,
+	})
+
+	err := r.client.RemovePermissionTemplateItem(ctx, templateID, permissionID)
+	if err != nil {
+		if IsNotFoundError(err) {
+			tflog.Info(ctx, "Permission template item already removed, ignoring error", map[string]interface{}{
+				"template_id":   templateID,
+				"permission_id": permissionID,
+			})
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Removing Permission Template Item",
+			fmt.Sprintf("Could not remove permission %q from template %d: %s", data.PermissionName.ValueString(), templateID, err.Error()),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Permission template item deleted successfully")
+}
+
+func (r *PermissionTemplateItemResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	templateID, name, err := parseRecordImportID(req.ID)
+	if err != nil || name == "" {
+		resp.Diagnostics.AddError(
+			"Error Importing Permission Template Item",
+			fmt.Sprintf("Import ID must be in format 'template_id/permission_name', got: %s", req.ID),
+		)
+		return
+	}
+
+	data := PermissionTemplateItemResourceModel{
+		ID:             types.StringValue(req.ID),
+		TemplateID:     types.Int64Value(templateID),
+		PermissionName: types.StringValue(string(name)),
+		PermissionID:   types.Int64Null(), // resolved by Read
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,95 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPermissionTemplateItemResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+resource "poweradmin_permission_template_item" "test" {
+  template_id     = 1
+  permission_name = "zone_content_view_others"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("poweradmin_permission_template_item.test", "id", "1/zone_content_view_others"),
+					resource.TestCheckResourceAttrSet("poweradmin_permission_template_item.test", "permission_id"),
+				),
+			},
+			{
+				ResourceName:      "poweradmin_permission_template_item.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestPermissionTemplateItemResolveNewItem(t *testing.T) {
+	tests := []struct {
+		name      string
+		perm      string
+		itemsFail bool
+		wantID    int
+		wantOK    bool
+		wantError string
+	}{
+		{"available", "user_view_others", false, 53, true, ""},
+		{"unknown permission", "zone_master_add", false, 0, false, "Unknown Permission"},
+		{"already in template", "zone_content_view_own", false, 0, false, "Permission Already In Template"},
+		{"items lookup failure left to create", "user_view_others", true, 53, true, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/api/v2/permissions":
+					respondJSON(t, w, PermissionListResponse{Permissions: testPermissions})
+				case "/api/v2/permission-templates/1/items":
+					if tt.itemsFail {
+						respondError(t, w, http.StatusForbidden, "forbidden")
+						return
+					}
+					respondJSON(t, w, PermissionListResponse{Permissions: testPermissions[:1]})
+				default:
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+			})
+			r := &PermissionTemplateItemResource{client: client}
+			data := PermissionTemplateItemResourceModel{
+				TemplateID:     types.Int64Value(1),
+				PermissionName: types.StringValue(tt.perm),
+			}
+
+			var diags diag.Diagnostics
+			gotID, gotOK := r.resolveNewItem(context.Background(), data, &diags)
+			if gotID != tt.wantID || gotOK != tt.wantOK {
+				t.Errorf("resolveNewItem() = (%d, %v), want (%d, %v)", gotID, gotOK, tt.wantID, tt.wantOK)
+			}
+			if tt.wantError == "" {
+				if diags.HasError() {
+					t.Errorf("unexpected diagnostics: %v", diags)
+				}
+				return
+			}
+			if !diags.HasError() || !strings.Contains(diags.Errors()[0].Summary(), tt.wantError) {
+				t.Errorf("expected %q error, got %v", tt.wantError, diags)
+			}
+		})
+	}
+}
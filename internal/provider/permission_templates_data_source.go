@@ -0,0 +1,111 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &PermissionTemplatesDataSource{}
+
+func NewPermissionTemplatesDataSource() datasource.DataSource {
+	return &PermissionTemplatesDataSource{}
+}
+
+// PermissionTemplatesDataSource defines the data source implementation.
+type PermissionTemplatesDataSource struct {
+	client *Client
+}
+
+// PermissionTemplateSummaryModel describes a permission template entry.
+type PermissionTemplateSummaryModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+// PermissionTemplatesDataSourceModel describes the data source data model.
+type PermissionTemplatesDataSourceModel struct {
+	Templates []PermissionTemplateSummaryModel `tfsdk:"templates"`
+}
+
+func (d *PermissionTemplatesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permission_templates"
+}
+
+func (d *PermissionTemplatesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists all Poweradmin permission templates, e.g. to validate or look up the `perm_templ` of users and groups by name. Requires Poweradmin 4.2.0+.",
+
+		Attributes: map[string]schema.Attribute{
+			"templates": schema.ListNestedAttribute{
+				MarkdownDescription: "Permission templates",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Permission template ID",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Permission template name",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the permission template",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PermissionTemplatesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PermissionTemplatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PermissionTemplatesDataSourceModel
+
+	templates, err := d.client.ListPermissionTemplates(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Permission Templates",
+			fmt.Sprintf("Could not list permission templates: %s", err.Error()),
+		)
+		return
+	}
+
+	models := make([]PermissionTemplateSummaryModel, len(templates))
+	for i, t := range templates {
+		models[i] = PermissionTemplateSummaryModel{
+			ID:          types.Int64Value(int64(t.ID)),
+			Name:        types.StringValue(t.Name),
+			Description: types.StringValue(t.Descr),
+		}
+	}
+	data.Templates = models
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,53 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestListPermissionTemplates(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/api/v2/permission-templates" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		respondJSON(t, w, PermissionTemplateListResponse{PermissionTemplates: []PermissionTemplate{
+			{ID: 1, Name: "Administrator", Descr: "Administrator template with full rights."},
+			{ID: 2, Name: "Zone Manager", Descr: "Manages own zones"},
+		}})
+	})
+
+	templates, err := client.ListPermissionTemplates(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(templates) != 2 {
+		t.Fatalf("expected 2 templates, got %d", len(templates))
+	}
+	if templates[1].Name != "Zone Manager" || templates[1].Descr != "Manages own zones" {
+		t.Errorf("unexpected second template: %+v", templates[1])
+	}
+}
+
+func TestAccPermissionTemplatesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+data "poweradmin_permission_templates" "all" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.poweradmin_permission_templates.all", "templates.0.id"),
+					resource.TestCheckResourceAttrSet("data.poweradmin_permission_templates.all", "templates.0.name"),
+				),
+			},
+		},
+	})
+}
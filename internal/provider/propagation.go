@@ -0,0 +1,467 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/netip"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// defaultPropagationTimeout bounds wait_for_propagation when
+// propagation_timeout is unset.
+const defaultPropagationTimeout = 5 * time.Minute
+
+// dnsQueryTimeout bounds a single query to one server.
+const dnsQueryTimeout = 5 * time.Second
+
+// propagationPollInterval is how often the DNS servers are queried again
+// while waiting for a change; a variable so tests can shorten it.
+var propagationPollInterval = 5 * time.Second
+
+// propagationTypes maps the record types wait_for_propagation can verify to
+// their query type. CAA has no constant in dnsmessage.
+var propagationTypes = map[string]dnsmessage.Type{
+	"A":     dnsmessage.TypeA,
+	"AAAA":  dnsmessage.TypeAAAA,
+	"CAA":   dnsmessage.Type(257),
+	"CNAME": dnsmessage.TypeCNAME,
+	"MX":    dnsmessage.TypeMX,
+	"NS":    dnsmessage.TypeNS,
+	"PTR":   dnsmessage.TypePTR,
+	"SRV":   dnsmessage.TypeSRV,
+	"TXT":   dnsmessage.TypeTXT,
+}
+
+// supportsPropagationCheck reports whether wait_for_propagation can verify
+// records of the given type.
+func supportsPropagationCheck(recordType string) bool {
+	_, ok := propagationTypes[strings.ToUpper(recordType)]
+	return ok
+}
+
+// supportedPropagationTypes lists the verifiable types for error messages.
+func supportedPropagationTypes() string {
+	names := make([]string, 0, len(propagationTypes))
+	for name := range propagationTypes {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return strings.Join(names, ", ")
+}
+
+// parsePropagationTimeout returns the configured propagation_timeout, or the
+// default when it is unset.
+func parsePropagationTimeout(v types.String) (time.Duration, error) {
+	if v.IsNull() || v.IsUnknown() || v.ValueString() == "" {
+		return defaultPropagationTimeout, nil
+	}
+	timeout, err := time.ParseDuration(v.ValueString())
+	if err != nil || timeout <= 0 {
+		return 0, fmt.Errorf("invalid propagation_timeout %q: must be a positive duration such as 90s or 10m", v.ValueString())
+	}
+	return timeout, nil
+}
+
+// validatePropagationConfig checks propagation_timeout and rejects
+// wait_for_propagation on types whose answers cannot be verified.
+func validatePropagationConfig(wait types.Bool, timeout types.String, recordType types.String, diags *diag.Diagnostics) {
+	if !timeout.IsNull() && !timeout.IsUnknown() {
+		if _, err := parsePropagationTimeout(timeout); err != nil {
+			diags.AddAttributeError(path.Root("propagation_timeout"), "Invalid Propagation Timeout", err.Error())
+		}
+	}
+	if wait.ValueBool() && !recordType.IsNull() && !recordType.IsUnknown() && !supportsPropagationCheck(recordType.ValueString()) {
+		diags.AddAttributeError(
+			path.Root("wait_for_propagation"),
+			"Unsupported Record Type",
+			fmt.Sprintf("wait_for_propagation cannot verify %s records; supported types are %s.", recordType.ValueString(), supportedPropagationTypes()),
+		)
+	}
+}
+
+// awaitPropagation waits for a written change when wait_for_propagation is
+// set, reporting a timeout as an error. The change itself was applied, so
+// callers still save state.
+func awaitPropagation(ctx context.Context, client *Client, wait types.Bool, timeout types.String, check propagationCheck, diags *diag.Diagnostics) {
+	if !wait.ValueBool() || !supportsPropagationCheck(check.Type) || (len(check.Expected) == 0 && !check.Exact) {
+		return
+	}
+	limit, _ := parsePropagationTimeout(timeout)
+	if err := waitForPropagation(ctx, client, check, limit); err != nil {
+		diags.AddError(
+			"DNS Change Not Propagated",
+			fmt.Sprintf("The change was saved in Poweradmin, but %s. Dependent resources may still see the old value; "+
+				"check that the name servers reload zone changes (e.g. the PowerDNS cache TTLs) or raise propagation_timeout.", err),
+		)
+	}
+}
+
+// propagationCheck describes the records that must be served before a
+// change counts as propagated.
+type propagationCheck struct {
+	ZoneID int64
+	Name   string
+	Type   string
+	// Expected holds the wanted values in the form produced by
+	// normalizeServedValue.
+	Expected []string
+	// Exact requires the served values to match Expected exactly, for RRSets
+	// whose removed records must disappear too; otherwise Expected only has
+	// to be included.
+	Exact bool
+}
+
+// expectedServedValue renders record content and priority the way a server
+// answers it, so both sides compare equal.
+func expectedServedValue(recordType, content string, priority int64) string {
+	switch strings.ToUpper(recordType) {
+	case "MX", "SRV":
+		return normalizeServedValue(recordType, fmt.Sprintf("%d %s", priority, content))
+	}
+	return normalizeServedValue(recordType, content)
+}
+
+// normalizeServedValue canonicalizes a value of the given type: addresses in
+// their shortest form, host names lowercase without the trailing dot, TXT
+// strings unquoted and concatenated, and CAA values quoted.
+func normalizeServedValue(recordType, value string) string {
+	value = strings.TrimSpace(value)
+	switch strings.ToUpper(recordType) {
+	case "A", "AAAA":
+		if addr, err := netip.ParseAddr(value); err == nil {
+			return addr.String()
+		}
+	case "CNAME", "NS", "PTR":
+		return canonicalDNSName(value)
+	case "MX", "SRV":
+		fields := strings.Fields(value)
+		if len(fields) > 0 {
+			fields[len(fields)-1] = canonicalDNSName(fields[len(fields)-1])
+		}
+		return strings.Join(fields, " ")
+	case "TXT":
+		return strings.Join(splitTXTStrings(value), "")
+	case "CAA":
+		fields := strings.SplitN(value, " ", 3)
+		if len(fields) == 3 {
+			return fmt.Sprintf("%s %s %q", fields[0], strings.ToLower(fields[1]), strings.Trim(fields[2], `"`))
+		}
+	}
+	return value
+}
+
+// splitTXTStrings returns the character strings of TXT content written as
+// one or more quoted strings; unquoted content is a single string.
+func splitTXTStrings(content string) []string {
+	if !strings.HasPrefix(content, `"`) {
+		return []string{content}
+	}
+	var parts []string
+	var current strings.Builder
+	inQuotes := false
+	for i := 0; i < len(content); i++ {
+		ch := content[i]
+		switch {
+		case ch == '\\' && inQuotes && i+1 < len(content):
+			i++
+			current.WriteByte(content[i])
+		case ch == '"':
+			if inQuotes {
+				parts = append(parts, current.String())
+				current.Reset()
+			}
+			inQuotes = !inQuotes
+		case inQuotes:
+			current.WriteByte(ch)
+		}
+	}
+	if inQuotes {
+		parts = append(parts, current.String())
+	}
+	return parts
+}
+
+// ownerFQDN returns the absolute, ASCII query name for a record name in
+// relative, FQDN or "@" form.
+func ownerFQDN(name, zoneName string) string {
+	zone := canonicalDNSName(zoneName)
+	owner := canonicalOwnerName(name, zoneName)
+	if owner == "@" {
+		return zone + "."
+	}
+	return owner + "." + zone + "."
+}
+
+// propagationServers returns the addresses to verify a zone's records
+// against: the provider's dns_check_servers when set, otherwise the zone's
+// own apex NS records, resolved to host:53.
+func propagationServers(ctx context.Context, client *Client, zoneID int64, zoneName string) ([]string, error) {
+	if len(client.DNSCheckServers) > 0 {
+		return client.DNSCheckServers, nil
+	}
+	records, err := client.ListRecords(ctx, zoneID, "NS")
+	if err != nil {
+		return nil, fmt.Errorf("could not list NS records of zone %s: %w", zoneName, err)
+	}
+	var servers []string
+	for _, record := range records {
+		if record.Disabled || canonicalOwnerName(record.Name, zoneName) != "@" {
+			continue
+		}
+		host := strings.TrimSuffix(record.Content, ".")
+		addrs, err := net.DefaultResolver.LookupHost(ctx, host)
+		if err != nil {
+			tflog.Debug(ctx, "Could not resolve name server", map[string]interface{}{
+				"name_server": host,
+				"error":       err.Error(),
+			})
+			continue
+		}
+		for _, addr := range addrs {
+			server := net.JoinHostPort(addr, "53")
+			if !slices.Contains(servers, server) {
+				servers = append(servers, server)
+			}
+		}
+	}
+	if len(servers) == 0 {
+		return nil, fmt.Errorf("zone %s has no resolvable NS records to verify propagation against", zoneName)
+	}
+	return servers, nil
+}
+
+// waitForPropagation polls every server until it serves the expected values,
+// or the timeout expires.
+func waitForPropagation(ctx context.Context, client *Client, check propagationCheck, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	zoneName, err := client.GetZoneName(ctx, check.ZoneID)
+	if err != nil {
+		return fmt.Errorf("could not resolve zone name for zone ID %d: %w", check.ZoneID, err)
+	}
+	servers, err := propagationServers(ctx, client, check.ZoneID, zoneName)
+	if err != nil {
+		return err
+	}
+	return pollPropagation(ctx, servers, ownerFQDN(check.Name, zoneName), check, timeout)
+}
+
+// pollPropagation is the polling loop of waitForPropagation, split out so
+// tests can point it at a local server.
+func pollPropagation(ctx context.Context, servers []string, fqdn string, check propagationCheck, timeout time.Duration) error {
+	qtype := propagationTypes[strings.ToUpper(check.Type)]
+	pending := slices.Clone(servers)
+	lastSeen := map[string]string{}
+
+	for {
+		var still []string
+		for _, server := range pending {
+			served, err := queryDNS(ctx, server, fqdn, qtype, check.Type)
+			if err != nil {
+				// A query cut short by the deadline says nothing about the server
+				if ctx.Err() == nil || lastSeen[server] == "" {
+					lastSeen[server] = err.Error()
+				}
+				still = append(still, server)
+				continue
+			}
+			if !propagated(served, check.Expected, check.Exact) {
+				lastSeen[server] = fmt.Sprintf("serving %q", served)
+				still = append(still, server)
+			}
+		}
+		pending = still
+		if len(pending) == 0 {
+			return nil
+		}
+
+		tflog.Debug(ctx, "Waiting for DNS propagation", map[string]interface{}{
+			"name":    fqdn,
+			"type":    check.Type,
+			"pending": pending,
+		})
+		select {
+		case <-ctx.Done():
+			details := make([]string, 0, len(pending))
+			for _, server := range pending {
+				details = append(details, fmt.Sprintf("%s (%s)", server, lastSeen[server]))
+			}
+			return fmt.Errorf("%s %s was not served as expected within %s by: %s",
+				fqdn, check.Type, timeout, strings.Join(details, "; "))
+		case <-time.After(propagationPollInterval):
+		}
+	}
+}
+
+// propagated compares the served values with the expected ones.
+func propagated(served, expected []string, exact bool) bool {
+	for _, want := range expected {
+		if !slices.Contains(served, want) {
+			return false
+		}
+	}
+	if !exact {
+		return true
+	}
+	for _, got := range served {
+		if !slices.Contains(expected, got) {
+			return false
+		}
+	}
+	return true
+}
+
+// queryDNS asks one server for the records of the given name and type and
+// returns their normalized values; a name that does not exist has none.
+// Truncated UDP answers are retried over TCP.
+func queryDNS(ctx context.Context, server, fqdn string, qtype dnsmessage.Type, recordType string) ([]string, error) {
+	name, err := dnsmessage.NewName(fqdn)
+	if err != nil {
+		return nil, fmt.Errorf("invalid query name %q: %w", fqdn, err)
+	}
+	id := uint16(rand.Uint32())
+	query := dnsmessage.Message{
+		Header:    dnsmessage.Header{ID: id, RecursionDesired: true},
+		Questions: []dnsmessage.Question{{Name: name, Type: qtype, Class: dnsmessage.ClassINET}},
+	}
+	packed, err := query.Pack()
+	if err != nil {
+		return nil, err
+	}
+
+	var response dnsmessage.Message
+	for _, network := range []string{"udp", "tcp"} {
+		raw, err := exchangeDNS(ctx, network, server, packed)
+		if err != nil {
+			return nil, err
+		}
+		if err := response.Unpack(raw); err != nil {
+			return nil, fmt.Errorf("invalid response from %s: %w", server, err)
+		}
+		if response.ID != id {
+			return nil, fmt.Errorf("mismatched response ID from %s", server)
+		}
+		if !response.Truncated {
+			break
+		}
+	}
+
+	switch response.RCode {
+	case dnsmessage.RCodeSuccess:
+	case dnsmessage.RCodeNameError:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("server answered %s", response.RCode)
+	}
+
+	var values []string
+	for _, answer := range response.Answers {
+		// Skip CNAMEs and the like on the way to the wanted type
+		if answer.Header.Type != qtype {
+			continue
+		}
+		var value string
+		switch body := answer.Body.(type) {
+		case *dnsmessage.AResource:
+			value = netip.AddrFrom4(body.A).String()
+		case *dnsmessage.AAAAResource:
+			value = netip.AddrFrom16(body.AAAA).String()
+		case *dnsmessage.CNAMEResource:
+			value = body.CNAME.String()
+		case *dnsmessage.NSResource:
+			value = body.NS.String()
+		case *dnsmessage.PTRResource:
+			value = body.PTR.String()
+		case *dnsmessage.MXResource:
+			value = fmt.Sprintf("%d %s", body.Pref, body.MX.String())
+		case *dnsmessage.SRVResource:
+			value = fmt.Sprintf("%d %d %d %s", body.Priority, body.Weight, body.Port, body.Target.String())
+		case *dnsmessage.TXTResource:
+			// Already unquoted; normalizing would treat it as content
+			values = append(values, strings.Join(body.TXT, ""))
+			continue
+		case *dnsmessage.UnknownResource:
+			value, err = parseCAAData(body.Data)
+			if err != nil {
+				return nil, err
+			}
+		default:
+			continue
+		}
+		values = append(values, normalizeServedValue(recordType, value))
+	}
+	return values, nil
+}
+
+// parseCAAData renders CAA RDATA (RFC 8659) in presentation form.
+func parseCAAData(data []byte) (string, error) {
+	if len(data) < 2 || len(data) < 2+int(data[1]) {
+		return "", errors.New("malformed CAA record in response")
+	}
+	tagEnd := 2 + int(data[1])
+	return fmt.Sprintf("%d %s %q", data[0], data[2:tagEnd], data[tagEnd:]), nil
+}
+
+// exchangeDNS sends one packed query and reads the response, using the
+// two-byte length prefix on TCP.
+func exchangeDNS(ctx context.Context, network, server string, query []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, dnsQueryTimeout)
+	defer cancel()
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, network, server)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return nil, err
+		}
+	}
+
+	if network == "udp" {
+		if _, err := conn.Write(query); err != nil {
+			return nil, err
+		}
+		buf := make([]byte, 65535)
+		n, err := conn.Read(buf)
+		if err != nil {
+			return nil, err
+		}
+		return buf[:n], nil
+	}
+
+	msg := make([]byte, 2+len(query))
+	binary.BigEndian.PutUint16(msg, uint16(len(query)))
+	copy(msg[2:], query)
+	if _, err := conn.Write(msg); err != nil {
+		return nil, err
+	}
+	var length [2]byte
+	if _, err := io.ReadFull(conn, length[:]); err != nil {
+		return nil, err
+	}
+	buf := make([]byte, binary.BigEndian.Uint16(length[:]))
+	if _, err := io.ReadFull(conn, buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
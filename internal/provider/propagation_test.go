@@ -0,0 +1,230 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+func TestNormalizeServedValue(t *testing.T) {
+	tests := []struct {
+		recordType string
+		value      string
+		want       string
+	}{
+		{"A", "192.0.2.1", "192.0.2.1"},
+		{"AAAA", "2001:DB8:0:0::1", "2001:db8::1"},
+		{"CNAME", "Target.Example.com.", "target.example.com"},
+		{"MX", "10 Mail.Example.com.", "10 mail.example.com"},
+		{"SRV", "10 5 443  sip.example.com.", "10 5 443 sip.example.com"},
+		{"TXT", `"v=spf1 " "-all"`, "v=spf1 -all"},
+		{"TXT", `"say \"hi\""`, `say "hi"`},
+		{"TXT", "unquoted token", "unquoted token"},
+		{"CAA", `0 ISSUE "letsencrypt.org"`, `0 issue "letsencrypt.org"`},
+		{"CAA", `0 issue letsencrypt.org`, `0 issue "letsencrypt.org"`},
+	}
+	for _, tt := range tests {
+		if got := normalizeServedValue(tt.recordType, tt.value); got != tt.want {
+			t.Errorf("normalizeServedValue(%s, %q) = %q, want %q", tt.recordType, tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestExpectedServedValue(t *testing.T) {
+	if got := expectedServedValue("MX", "mail.example.com", 10); got != "10 mail.example.com" {
+		t.Errorf("unexpected MX value %q", got)
+	}
+	if got := expectedServedValue("SRV", "5 443 sip.example.com", 20); got != "20 5 443 sip.example.com" {
+		t.Errorf("unexpected SRV value %q", got)
+	}
+	if got := expectedServedValue("A", "192.0.2.1", 0); got != "192.0.2.1" {
+		t.Errorf("unexpected A value %q", got)
+	}
+}
+
+func TestOwnerFQDN(t *testing.T) {
+	tests := []struct {
+		name, zone, want string
+	}{
+		{"@", "example.com", "example.com."},
+		{"www", "example.com", "www.example.com."},
+		{"www.example.com", "example.com", "www.example.com."},
+		{"_acme-challenge.WWW", "Example.com.", "_acme-challenge.www.example.com."},
+		{"mail", "bücher.example", "mail.xn--bcher-kva.example."},
+	}
+	for _, tt := range tests {
+		if got := ownerFQDN(tt.name, tt.zone); got != tt.want {
+			t.Errorf("ownerFQDN(%q, %q) = %q, want %q", tt.name, tt.zone, got, tt.want)
+		}
+	}
+}
+
+func TestPropagated(t *testing.T) {
+	tests := []struct {
+		name     string
+		served   []string
+		expected []string
+		exact    bool
+		want     bool
+	}{
+		{"included", []string{"a", "b"}, []string{"a"}, false, true},
+		{"missing", []string{"b"}, []string{"a"}, false, false},
+		{"exact match", []string{"b", "a"}, []string{"a", "b"}, true, true},
+		{"exact with extra", []string{"a", "b"}, []string{"a"}, true, false},
+		{"exact empty", nil, []string{}, true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := propagated(tt.served, tt.expected, tt.exact); got != tt.want {
+				t.Errorf("propagated() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// startTestDNSServer serves TXT answers for any question from a UDP socket;
+// answers returns the strings to serve on each call.
+func startTestDNSServer(t *testing.T, answers func() []string) string {
+	t.Helper()
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	go func() {
+		buf := make([]byte, 512)
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			var query dnsmessage.Message
+			if err := query.Unpack(buf[:n]); err != nil || len(query.Questions) != 1 {
+				continue
+			}
+			reply := dnsmessage.Message{
+				Header:    dnsmessage.Header{ID: query.ID, Response: true, Authoritative: true},
+				Questions: query.Questions,
+			}
+			for _, value := range answers() {
+				reply.Answers = append(reply.Answers, dnsmessage.Resource{
+					Header: dnsmessage.ResourceHeader{Name: query.Questions[0].Name, Type: dnsmessage.TypeTXT, Class: dnsmessage.ClassINET, TTL: 60},
+					Body:   &dnsmessage.TXTResource{TXT: []string{value}},
+				})
+			}
+			packed, err := reply.Pack()
+			if err != nil {
+				continue
+			}
+			_, _ = conn.WriteTo(packed, addr)
+		}
+	}()
+	return conn.LocalAddr().String()
+}
+
+func TestPollPropagation(t *testing.T) {
+	oldInterval := propagationPollInterval
+	propagationPollInterval = 10 * time.Millisecond
+	t.Cleanup(func() { propagationPollInterval = oldInterval })
+
+	var mu sync.Mutex
+	calls := 0
+	server := startTestDNSServer(t, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		if calls < 3 {
+			return []string{"old-token"}
+		}
+		return []string{"new-token"}
+	})
+
+	check := propagationCheck{Type: "TXT", Expected: []string{expectedServedValue("TXT", `"new-token"`, 0)}, Exact: true}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := pollPropagation(ctx, []string{server}, "_acme-challenge.example.com.", check, 5*time.Second); err != nil {
+		t.Fatalf("expected propagation, got %v", err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if calls < 3 {
+		t.Errorf("expected polling until the new value was served, got %d queries", calls)
+	}
+}
+
+func TestPollPropagation_Timeout(t *testing.T) {
+	oldInterval := propagationPollInterval
+	propagationPollInterval = 10 * time.Millisecond
+	t.Cleanup(func() { propagationPollInterval = oldInterval })
+
+	server := startTestDNSServer(t, func() []string { return []string{"old-token"} })
+
+	check := propagationCheck{Type: "TXT", Expected: []string{"new-token"}}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	err := pollPropagation(ctx, []string{server}, "_acme-challenge.example.com.", check, 100*time.Millisecond)
+	if err == nil {
+		t.Fatal("expected a timeout error")
+	}
+	if !strings.Contains(err.Error(), server) || !strings.Contains(err.Error(), "old-token") {
+		t.Errorf("expected error to name the server and what it serves, got %v", err)
+	}
+}
+
+func TestPropagationServers_Configured(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s: configured servers need no NS lookup", r.Method, r.URL.Path)
+	})
+	client.DNSCheckServers = []string{"192.0.2.53:53"}
+
+	servers, err := propagationServers(context.Background(), client, 1, "example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(servers) != 1 || servers[0] != "192.0.2.53:53" {
+		t.Errorf("expected configured servers, got %v", servers)
+	}
+}
+
+func TestPropagationServers_ZoneNS(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(t, w, RecordListResponse{Records: []Record{
+			{Name: "example.com", Type: "NS", Content: "192.0.2.1"},
+			{Name: "example.com", Type: "NS", Content: "192.0.2.2", Disabled: true},
+			{Name: "sub.example.com", Type: "NS", Content: "192.0.2.3"},
+		}})
+	})
+
+	servers, err := propagationServers(context.Background(), client, 1, "example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(servers) != 1 || servers[0] != "192.0.2.1:53" {
+		t.Errorf("expected only the enabled apex name server, got %v", servers)
+	}
+}
+
+func TestParsePropagationTimeout(t *testing.T) {
+	if got, err := parsePropagationTimeout(types.StringNull()); err != nil || got != defaultPropagationTimeout {
+		t.Errorf("expected default timeout, got %s (err %v)", got, err)
+	}
+	if got, err := parsePropagationTimeout(types.StringValue("90s")); err != nil || got != 90*time.Second {
+		t.Errorf("expected 90s, got %s (err %v)", got, err)
+	}
+	for _, bad := range []string{"soon", "0s", "-1m"} {
+		if _, err := parsePropagationTimeout(types.StringValue(bad)); err == nil {
+			t.Errorf("expected error for propagation_timeout %q", bad)
+		}
+	}
+}
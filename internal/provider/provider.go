@@ -6,20 +6,25 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure PoweradminProvider satisfies various provider interfaces.
 var _ provider.Provider = &PoweradminProvider{}
 var _ provider.ProviderWithFunctions = &PoweradminProvider{}
 var _ provider.ProviderWithEphemeralResources = &PoweradminProvider{}
+var _ provider.ProviderWithActions = &PoweradminProvider{}
 
 // PoweradminProvider defines the provider implementation.
 type PoweradminProvider struct {
@@ -33,10 +38,57 @@ type PoweradminProvider struct {
 type PoweradminProviderModel struct {
 	ApiUrl     types.String `tfsdk:"api_url"`
 	ApiKey     types.String `tfsdk:"api_key"`
+	ApiKeyFile types.String `tfsdk:"api_key_file"`
+
+	VaultAPIKeyPath  types.String `tfsdk:"vault_api_key_path"`
+	VaultAPIKeyField types.String `tfsdk:"vault_api_key_field"`
+
 	Username   types.String `tfsdk:"username"`
 	Password   types.String `tfsdk:"password"`
 	Insecure   types.Bool   `tfsdk:"insecure"`
 	ApiVersion types.String `tfsdk:"api_version"`
+
+	TLSSkipVerify types.Bool   `tfsdk:"tls_skip_verify"`
+	TLSMinVersion types.String `tfsdk:"tls_min_version"`
+	TLSServerName types.String `tfsdk:"tls_server_name"`
+
+	HTTPVersion types.String `tfsdk:"http_version"`
+
+	Profile         types.String `tfsdk:"profile"`
+	CredentialsFile types.String `tfsdk:"credentials_file"`
+
+	ApiPathPrefix types.String `tfsdk:"api_path_prefix"`
+
+	FailoverAPIURLs types.List `tfsdk:"failover_api_urls"`
+
+	CacheZoneReads types.Bool `tfsdk:"cache_zone_reads"`
+	ReadOnly       types.Bool `tfsdk:"read_only"`
+
+	ConflictRetryTimeout types.String `tfsdk:"conflict_retry_timeout"`
+	SlowRequestThreshold types.String `tfsdk:"slow_request_threshold"`
+
+	DNSCheckServers types.List `tfsdk:"dns_check_servers"`
+
+	UserAgentSuffix types.String `tfsdk:"user_agent_suffix"`
+	LogCurlCommands types.Bool   `tfsdk:"log_curl_commands"`
+
+	AutoQuoteTXT              types.Bool  `tfsdk:"auto_quote_txt"`
+	RRSetSizeWarningThreshold types.Int64 `tfsdk:"rrset_size_warning_threshold"`
+	CircuitBreakerThreshold   types.Int64 `tfsdk:"circuit_breaker_threshold"`
+	ForbidLUARecords          types.Bool  `tfsdk:"forbid_lua_records"`
+
+	PowerDNSAPIURL types.String `tfsdk:"powerdns_api_url"`
+	PowerDNSAPIKey types.String `tfsdk:"powerdns_api_key"`
+
+	Retry *RetryPolicyModel `tfsdk:"retry"`
+}
+
+// RetryPolicyModel describes the retry configuration block.
+type RetryPolicyModel struct {
+	Statuses       types.List   `tfsdk:"statuses"`
+	Methods        types.List   `tfsdk:"methods"`
+	MaxAttempts    types.Int64  `tfsdk:"max_attempts"`
+	MaxElapsedTime types.String `tfsdk:"max_elapsed_time"`
 }
 
 func (p *PoweradminProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -49,14 +101,36 @@ func (p *PoweradminProvider) Schema(ctx context.Context, req provider.SchemaRequ
 		MarkdownDescription: "Provider for managing Poweradmin DNS zones and records. Compatible with both Terraform and OpenTofu.",
 		Attributes: map[string]schema.Attribute{
 			"api_url": schema.StringAttribute{
-				MarkdownDescription: "Poweradmin API base URL (e.g., https://dns.example.com)",
-				Required:            true,
+				MarkdownDescription: "Poweradmin API base URL (e.g., https://dns.example.com). Required unless taken from a `profile`.",
+				Optional:            true,
+			},
+			"failover_api_urls": schema.ListAttribute{
+				MarkdownDescription: "Base URLs of further servers of the same Poweradmin installation, e.g. a second app server behind its own name. " +
+					"When an endpoint cannot be reached, requests fail over to the next one in order, starting with `api_url`; an endpoint that failed is tried last for the next 30 seconds, then preferred again. " +
+					"Reads, updates and deletes fail over on any connection error, creates only when the connection could not be established, so a create is never sent twice.",
+				ElementType: types.StringType,
+				Optional:    true,
 			},
 			"api_key": schema.StringAttribute{
 				MarkdownDescription: "API key for authentication (X-API-Key header)",
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"api_key_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file holding the API key, for keys rotated by an external agent. When a request is rejected with HTTP 401 the file is read again and, if the key changed, the request is replayed once, so a rotation mid-apply does not fail the remaining operations. Conflicts with `api_key`.",
+				Optional:            true,
+			},
+			"vault_api_key_path": schema.StringAttribute{
+				MarkdownDescription: "Path of a HashiCorp Vault KV secret to read the API key from when the provider is configured, so the key never appears in variables or state. " +
+					"Give the API path below `/v1/`: `secret/data/poweradmin` for a KV version 2 engine mounted at `secret/`, `kv/poweradmin` for version 1. " +
+					"The Vault address is taken from `VAULT_ADDR`, the token from `VAULT_TOKEN` or `~/.vault-token`, and `VAULT_NAMESPACE`, `VAULT_CACERT`, `VAULT_CAPATH` and `VAULT_SKIP_VERIFY` are honored as by the Vault CLI. " +
+					"When a request is rejected with HTTP 401 the secret is read again and the request replayed once if the key changed. Conflicts with `api_key` and `api_key_file`.",
+				Optional: true,
+			},
+			"vault_api_key_field": schema.StringAttribute{
+				MarkdownDescription: "Field of the Vault secret holding the API key. Defaults to `api_key`.",
+				Optional:            true,
+			},
 			"username": schema.StringAttribute{
 				MarkdownDescription: "Username for HTTP basic authentication (alternative to api_key)",
 				Optional:            true,
@@ -66,14 +140,145 @@ func (p *PoweradminProvider) Schema(ctx context.Context, req provider.SchemaRequ
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"profile": schema.StringAttribute{
+				MarkdownDescription: "Profile of the shared credentials file to take `api_url` and the credentials (`api_key`, or `username` and `password`) from, for switching between Poweradmin instances. " +
+					"Settings in the provider block take precedence: `api_url` is used when set, and the profile's credentials only when no authentication is configured. " +
+					"Defaults to `default` when only `credentials_file` is set.",
+				Optional: true,
+			},
+			"credentials_file": schema.StringAttribute{
+				MarkdownDescription: "Path of the shared credentials file, a TOML file with one table per profile. A leading `~/` is expanded. Defaults to `~/.config/poweradmin/credentials.toml`. " +
+					"The file is only read when `profile` or `credentials_file` is set.",
+				Optional: true,
+			},
 			"insecure": schema.BoolAttribute{
-				MarkdownDescription: "Skip TLS certificate verification. **Insecure** — disables protection against man-in-the-middle attacks and must only be used for self-signed or internal endpoints in trusted networks. Never enable in production.",
+				MarkdownDescription: "Deprecated alias of `tls_skip_verify`.",
+				Optional:            true,
+				DeprecationMessage:  "Use tls_skip_verify instead; insecure will be removed in a future major version.",
+			},
+			"tls_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip TLS certificate verification. **Insecure** — disables protection against man-in-the-middle attacks and must only be used for self-signed or internal endpoints in trusted networks. Never enable in production. " +
+					"`tls_min_version` still applies. Defaults to false.",
+				Optional: true,
+			},
+			"tls_min_version": schema.StringAttribute{
+				MarkdownDescription: "Lowest TLS version accepted from the API server: `1.2` or `1.3`, for policies that require TLS 1.3. Defaults to `1.2`.",
 				Optional:            true,
 			},
+			"tls_server_name": schema.StringAttribute{
+				MarkdownDescription: "Host name sent in the TLS handshake (SNI) and expected in the server certificate, instead of the host of the URL. " +
+					"For installations reached by IP address or an internal name behind an SNI-routing proxy, e.g. `api_url = \"https://10.0.0.5\"` with `tls_server_name = \"dns.example.com\"`. " +
+					"Applies to every API connection, including `failover_api_urls` and `powerdns_api_url`.",
+				Optional: true,
+			},
+			"http_version": schema.StringAttribute{
+				MarkdownDescription: "HTTP version used to talk to the API: `auto` negotiates HTTP/2 over TLS and falls back to HTTP/1.1, `1.1` forces HTTP/1.1 for reverse proxies that misbehave with HTTP/2, " +
+					"and `2` forces HTTP/2, also unencrypted (h2c) for `http://` URLs. Defaults to `auto`.",
+				Optional: true,
+			},
 			"api_version": schema.StringAttribute{
 				MarkdownDescription: "Poweradmin API version to use. Only 'v2' is supported (Poweradmin 4.1.0+). Defaults to 'v2'",
 				Optional:            true,
 			},
+			"api_path_prefix": schema.StringAttribute{
+				MarkdownDescription: "Path between `api_url` and the API endpoints, for installations behind a path-routing proxy that exposes the API somewhere other than `/api/v2` (e.g., `/poweradmin/api/v2`). " +
+					"Replaces the whole `/api/{api_version}` segment. Defaults to `/api/v2`.",
+				Optional: true,
+			},
+			"read_only": schema.BoolAttribute{
+				MarkdownDescription: "Refuse to change anything in Poweradmin. Plans, refreshes and data sources work as usual, but any create, update or delete fails with an error before a request is sent. " +
+					"Useful for audit and drift-detection pipelines. Defaults to false.",
+				Optional: true,
+			},
+			"conflict_retry_timeout": schema.StringAttribute{
+				MarkdownDescription: "How long to keep retrying requests that fail because of a concurrent change (HTTP 409 or 423, or a \"zone is locked\" error), with exponential backoff, before reporting the error. " +
+					"A Go duration such as `30s` or `2m`; `0s` disables retries. Conflicts about an object that already exists are never retried. Defaults to `30s`.",
+				Optional: true,
+			},
+			"slow_request_threshold": schema.StringAttribute{
+				MarkdownDescription: "Duration above which an API call is logged as a warning (visible with `TF_LOG=WARN`), with the method, path, duration and the running count of slow calls, " +
+					"to spot slow queries or misconfiguration on the Poweradmin or database side before applies start timing out. A Go duration such as `2s`; `0s` disables the warning. Defaults to `5s`.",
+				Optional: true,
+			},
+			"user_agent_suffix": schema.StringAttribute{
+				MarkdownDescription: "Text appended to the User-Agent header, e.g. `pipeline/dns-prod`, so server access logs can attribute traffic to a pipeline. " +
+					"The User-Agent always names the provider and Terraform versions (`terraform-provider-poweradmin/1.2.3 Terraform/1.9.0`); the `TF_APPEND_USER_AGENT` environment variable is appended as well.",
+				Optional: true,
+			},
+			"log_curl_commands": schema.BoolAttribute{
+				MarkdownDescription: "Log an equivalent `curl` command for every API request at INFO level (visible with `TF_LOG=INFO`), to reproduce and report API-side problems. " +
+					"API keys, basic-auth passwords and password fields in request bodies are redacted. Defaults to false.",
+				Optional: true,
+			},
+			"auto_quote_txt": schema.BoolAttribute{
+				MarkdownDescription: "Wrap unquoted TXT record content in quotes before sending it, splitting it into 255-byte strings, and compare the unquoted form on read, " +
+					"so `content = \"v=spf1 -all\"` needs no embedded quotes. Content that already starts with a quote is sent as is. " +
+					"The `auto_quote_txt` attribute of `poweradmin_record` and `poweradmin_rrset` overrides this per resource. Defaults to false.",
+				Optional: true,
+			},
+			"forbid_lua_records": schema.BoolAttribute{
+				MarkdownDescription: "Refuse to create or change `poweradmin_record` and `poweradmin_rrset` resources of type `LUA`, whose content is Lua code run by PowerDNS on every query, " +
+					"for organizations that do not allow code in DNS data. LUA records already managed keep refreshing and can be destroyed, but not changed. Defaults to false.",
+				Optional: true,
+			},
+			"rrset_size_warning_threshold": schema.Int64Attribute{
+				MarkdownDescription: "Number of records above which planning a `poweradmin_rrset` produces a warning, since such sets usually come from a bug in generated configuration. " +
+					"`0` disables the warning. TXT RRSets also warn when their content exceeds DNS size limits, regardless of this setting. Defaults to 100.",
+				Optional: true,
+			},
+			"circuit_breaker_threshold": schema.Int64Attribute{
+				MarkdownDescription: "Number of consecutive requests failing with a connection error or a 5xx status after which the provider stops contacting the API for 30 seconds, " +
+					"failing every resource fast with the same error instead of each retrying a down server. Then a single request probes the API: success resumes requests, another failure waits 30 seconds more. `0` disables the circuit breaker. Defaults to 10.",
+				Optional: true,
+			},
+			"powerdns_api_url": schema.StringAttribute{
+				MarkdownDescription: "Base URL of the PowerDNS HTTP API behind Poweradmin (e.g. `http://127.0.0.1:8081`). When set, zone metadata, DNSSEC key, notify, rectify and supermaster operations " +
+					"are sent to the PowerDNS API whenever the Poweradmin API does not implement them (answers 404, 405 or 501), for capabilities PowerDNS has before Poweradmin exposes them. " +
+					"Requires `powerdns_api_key`.",
+				Optional: true,
+			},
+			"powerdns_api_key": schema.StringAttribute{
+				MarkdownDescription: "API key of the PowerDNS HTTP API (its `api-key` setting), used with `powerdns_api_url`",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"dns_check_servers": schema.ListAttribute{
+				MarkdownDescription: "DNS servers the provider queries for DNS-based verification such as `wait_for_propagation`, as IP addresses or host names with an optional port " +
+					"(`192.0.2.53`, `ns1.example.com:5353`, `[2001:db8::53]:53`). Port 53 is used when none is given. Defaults to each zone's own name servers (its apex NS records).",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"cache_zone_reads": schema.BoolAttribute{
+				MarkdownDescription: "Fetch each zone's RRSets once per Terraform run and serve `poweradmin_rrset` and `poweradmin_glue_record` refreshes from that listing, instead of one request per resource. " +
+					"Recommended for large zones. Writes made by the provider discard the zone's listing so later reads see them. Defaults to false.",
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"retry": schema.SingleNestedBlock{
+				MarkdownDescription: "Retries requests that fail with transient HTTP statuses, e.g. from a rate limit or a restarting backend behind a proxy, with exponential backoff. " +
+					"Without this block only concurrent-change conflicts are retried (see `conflict_retry_timeout`).",
+				Attributes: map[string]schema.Attribute{
+					"statuses": schema.ListAttribute{
+						MarkdownDescription: "HTTP status codes to retry. Defaults to `[429, 502, 503, 504]`.",
+						ElementType:         types.Int64Type,
+						Optional:            true,
+					},
+					"methods": schema.ListAttribute{
+						MarkdownDescription: "HTTP methods to retry. Defaults to the idempotent `[\"GET\", \"HEAD\", \"PUT\", \"DELETE\"]`; add `POST` only if repeating a create is safe for your server.",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+					"max_attempts": schema.Int64Attribute{
+						MarkdownDescription: "Maximum number of attempts per request, including the first. Defaults to 5.",
+						Optional:            true,
+					},
+					"max_elapsed_time": schema.StringAttribute{
+						MarkdownDescription: "Longest time to keep retrying a request, as a Go duration such as `1m`. Defaults to `1m`.",
+						Optional:            true,
+					},
+				},
+			},
 		},
 	}
 }
@@ -87,24 +292,51 @@ func (p *PoweradminProvider) Configure(ctx context.Context, req provider.Configu
 		return
 	}
 
+	// Clients that support deferred actions can plan around a provider whose
+	// configuration depends on values not known yet, instead of failing
+	if req.ClientCapabilities.DeferralAllowed && !req.Config.Raw.IsFullyKnown() {
+		tflog.Info(ctx, "Provider configuration is not fully known, deferring")
+		resp.Deferred = &provider.Deferred{Reason: provider.DeferredReasonProviderConfigUnknown}
+		return
+	}
+
+	if err := applyCredentialsProfile(&data); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("profile"),
+			"Unable to Read Credentials Profile",
+			err.Error(),
+		)
+		return
+	}
+
 	// Validate configuration
 	if data.ApiUrl.IsNull() || data.ApiUrl.ValueString() == "" {
 		resp.Diagnostics.AddError(
 			"Missing API URL",
-			"The api_url attribute is required for the Poweradmin provider",
+			"The api_url attribute is required for the Poweradmin provider, either in the provider block or in the selected profile",
 		)
 		return
 	}
 
 	// Validate authentication: require either API key or username/password
 	hasApiKey := !data.ApiKey.IsNull() && data.ApiKey.ValueString() != ""
+	hasApiKeyFile := !data.ApiKeyFile.IsNull() && data.ApiKeyFile.ValueString() != ""
+	hasVaultAPIKey := !data.VaultAPIKeyPath.IsNull() && data.VaultAPIKeyPath.ValueString() != ""
 	hasBasicAuth := !data.Username.IsNull() && data.Username.ValueString() != "" &&
 		!data.Password.IsNull() && data.Password.ValueString() != ""
 
-	if !hasApiKey && !hasBasicAuth {
+	if (hasApiKey && hasApiKeyFile) || (hasVaultAPIKey && (hasApiKey || hasApiKeyFile)) {
+		resp.Diagnostics.AddError(
+			"Conflicting Authentication",
+			"Only one of api_key, api_key_file and vault_api_key_path may be set",
+		)
+		return
+	}
+
+	if !hasApiKey && !hasApiKeyFile && !hasVaultAPIKey && !hasBasicAuth {
 		resp.Diagnostics.AddError(
 			"Missing Authentication",
-			"Either api_key or both username and password must be provided for authentication",
+			"Either api_key, api_key_file, vault_api_key_path, or both username and password must be provided for authentication",
 		)
 		return
 	}
@@ -121,8 +353,17 @@ func (p *PoweradminProvider) Configure(ctx context.Context, req provider.Configu
 		}
 	}
 
+	if strings.ContainsAny(data.UserAgentSuffix.ValueString(), "\r\n") {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("user_agent_suffix"),
+			"Invalid User-Agent Suffix",
+			"user_agent_suffix must be a single line.",
+		)
+		return
+	}
+
 	// Create Poweradmin API client
-	client, err := NewClient(&data)
+	client, err := NewClient(ctx, &data)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create Poweradmin API Client",
@@ -131,8 +372,25 @@ func (p *PoweradminProvider) Configure(ctx context.Context, req provider.Configu
 		return
 	}
 
+	client.UserAgent = buildUserAgent(p.version, req.TerraformVersion, data.UserAgentSuffix.ValueString())
+
+	// Likewise defer when the API cannot be reached yet, e.g. behind a VPN
+	// that a pipeline only brings up before the apply
+	if req.ClientCapabilities.DeferralAllowed {
+		if err := client.checkReachable(ctx); err != nil {
+			tflog.Warn(ctx, "Poweradmin API is not reachable, deferring", map[string]interface{}{
+				"api_url": client.BaseURL,
+				"error":   err.Error(),
+			})
+			resp.Deferred = &provider.Deferred{Reason: provider.DeferredReasonProviderConfigUnknown}
+			return
+		}
+	}
+
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.ActionData = client
+	resp.EphemeralResourceData = client
 }
 
 func (p *PoweradminProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -141,37 +399,66 @@ func (p *PoweradminProvider) Resources(ctx context.Context) []func() resource.Re
 		NewRecordResource,
 		NewRRSetResource,
 		NewUserResource,
+		NewUserPermissionResource,
+		NewPermissionTemplateItemResource,
 		NewGroupResource,
 		NewGroupMembershipResource,
 		NewGroupZoneAssignmentResource,
+		NewZoneOwnerResource,
+		NewDNSSECKeyResource,
 		NewZoneTemplateResource,
 		NewZoneTemplateRecordResource,
+		NewGlueRecordResource,
+		NewZoneTransferACLResource,
 	}
 }
 
 func (p *PoweradminProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
 	return []func() ephemeral.EphemeralResource{
-		// No ephemeral resources currently implemented.
-		// Potential future enhancement: temporary API keys if Poweradmin REST API supports it.
+		NewSessionEphemeralResource,
 	}
 }
 
 func (p *PoweradminProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewZoneDataSource,
+		NewZonesDataSource,
 		NewPermissionDataSource,
+		NewPermissionTemplatesDataSource,
 		NewRecordsDataSource,
 		NewRRSetsDataSource,
 		NewGroupDataSource,
 		NewZoneTemplateDataSource,
 		NewZoneTemplatesDataSource,
+		NewZoneDefaultsDataSource,
+		NewZoneChangeLogDataSource,
+		NewZoneDSRecordsDataSource,
+		NewZoneSOADataSource,
+		NewUserActivityLogDataSource,
+		NewReverseZoneDataSource,
+		NewAPIStatusDataSource,
+		NewCurrentUserDataSource,
+		NewPermissionCheckDataSource,
+		NewSupermastersDataSource,
+	}
+}
+
+func (p *PoweradminProvider) Actions(ctx context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewValidateZoneAction,
+		NewRectifyZoneAction,
 	}
 }
 
 func (p *PoweradminProvider) Functions(ctx context.Context) []func() function.Function {
 	return []func() function.Function{
-		// No provider functions currently implemented.
-		// Potential future enhancements: FQDN formatting, DNS validation helpers, etc.
+		NewDMARCRecordFunction,
+		NewSPFRecordFunction,
+		NewDKIMRecordFunction,
+		NewCAARecordFunction,
+		NewSRVContentFunction,
+		NewZoneSerialFunction,
+		NewReverseZoneFromCIDRFunction,
 	}
 }
 
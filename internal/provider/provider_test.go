@@ -4,11 +4,17 @@
 package provider
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -55,3 +61,74 @@ provider "poweradmin" {
 }
 `
 }
+
+// testConfigureRequest builds a ConfigureRequest from attribute values;
+// attributes left out are null.
+func testConfigureRequest(t *testing.T, deferralAllowed bool, values map[string]tftypes.Value) provider.ConfigureRequest {
+	t.Helper()
+	ctx := context.Background()
+	var schemaResp provider.SchemaResponse
+	New("test")().Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+
+	objectType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		t.Fatal("expected the provider schema to be an object")
+	}
+	attrs := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		attrs[name] = tftypes.NewValue(attrType, nil)
+		if v, ok := values[name]; ok {
+			attrs[name] = v
+		}
+	}
+	return provider.ConfigureRequest{
+		ClientCapabilities: provider.ConfigureProviderClientCapabilities{DeferralAllowed: deferralAllowed},
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(objectType, attrs),
+		},
+	}
+}
+
+func TestProviderConfigure_Deferral(t *testing.T) {
+	reachable := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	t.Cleanup(reachable.Close)
+	unreachable := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	unreachable.Close()
+
+	tests := []struct {
+		name            string
+		apiURL          tftypes.Value
+		deferralAllowed bool
+		wantDeferred    bool
+		wantError       bool
+	}{
+		{"reachable", tftypes.NewValue(tftypes.String, reachable.URL), true, false, false},
+		{"unreachable", tftypes.NewValue(tftypes.String, unreachable.URL), true, true, false},
+		{"unknown api_url", tftypes.NewValue(tftypes.String, tftypes.UnknownValue), true, true, false},
+		{"unknown api_url without deferral support", tftypes.NewValue(tftypes.String, tftypes.UnknownValue), false, false, true},
+		{"unreachable without deferral support", tftypes.NewValue(tftypes.String, unreachable.URL), false, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := testConfigureRequest(t, tt.deferralAllowed, map[string]tftypes.Value{
+				"api_url": tt.apiURL,
+				"api_key": tftypes.NewValue(tftypes.String, "test-key"),
+			})
+			var resp provider.ConfigureResponse
+			New("test")().Configure(context.Background(), req, &resp)
+
+			if got := resp.Deferred != nil; got != tt.wantDeferred {
+				t.Errorf("expected deferred = %v, got %+v", tt.wantDeferred, resp.Deferred)
+			}
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Errorf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if tt.wantDeferred && resp.ResourceData != nil {
+				t.Error("expected no client when deferred")
+			}
+		})
+	}
+}
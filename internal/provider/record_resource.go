@@ -6,7 +6,10 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -23,6 +26,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &RecordResource{}
 var _ resource.ResourceWithImportState = &RecordResource{}
+var _ resource.ResourceWithModifyPlan = &RecordResource{}
+var _ resource.ResourceWithValidateConfig = &RecordResource{}
 
 func NewRecordResource() resource.Resource {
 	return &RecordResource{}
@@ -35,15 +40,29 @@ type RecordResource struct {
 
 // RecordResourceModel describes the resource data model.
 type RecordResourceModel struct {
-	ID        types.String `tfsdk:"id"`
-	ZoneID    types.Int64  `tfsdk:"zone_id"`
-	Name      types.String `tfsdk:"name"`
-	Type      types.String `tfsdk:"type"`
-	Content   types.String `tfsdk:"content"`
-	TTL       types.Int64  `tfsdk:"ttl"`
-	Priority  types.Int64  `tfsdk:"priority"`
-	Disabled  types.Bool   `tfsdk:"disabled"`
-	CreatePTR types.Bool   `tfsdk:"create_ptr"`
+	ID            types.String `tfsdk:"id"`
+	ZoneID        types.Int64  `tfsdk:"zone_id"`
+	Name          DNSNameValue `tfsdk:"name"`
+	FQDN          DNSNameValue `tfsdk:"fqdn"`
+	Type          types.String `tfsdk:"type"`
+	Content       types.String `tfsdk:"content"`
+	SecretContent types.String `tfsdk:"secret_content"`
+	TTL           TTLValue     `tfsdk:"ttl"`
+	Priority      types.Int64  `tfsdk:"priority"`
+	Disabled      types.Bool   `tfsdk:"disabled"`
+	CreatePTR     types.Bool   `tfsdk:"create_ptr"`
+	IPAddress     types.String `tfsdk:"ip_address"`
+
+	SensitiveContent      types.Bool `tfsdk:"sensitive_content"`
+	IgnoreExternalDisable types.Bool `tfsdk:"ignore_external_disable"`
+	AutoQuoteTXT          types.Bool `tfsdk:"auto_quote_txt"`
+
+	WaitForPropagation types.Bool   `tfsdk:"wait_for_propagation"`
+	PropagationTimeout types.String `tfsdk:"propagation_timeout"`
+
+	ChangeDate types.String `tfsdk:"change_date"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+	UpdatedAt  types.String `tfsdk:"updated_at"`
 }
 
 func (r *RecordResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -63,29 +82,59 @@ func (r *RecordResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"zone_id": schema.Int64Attribute{
-				MarkdownDescription: "The ID of the zone this record belongs to",
-				Required:            true,
+				MarkdownDescription: "The ID of the zone this record belongs to. Required unless `fqdn` is set.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.RequiresReplace(),
+					int64planmodifier.UseStateForUnknown(),
 				},
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The record name. Accepts the relative form ('www', 'sub.www', '@' for the zone apex) or the FQDN form ('www.example.com'); the configured form is preserved in state.",
-				Required:            true,
+				MarkdownDescription: "The record name. Accepts the relative form ('www', 'sub.www', '@' for the zone apex) or the FQDN form ('www.example.com'); the configured form is preserved in state, and case, a trailing dot or a Unicode spelling cause no diff. " +
+					"Required unless `ip_address` or `fqdn` is set.",
+				CustomType: DNSNameType{},
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"fqdn": schema.StringAttribute{
+				MarkdownDescription: "The fully qualified record name, e.g. `api.eu.example.com`, instead of `zone_id` and `name`. " +
+					"The most specific existing zone containing it owns the record, and `zone_id` and `name` are computed from it; " +
+					"a new zone that becomes the most specific one moves the record there, replacing it. Conflicts with `zone_id`, `name` and `ip_address`.",
+				CustomType: DNSNameType{},
+				Optional:   true,
+			},
+			"ip_address": schema.StringAttribute{
+				MarkdownDescription: "For PTR records, the IPv4 or IPv6 address the record points back from. The reversed `name` within the zone is derived from it, " +
+					"and the address must belong to the reverse zone. Conflicts with `name`.",
+				Optional: true,
 			},
 			"type": schema.StringAttribute{
-				MarkdownDescription: "The record type (A, AAAA, CNAME, MX, TXT, SRV, NS, PTR, etc.)",
-				Required:            true,
+				MarkdownDescription: "The record type (A, AAAA, ALIAS, CNAME, MX, TXT, SRV, NS, PTR, LUA, etc.). ALIAS records are only allowed at the zone apex, not beside A, AAAA or CNAME records, and take a host name as content; like CNAME targets, a trailing dot is optional. " +
+					"LUA records take the type they answer for and a Lua snippet, e.g. `A ifportup(443, {'192.0.2.1', '192.0.2.2'})`; the snippet is quoted for PowerDNS when it is not already. " +
+					"The provider's `forbid_lua_records` rejects them.",
+				Required: true,
 			},
 			"content": schema.StringAttribute{
-				MarkdownDescription: "The record content/value",
-				Required:            true,
-			},
-			"ttl": schema.Int64Attribute{
-				MarkdownDescription: "Time to Live in seconds. Defaults to 3600.",
+				MarkdownDescription: "The record content/value. Required unless `secret_content` is set.",
 				Optional:            true,
-				Computed:            true,
-				Default:             int64default.StaticInt64(3600),
+			},
+			"secret_content": schema.StringAttribute{
+				MarkdownDescription: "The record content/value, instead of `content`, for values such as domain verification tokens or ACME keys. " +
+					"It is marked sensitive, so Terraform hides it in plan output, and it is kept out of provider debug logs like with `sensitive_content`. Conflicts with `content`.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"ttl": schema.StringAttribute{
+				MarkdownDescription: "Time to Live, in seconds (`300`) or as a duration (`\"5m\"`, `\"1h30m\"`, `\"1d\"`; units s, m, h, d, w). " +
+					"Both forms of the same TTL are equal, so switching between them causes no drift. " +
+					"Defaults to the zone's `default_record_ttl`, or 3600 when the zone sets none.",
+				CustomType: TTLType{},
+				Optional:   true,
+				Computed:   true,
 			},
 			"priority": schema.Int64Attribute{
 				MarkdownDescription: "Priority for MX and SRV records. Defaults to 0.",
@@ -99,6 +148,51 @@ func (r *RecordResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
 			},
+			"sensitive_content": schema.BoolAttribute{
+				MarkdownDescription: "Keep `content` out of provider debug logs. Defaults to false. " +
+					"This does not hide it in plan output; set the content as `secret_content` instead to hide it there too.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"ignore_external_disable": schema.BoolAttribute{
+				MarkdownDescription: "Leave the record disabled when it was disabled outside Terraform, e.g. by an operator during an incident, instead of re-enabling it on the next apply. " +
+					"A server-side `disabled = true` is then not reported as drift while `disabled` is false in the configuration; changing `disabled` in the configuration still takes effect. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"auto_quote_txt": schema.BoolAttribute{
+				MarkdownDescription: "For TXT records, wrap unquoted `content` in quotes before sending it, splitting it into 255-byte strings, and compare the unquoted form on read. " +
+					"Content that already starts with a quote is sent as is. Defaults to the provider's `auto_quote_txt`.",
+				Optional: true,
+			},
+			"wait_for_propagation": schema.BoolAttribute{
+				MarkdownDescription: "After create and update, query the zone's name servers (or the provider's `dns_check_servers`) until they serve the new content, " +
+					"so dependent checks such as ACME DNS-01 validation do not race the change. Fails when it is not served within `propagation_timeout`. " +
+					"Supported for A, AAAA, CAA, CNAME, MX, NS, PTR, SRV and TXT records; disabled records are not checked.",
+				Optional: true,
+			},
+			"propagation_timeout": schema.StringAttribute{
+				MarkdownDescription: "How long `wait_for_propagation` waits, as a Go duration such as `90s` or `10m`. Defaults to `5m`.",
+				Optional:            true,
+			},
+			"change_date": schema.StringAttribute{
+				MarkdownDescription: "When PowerDNS last saw the record change, as an RFC 3339 UTC timestamp. Refreshed on every read, so changes made outside Terraform show up here. " +
+					"Null when the API does not report it.",
+				Computed: true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "When the record was created, as reported by Poweradmin. Null when the API does not report it.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "When the record was last updated, as reported by Poweradmin. Null when the API does not report it.",
+				Computed:            true,
+			},
 			"create_ptr": schema.BoolAttribute{
 				MarkdownDescription: "Automatically create a PTR (reverse DNS) record for this record. Only applicable to A and AAAA records. Requires a matching reverse zone. Defaults to false. Changing this value requires resource replacement.",
 				Optional:            true,
@@ -131,6 +225,77 @@ func (r *RecordResource) Configure(ctx context.Context, req resource.ConfigureRe
 	r.client = client
 }
 
+// ModifyPlan rejects new records in a SLAVE zone, misplaced ALIAS records
+// and LUA records forbidden by the provider while planning, instead of
+// failing deep in the apply, and plans the zone's default TTL when ttl is
+// unset. An fqdn is resolved to its zone first so the checks see the zone_id.
+func (r *RecordResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.planFQDN(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	req.Plan = resp.Plan
+	validatePlannedZoneAcceptsRecords(ctx, r.client, req, &resp.Diagnostics)
+	planPTRName(ctx, r.client, req, resp)
+	planRecordTTL(ctx, r.client, req, resp)
+	validatePlannedALIAS(ctx, r.client, req, &resp.Diagnostics)
+	validatePlannedLUA(ctx, r.client, req, &resp.Diagnostics)
+}
+
+// ValidateConfig checks how the record is named and what it holds. Without
+// fqdn it requires zone_id, and a name or, on PTR records, an ip_address to
+// derive the name from; fqdn excludes all three. It also requires exactly one
+// of content and secret_content, a host name as ALIAS content, a type and
+// snippet as LUA content, and a verifiable type for wait_for_propagation.
+func (r *RecordResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data RecordResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if data.FQDN.IsNull() {
+		if data.ZoneID.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("zone_id"), "Missing Record Zone", "zone_id is required unless fqdn is set.")
+		}
+		validatePTRAddressConfig(data.Name.StringValue, data.Type, data.IPAddress, &resp.Diagnostics)
+	} else {
+		conflicts := []struct {
+			name  string
+			value attr.Value
+		}{{"zone_id", data.ZoneID}, {"name", data.Name}, {"ip_address", data.IPAddress}}
+		for _, c := range conflicts {
+			if !c.value.IsNull() {
+				resp.Diagnostics.AddAttributeError(path.Root(c.name), "Conflicting Record Name",
+					fmt.Sprintf("%s cannot be set together with fqdn, which determines the zone and name of the record.", c.name))
+			}
+		}
+		if !data.FQDN.IsUnknown() && strings.TrimSuffix(strings.TrimSpace(data.FQDN.ValueString()), ".") == "" {
+			resp.Diagnostics.AddAttributeError(path.Root("fqdn"), "Invalid FQDN", "fqdn must not be empty.")
+		}
+	}
+	contentPath := path.Root("content")
+	switch {
+	case !data.Content.IsNull() && !data.SecretContent.IsNull():
+		resp.Diagnostics.AddAttributeError(path.Root("secret_content"), "Conflicting Record Content", "content and secret_content cannot both be set.")
+	case data.Content.IsNull() && data.SecretContent.IsNull():
+		resp.Diagnostics.AddAttributeError(contentPath, "Missing Record Content", "content or secret_content is required.")
+	case !data.SecretContent.IsNull():
+		contentPath = path.Root("secret_content")
+		data.unsealContent()
+	}
+	if isALIASType(data.Type.ValueString()) && !data.Content.IsUnknown() && !data.Content.IsNull() {
+		if err := validateALIASTarget(data.Content.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(contentPath, "Invalid ALIAS Target", err.Error())
+		}
+	}
+	if isLUAType(data.Type.ValueString()) && !data.Content.IsUnknown() && !data.Content.IsNull() {
+		if err := validateLUAContent(data.Content.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(contentPath, "Invalid LUA Content", err.Error())
+		}
+	}
+	validatePropagationConfig(data.WaitForPropagation, data.PropagationTimeout, data.Type, &resp.Diagnostics)
+}
+
 func (r *RecordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data RecordResourceModel
 
@@ -141,12 +306,23 @@ func (r *RecordResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	secret := data.unsealContent()
+	ctx = maskContentLogs(ctx, data.SensitiveContent.ValueBool() || secret, data.Content.ValueString())
+
+	if !r.resolveFQDN(ctx, &data, &resp.Diagnostics) {
+		return
+	}
+	if !r.resolveName(ctx, &data, &resp.Diagnostics) {
+		return
+	}
+	resolveRecordTTL(ctx, r.client, data.ZoneID.ValueInt64(), &data.TTL, &resp.Diagnostics)
+
 	// Build create request
 	createReq := CreateRecordRequest{
 		Name:      data.Name.ValueString(),
 		Type:      data.Type.ValueString(),
 		Content:   data.Content.ValueString(),
-		TTL:       int(data.TTL.ValueInt64()),
+		TTL:       int(data.TTL.ValueSeconds()),
 		CreatePTR: data.CreatePTR.ValueBool(),
 	}
 
@@ -156,9 +332,27 @@ func (r *RecordResource) Create(ctx context.Context, req resource.CreateRequest,
 	if !data.Disabled.IsNull() {
 		createReq.Disabled = data.Disabled.ValueBool()
 	}
+	autoQuote := autoQuoteTXT(r.client, data.AutoQuoteTXT, data.Type.ValueString())
+	if autoQuote {
+		createReq.Content = quoteTXTContent(createReq.Content)
+	}
+	if isLUAType(createReq.Type) {
+		createReq.Content = quoteLUAContent(createReq.Content)
+	}
 
 	zoneID := data.ZoneID.ValueInt64()
 
+	// Zone IDs unknown at plan time are only checked now
+	if !validateZoneAcceptsRecords(ctx, r.client, zoneID, &resp.Diagnostics) {
+		return
+	}
+	if isALIASType(createReq.Type) && !validateALIASPlacement(ctx, r.client, zoneID, createReq.Name, &resp.Diagnostics) {
+		return
+	}
+	if !rejectForbiddenLUA(r.client, createReq.Type, &resp.Diagnostics) {
+		return
+	}
+
 	tflog.Debug(ctx, "Creating record", map[string]interface{}{
 		"zone_id": zoneID,
 		"name":    createReq.Name,
@@ -167,6 +361,10 @@ func (r *RecordResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	// Create the record via API
 	record, err := r.client.CreateRecord(ctx, zoneID, createReq)
+	if IsCNAMEConflictError(err) {
+		addCNAMEConflictError(&resp.Diagnostics, zoneID, data.Name.ValueString(), data.Type.ValueString(), err)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating Record",
@@ -183,13 +381,16 @@ func (r *RecordResource) Create(ctx context.Context, req resource.CreateRequest,
 			fmt.Sprintf("Could not resolve zone name for zone ID %d: %s. Keeping configured name %q.", zoneID, zErr, data.Name.ValueString()),
 		)
 	}
-	data.applyRecord(record, zoneName)
+	data.applyRecord(record, zoneName, autoQuote)
 
 	tflog.Trace(ctx, "Created record", map[string]interface{}{
 		"id": record.ID,
 	})
 
+	awaitPropagation(ctx, r.client, data.WaitForPropagation, data.PropagationTimeout, data.propagationCheck(record), &resp.Diagnostics)
+
 	// Save data into Terraform state
+	data.sealContent(secret)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -203,6 +404,9 @@ func (r *RecordResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	secret := data.unsealContent()
+	ctx = maskContentLogs(ctx, data.SensitiveContent.ValueBool() || secret, data.Content.ValueString())
+
 	// Record IDs are opaque strings: numeric on SQL backends, encoded on the
 	// PowerDNS API backend.
 	recordID := RecordID(data.ID.ValueString())
@@ -246,9 +450,10 @@ func (r *RecordResource) Read(ctx context.Context, req resource.ReadRequest, res
 		)
 		return
 	}
-	data.applyRecord(record, zoneName)
+	data.applyRecord(record, zoneName, autoQuoteTXT(r.client, data.AutoQuoteTXT, data.Type.ValueString()))
 
 	// Save updated data into Terraform state
+	data.sealContent(secret)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -262,6 +467,9 @@ func (r *RecordResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	secret := data.unsealContent()
+	ctx = maskContentLogs(ctx, data.SensitiveContent.ValueBool() || secret, data.Content.ValueString())
+
 	// Record IDs are opaque strings: numeric on SQL backends, encoded on the
 	// PowerDNS API backend.
 	recordID := RecordID(data.ID.ValueString())
@@ -272,6 +480,11 @@ func (r *RecordResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	zoneID := data.ZoneID.ValueInt64()
 
+	if !r.resolveName(ctx, &data, &resp.Diagnostics) {
+		return
+	}
+	resolveRecordTTL(ctx, r.client, zoneID, &data.TTL, &resp.Diagnostics)
+
 	// Build update request
 	// Always send TTL and Priority (even if zero) to allow setting them to 0
 	updateReq := UpdateRecordRequest{
@@ -280,19 +493,38 @@ func (r *RecordResource) Update(ctx context.Context, req resource.UpdateRequest,
 		Content: data.Content.ValueString(),
 	}
 
+	autoQuote := autoQuoteTXT(r.client, data.AutoQuoteTXT, data.Type.ValueString())
+	if autoQuote {
+		updateReq.Content = quoteTXTContent(updateReq.Content)
+	}
+	if isLUAType(updateReq.Type) {
+		updateReq.Content = quoteLUAContent(updateReq.Content)
+	}
+
 	// TTL - always send the value (even if 0) since it's computed with a default
-	ttl := int(data.TTL.ValueInt64())
+	ttl := int(data.TTL.ValueSeconds())
 	updateReq.TTL = &ttl
 
 	// Priority - always send the value (even if 0) since it's computed with a default
 	priority := int(data.Priority.ValueInt64())
 	updateReq.Priority = &priority
 
-	// Disabled - always send the value since it's computed with a default
+	// Disabled - always send the value since it's computed with a default,
+	// unless an unchanged false may be overridden on the server
 	if !data.Disabled.IsNull() {
 		disabled := data.Disabled.ValueBool()
 		updateReq.Disabled = &disabled
 	}
+	if data.IgnoreExternalDisable.ValueBool() && !data.Disabled.ValueBool() {
+		var priorDisabled types.Bool
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("disabled"), &priorDisabled)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !priorDisabled.ValueBool() {
+			updateReq.Disabled = nil
+		}
+	}
 
 	tflog.Debug(ctx, "Updating record", map[string]interface{}{
 		"zone_id":   zoneID,
@@ -317,9 +549,12 @@ func (r *RecordResource) Update(ctx context.Context, req resource.UpdateRequest,
 			fmt.Sprintf("Could not resolve zone name for zone ID %d: %s. Keeping configured name %q.", zoneID, zErr, data.Name.ValueString()),
 		)
 	}
-	data.applyRecord(record, zoneName)
+	data.applyRecord(record, zoneName, autoQuote)
+
+	awaitPropagation(ctx, r.client, data.WaitForPropagation, data.PropagationTimeout, data.propagationCheck(record), &resp.Diagnostics)
 
 	// Save updated data into Terraform state
+	data.sealContent(secret)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -333,6 +568,9 @@ func (r *RecordResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	secret := data.unsealContent()
+	ctx = maskContentLogs(ctx, data.SensitiveContent.ValueBool() || secret, data.Content.ValueString())
+
 	// Record IDs are opaque strings: numeric on SQL backends, encoded on the
 	// PowerDNS API backend.
 	recordID := RecordID(data.ID.ValueString())
@@ -389,6 +627,118 @@ func (r *RecordResource) ImportState(ctx context.Context, req resource.ImportSta
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone_id"), zoneID)...)
 }
 
+// resolveName derives a name still unknown at apply time from ip_address;
+// returns false when it added an error.
+func (r *RecordResource) resolveName(ctx context.Context, data *RecordResourceModel, diags *diag.Diagnostics) bool {
+	if !data.Name.IsUnknown() {
+		return true
+	}
+	name, err := resolvePTRName(ctx, r.client, data.ZoneID.ValueInt64(), data.IPAddress.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("ip_address"), "Invalid ip_address", err.Error())
+		return false
+	}
+	data.Name = NewDNSNameValue(name)
+	return true
+}
+
+// planFQDN plans the zone_id and name an fqdn resolves to. Both stay unknown
+// while the fqdn is unknown or no zone contains it yet, so a zone created in
+// the same apply can own the record. Moving to another zone, or to one still
+// unknown, replaces the record.
+func (r *RecordResource) planFQDN(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+	var fqdn DNSNameValue
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("fqdn"), &fqdn)...)
+	if resp.Diagnostics.HasError() || fqdn.IsNull() {
+		return
+	}
+
+	var zone *Zone
+	var name string
+	if !fqdn.IsUnknown() {
+		var err error
+		zone, name, err = resolveRecordFQDN(ctx, r.client, fqdn.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("fqdn"), "Error Resolving FQDN", err.Error())
+			return
+		}
+	}
+	var zoneID types.Int64
+	if zone == nil {
+		zoneID = types.Int64Unknown()
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("name"), NewDNSNameUnknown())...)
+	} else {
+		zoneID = types.Int64Value(int64(zone.ID))
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("name"), name)...)
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("zone_id"), zoneID)...)
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+	var priorZoneID types.Int64
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("zone_id"), &priorZoneID)...)
+	if !priorZoneID.IsNull() && !priorZoneID.Equal(zoneID) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("zone_id"))
+	}
+}
+
+// resolveFQDN resolves an fqdn left unresolved at plan time, failing when
+// still no zone contains it.
+func (r *RecordResource) resolveFQDN(ctx context.Context, data *RecordResourceModel, diags *diag.Diagnostics) bool {
+	if data.FQDN.IsNull() || !data.ZoneID.IsUnknown() {
+		return true
+	}
+	zone, name, err := resolveRecordFQDN(ctx, r.client, data.FQDN.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("fqdn"), "Error Resolving FQDN", err.Error())
+		return false
+	}
+	if zone == nil {
+		diags.AddAttributeError(path.Root("fqdn"), "No Zone for FQDN",
+			fmt.Sprintf("No zone visible to the provider contains %q.", data.FQDN.ValueString()))
+		return false
+	}
+	data.ZoneID = types.Int64Value(int64(zone.ID))
+	data.Name = NewDNSNameValue(name)
+	return true
+}
+
+// unsealContent moves secret_content into content for the duration of an
+// operation and reports whether it did.
+func (m *RecordResourceModel) unsealContent() bool {
+	if m.SecretContent.IsNull() {
+		return false
+	}
+	m.Content = m.SecretContent
+	return true
+}
+
+// sealContent moves content back into secret_content after unsealContent.
+func (m *RecordResourceModel) sealContent(secret bool) {
+	if secret {
+		m.SecretContent = m.Content
+		m.Content = types.StringNull()
+	}
+}
+
+// propagationCheck describes what wait_for_propagation waits for: the record's
+// content being served, unless the record is disabled on the server.
+func (m *RecordResourceModel) propagationCheck(record *Record) propagationCheck {
+	check := propagationCheck{
+		ZoneID: m.ZoneID.ValueInt64(),
+		Name:   m.Name.ValueString(),
+		Type:   m.Type.ValueString(),
+	}
+	if !record.Disabled {
+		check.Expected = []string{expectedServedValue(check.Type, m.Content.ValueString(), m.Priority.ValueInt64())}
+	}
+	return check
+}
+
 // zoneNameForNormalization resolves the zone name only when the configured and
 // API names differ (the only case normalization needs it); lookups are memoized.
 func (r *RecordResource) zoneNameForNormalization(ctx context.Context, data *RecordResourceModel, record *Record) (string, error) {
@@ -401,17 +751,32 @@ func (r *RecordResource) zoneNameForNormalization(ctx context.Context, data *Rec
 
 // applyRecord maps an API record onto the model, preserving the configured
 // name/content forms the API normalizes away. create_ptr is not persisted by
-// the API, so the plan/state value is kept (false after imports/upgrades).
-func (m *RecordResourceModel) applyRecord(record *Record, zoneName string) {
+// the API, so the plan/state value is kept (false after imports/upgrades);
+// the same goes for sensitive_content and ignore_external_disable. With
+// ignore_external_disable, a record disabled on the server stays enabled in
+// state while the model has it enabled; with autoQuote, TXT content the API
+// returns quoted keeps its configured unquoted form.
+func (m *RecordResourceModel) applyRecord(record *Record, zoneName string, autoQuote bool) {
 	m.ID = types.StringValue(string(record.ID))
 	m.ZoneID = types.Int64Value(int64(record.ZoneID))
-	m.Name = types.StringValue(normalizeRecordName(m.Name.ValueString(), record.Name, zoneName))
+	m.Name = NewDNSNameValue(normalizeRecordName(m.Name.ValueString(), record.Name, zoneName))
 	m.Type = types.StringValue(normalizeTypeCase(m.Type.ValueString(), record.Type))
-	m.Content = types.StringValue(normalizeRecordContent(m.Content.ValueString(), record.Content))
-	m.TTL = types.Int64Value(int64(record.TTL))
+	content := normalizeRecordContent(m.Content.ValueString(), record.Content, record.Type)
+	if autoQuote {
+		content = normalizeAutoQuotedTXT(m.Content.ValueString(), content)
+	}
+	m.Content = types.StringValue(content)
+	m.TTL = NewTTLValue(int64(record.TTL))
 	m.Priority = types.Int64Value(int64(record.Priority))
-	m.Disabled = types.BoolValue(record.Disabled)
-	if m.CreatePTR.IsNull() {
-		m.CreatePTR = types.BoolValue(false)
+	if !record.Disabled || !m.IgnoreExternalDisable.ValueBool() || m.Disabled.IsNull() || m.Disabled.ValueBool() {
+		m.Disabled = types.BoolValue(record.Disabled)
+	}
+	for _, setting := range []*types.Bool{&m.CreatePTR, &m.SensitiveContent, &m.IgnoreExternalDisable} {
+		if setting.IsNull() {
+			*setting = types.BoolValue(false)
+		}
 	}
+	m.ChangeDate = changeDateValue(record.ChangeDate)
+	m.CreatedAt = normalizeEmptyString(types.StringNull(), record.CreatedAt)
+	m.UpdatedAt = normalizeEmptyString(types.StringNull(), record.UpdatedAt)
 }
@@ -7,10 +7,65 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
+func TestApplyRecord_IgnoreExternalDisable(t *testing.T) {
+	tests := []struct {
+		name           string
+		ignore         bool
+		modelDisabled  types.Bool
+		serverDisabled bool
+		want           bool
+	}{
+		{"external disable is drift by default", false, types.BoolValue(false), true, true},
+		{"external disable ignored", true, types.BoolValue(false), true, false},
+		{"configured disable kept", true, types.BoolValue(true), true, true},
+		{"external enable is drift", true, types.BoolValue(true), false, false},
+		{"import takes server value", true, types.BoolNull(), true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := RecordResourceModel{
+				Name:                  NewDNSNameValue("www"),
+				Content:               types.StringValue("192.0.2.1"),
+				Disabled:              tt.modelDisabled,
+				IgnoreExternalDisable: types.BoolValue(tt.ignore),
+			}
+			record := &Record{ID: "1", ZoneID: 1, Name: "www", Type: "A", Content: "192.0.2.1", TTL: 3600, Disabled: tt.serverDisabled}
+			m.applyRecord(record, "", false)
+			if m.Disabled.ValueBool() != tt.want {
+				t.Errorf("disabled = %v, want %v", m.Disabled.ValueBool(), tt.want)
+			}
+			// Propagation follows what the server serves, not the state
+			if check := m.propagationCheck(record); (len(check.Expected) == 0) != tt.serverDisabled {
+				t.Errorf("propagation check expects %v with server disabled=%v", check.Expected, tt.serverDisabled)
+			}
+		})
+	}
+}
+
+func TestApplyRecord_ChangeMetadata(t *testing.T) {
+	var m RecordResourceModel
+	m.applyRecord(&Record{ID: "1", ZoneID: 1, Name: "www", Type: "A", Content: "192.0.2.1", ChangeDate: 1700000000, UpdatedAt: "2023-11-14 22:13:20"}, "", false)
+	if got := m.ChangeDate.ValueString(); got != "2023-11-14T22:13:20Z" {
+		t.Errorf("change_date = %q, want 2023-11-14T22:13:20Z", got)
+	}
+	if !m.CreatedAt.IsNull() {
+		t.Errorf("expected created_at to be null when not reported, got %s", m.CreatedAt)
+	}
+	if got := m.UpdatedAt.ValueString(); got != "2023-11-14 22:13:20" {
+		t.Errorf("updated_at = %q, want it as reported", got)
+	}
+
+	m.applyRecord(&Record{ID: "1", ZoneID: 1, Name: "www", Type: "A", Content: "192.0.2.1"}, "", false)
+	if !m.ChangeDate.IsNull() || !m.UpdatedAt.IsNull() {
+		t.Errorf("expected null timestamps when not reported, got %s and %s", m.ChangeDate, m.UpdatedAt)
+	}
+}
+
 func TestAccRecordResource(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -105,6 +160,91 @@ func TestAccRecordResource_CNAME(t *testing.T) {
 	})
 }
 
+func TestAccRecordResource_PTRFromIPAddress(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+resource "poweradmin_zone" "test" {
+  name = "100.51.198.in-addr.arpa"
+  type = "MASTER"
+}
+
+resource "poweradmin_record" "test" {
+  zone_id    = poweradmin_zone.test.id
+  ip_address = "198.51.100.25"
+  type       = "PTR"
+  content    = "host25.example.com."
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("poweradmin_record.test", "name", "25"),
+					resource.TestCheckResourceAttr("poweradmin_record.test", "type", "PTR"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRecordResource_SecretContent(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+resource "poweradmin_zone" "test" {
+  name = "test-secret-acc.example.com"
+  type = "MASTER"
+}
+
+resource "poweradmin_record" "test" {
+  zone_id        = poweradmin_zone.test.id
+  name           = "_verification"
+  type           = "TXT"
+  secret_content = "\"token-value\""
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("poweradmin_record.test", "secret_content", `"token-value"`),
+					resource.TestCheckNoResourceAttr("poweradmin_record.test", "content"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRecordResource_FQDN(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+resource "poweradmin_zone" "test" {
+  name = "test-fqdn-acc.example.com"
+  type = "MASTER"
+}
+
+resource "poweradmin_record" "test" {
+  fqdn    = "api.eu.test-fqdn-acc.example.com"
+  type    = "A"
+  content = "192.0.2.10"
+
+  depends_on = [poweradmin_zone.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("poweradmin_record.test", "zone_id", "poweradmin_zone.test", "id"),
+					resource.TestCheckResourceAttr("poweradmin_record.test", "name", "api.eu"),
+				),
+			},
+		},
+	})
+}
+
 func testAccRecordResourceConfig(zoneName, recordName, recordType, content string, ttl int) string {
 	return testAccProviderConfig() + fmt.Sprintf(`
 resource "poweradmin_zone" "test" {
@@ -31,6 +31,9 @@ type RecordsDataSourceModel struct {
 	Type    types.String      `tfsdk:"type"`
 	Name    types.String      `tfsdk:"name"`
 	Records []RecordDataModel `tfsdk:"records"`
+
+	IncludeDisabled types.Bool `tfsdk:"include_disabled"`
+	DisabledOnly    types.Bool `tfsdk:"disabled_only"`
 }
 
 // RecordDataModel describes a single record.
@@ -66,6 +69,14 @@ func (d *RecordsDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				MarkdownDescription: "Filter by exact record name. Optional.",
 				Optional:            true,
 			},
+			"include_disabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether disabled records are returned. Defaults to true.",
+				Optional:            true,
+			},
+			"disabled_only": schema.BoolAttribute{
+				MarkdownDescription: "Return only disabled records, e.g. to list them for review or cleanup. Defaults to false.",
+				Optional:            true,
+			},
 			"records": schema.ListNestedAttribute{
 				MarkdownDescription: "List of matching DNS records",
 				Computed:            true,
@@ -161,6 +172,23 @@ func (d *RecordsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		)
 		return
 	}
+	if data.IncludeDisabled.IsUnknown() || data.DisabledOnly.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Unknown disabled filter value",
+			"The include_disabled or disabled_only value is unknown at plan time. Data sources cannot be read until all configuration values are known.",
+		)
+		return
+	}
+	includeDisabled := data.IncludeDisabled.IsNull() || data.IncludeDisabled.ValueBool()
+	disabledOnly := data.DisabledOnly.ValueBool()
+	if disabledOnly && !includeDisabled {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("disabled_only"),
+			"Conflicting Disabled Filters",
+			"disabled_only = true returns only disabled records, which include_disabled = false excludes.",
+		)
+		return
+	}
 
 	// Call API to list records
 	recordType := ""
@@ -186,6 +214,7 @@ func (d *RecordsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	} else {
 		filteredRecords = records
 	}
+	filteredRecords = filterRecordsByDisabled(filteredRecords, includeDisabled, disabledOnly)
 
 	// Map response to model
 	recordModels := make([]RecordDataModel, len(filteredRecords))
@@ -206,3 +235,18 @@ func (d *RecordsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// filterRecordsByDisabled drops disabled records unless includeDisabled, and
+// enabled ones when disabledOnly.
+func filterRecordsByDisabled(records []Record, includeDisabled, disabledOnly bool) []Record {
+	if includeDisabled && !disabledOnly {
+		return records
+	}
+	var filtered []Record
+	for _, rec := range records {
+		if rec.Disabled == disabledOnly {
+			filtered = append(filtered, rec)
+		}
+	}
+	return filtered
+}
@@ -5,11 +5,41 @@ package provider
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
+func TestFilterRecordsByDisabled(t *testing.T) {
+	records := []Record{
+		{ID: "1", Disabled: false},
+		{ID: "2", Disabled: true},
+		{ID: "3", Disabled: false},
+	}
+	tests := []struct {
+		name            string
+		includeDisabled bool
+		disabledOnly    bool
+		want            []RecordID
+	}{
+		{"default", true, false, []RecordID{"1", "2", "3"}},
+		{"exclude disabled", false, false, []RecordID{"1", "3"}},
+		{"disabled only", true, true, []RecordID{"2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []RecordID
+			for _, rec := range filterRecordsByDisabled(records, tt.includeDisabled, tt.disabledOnly) {
+				got = append(got, rec.ID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected records %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
 func TestAccRecordsDataSource(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
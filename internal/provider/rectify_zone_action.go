@@ -0,0 +1,101 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &RectifyZoneAction{}
+var _ action.ActionWithConfigure = &RectifyZoneAction{}
+
+func NewRectifyZoneAction() action.Action {
+	return &RectifyZoneAction{}
+}
+
+// RectifyZoneAction rectifies a DNSSEC-signed zone on demand.
+type RectifyZoneAction struct {
+	client *Client
+}
+
+// RectifyZoneActionModel describes the action data model.
+type RectifyZoneActionModel struct {
+	ZoneID types.Int64 `tfsdk:"zone_id"`
+}
+
+func (a *RectifyZoneAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rectify_zone"
+}
+
+func (a *RectifyZoneAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Rectifies a zone: recalculates the ordering and authoritative flags PowerDNS keeps for its records, so the NSEC/NSEC3 chain of a DNSSEC-signed zone " +
+			"is correct after record changes without running `pdnsutil rectify-zone`. Trigger it after record changes with `action_trigger`. " +
+			"Uses the PowerDNS API when Poweradmin does not implement rectify and `powerdns_api_url` is set. Requires Terraform 1.14 or later.",
+
+		Attributes: map[string]schema.Attribute{
+			"zone_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the zone to rectify",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (a *RectifyZoneAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+}
+
+func (a *RectifyZoneAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data RectifyZoneActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneID := data.ZoneID.ValueInt64()
+
+	tflog.Debug(ctx, "Rectifying zone", map[string]interface{}{
+		"zone_id": zoneID,
+	})
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Rectifying zone %d", zoneID),
+	})
+
+	if err := a.client.RectifyZone(ctx, zoneID); err != nil {
+		detail := fmt.Sprintf("Could not rectify zone %d: %s", zoneID, err)
+		if isMissingEndpoint(err) && a.client.PowerDNSAPIURL == "" {
+			detail += "\n\nThe Poweradmin API may not implement rectify; set powerdns_api_url and powerdns_api_key in the provider to rectify through the PowerDNS API."
+		}
+		resp.Diagnostics.AddError("Error Rectifying Zone", detail)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Zone %d rectified", zoneID),
+	})
+}
@@ -0,0 +1,144 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ReverseZoneDataSource{}
+
+func NewReverseZoneDataSource() datasource.DataSource {
+	return &ReverseZoneDataSource{}
+}
+
+// ReverseZoneDataSource defines the data source implementation.
+type ReverseZoneDataSource struct {
+	client *Client
+}
+
+// ReverseZoneDataSourceModel describes the data source data model.
+type ReverseZoneDataSourceModel struct {
+	IPAddress types.String `tfsdk:"ip_address"`
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Type      types.String `tfsdk:"type"`
+	PTRName   types.String `tfsdk:"ptr_name"`
+}
+
+func (d *ReverseZoneDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reverse_zone"
+}
+
+func (d *ReverseZoneDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Finds the most specific `in-addr.arpa` or `ip6.arpa` zone in Poweradmin that holds the PTR record for an IP address.",
+
+		Attributes: map[string]schema.Attribute{
+			"ip_address": schema.StringAttribute{
+				MarkdownDescription: "IPv4 or IPv6 address to look up",
+				Required:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The reverse zone ID",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The reverse zone name (e.g., 2.0.192.in-addr.arpa)",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Zone type (MASTER, SLAVE, or NATIVE)",
+				Computed:            true,
+			},
+			"ptr_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the address's PTR record relative to the zone (e.g., `10` for 192.0.2.10)",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ReverseZoneDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ReverseZoneDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ReverseZoneDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ip, err := netip.ParseAddr(strings.TrimSpace(data.IPAddress.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ip_address"),
+			"Invalid IP Address",
+			fmt.Sprintf("%q is not a valid IPv4 or IPv6 address.", data.IPAddress.ValueString()),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Looking up reverse zone", map[string]interface{}{
+		"ip_address": ip.String(),
+	})
+
+	zones, err := d.client.ListZones(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Zones",
+			fmt.Sprintf("Could not list zones: %s", err.Error()),
+		)
+		return
+	}
+
+	zone, ptrName := findReverseZone(zones, ip)
+	if zone == nil {
+		resp.Diagnostics.AddError(
+			"No Reverse Zone Found",
+			fmt.Sprintf("No zone in Poweradmin contains %s. Create a reverse zone covering %s first.", reversePTRName(ip), ip),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(strconv.Itoa(zone.ID))
+	data.Name = types.StringValue(zone.Name)
+	data.Type = types.StringValue(zone.Type)
+	data.PTRName = types.StringValue(ptrName)
+
+	tflog.Trace(ctx, "Read reverse zone data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,42 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccReverseZoneDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReverseZoneDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.poweradmin_reverse_zone.test", "id", "poweradmin_zone.reverse", "id"),
+					resource.TestCheckResourceAttr("data.poweradmin_reverse_zone.test", "name", "113.0.203.in-addr.arpa"),
+					resource.TestCheckResourceAttr("data.poweradmin_reverse_zone.test", "ptr_name", "10"),
+				),
+			},
+		},
+	})
+}
+
+func testAccReverseZoneDataSourceConfig() string {
+	return testAccProviderConfig() + `
+resource "poweradmin_zone" "reverse" {
+  name = "113.0.203.in-addr.arpa"
+  type = "MASTER"
+}
+
+data "poweradmin_reverse_zone" "test" {
+  ip_address = "203.0.113.10"
+
+  depends_on = [poweradmin_zone.reverse]
+}
+`
+}
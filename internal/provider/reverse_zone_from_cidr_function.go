@@ -0,0 +1,118 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ReverseZoneFromCIDRFunction{}
+
+func NewReverseZoneFromCIDRFunction() function.Function {
+	return &ReverseZoneFromCIDRFunction{}
+}
+
+// ReverseZoneFromCIDRFunction maps an IP prefix to its reverse DNS zones.
+type ReverseZoneFromCIDRFunction struct{}
+
+func (f *ReverseZoneFromCIDRFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "reverse_zone_from_cidr"
+}
+
+func (f *ReverseZoneFromCIDRFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Convert an IP prefix to reverse zone names",
+		MarkdownDescription: "Returns the `in-addr.arpa` or `ip6.arpa` zone names covering a prefix, e.g. `10.20.0.0/16` → `[\"20.10.in-addr.arpa\"]`. " +
+			"Reverse zones are delegated on octet (IPv4) or nibble (IPv6) boundaries, so a prefix in between expands to every zone it spans: " +
+			"`10.20.0.0/23` → `[\"0.20.10.in-addr.arpa\", \"1.20.10.in-addr.arpa\"]`. " +
+			"IPv4 prefixes longer than /24 return the enclosing /24 zone (RFC 2317 classless delegation is not generated). Host bits are ignored.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr",
+				MarkdownDescription: "IPv4 or IPv6 prefix in CIDR notation",
+			},
+		},
+		Return: function.ListReturn{ElementType: types.StringType},
+	}
+}
+
+func (f *ReverseZoneFromCIDRFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidr))
+	if resp.Error != nil {
+		return
+	}
+
+	zones, err := reverseZonesForCIDR(cidr)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, zones))
+}
+
+// reverseZonesForCIDR rounds the prefix length up to the next delegation
+// boundary (8 bits for IPv4, 4 for IPv6) and names every zone covered.
+func reverseZonesForCIDR(cidr string) ([]string, error) {
+	prefix, err := netip.ParsePrefix(strings.TrimSpace(cidr))
+	if err != nil {
+		return nil, fmt.Errorf("%q is not a valid CIDR prefix", cidr)
+	}
+	prefix = prefix.Masked()
+	addr := prefix.Addr()
+
+	step, suffix := 4, "ip6.arpa"
+	if addr.Is4() {
+		step, suffix = 8, "in-addr.arpa"
+	}
+	bits := prefix.Bits()
+	if addr.Is4() && bits > 24 {
+		bits = 24
+	}
+	zoneBits := (bits + step - 1) / step * step
+	if zoneBits == 0 {
+		zoneBits = step
+	}
+
+	// Enumerate the zoneBits-long prefixes inside the original prefix by
+	// counting through the bits between the two lengths.
+	spanBits := zoneBits - min(prefix.Bits(), zoneBits)
+	count := 1 << spanBits
+	raw := addr.AsSlice()
+	zones := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		b := make([]byte, len(raw))
+		copy(b, raw)
+		for bit := 0; bit < spanBits; bit++ {
+			if i&(1<<bit) != 0 {
+				pos := zoneBits - 1 - bit
+				b[pos/8] |= 0x80 >> (pos % 8)
+			}
+		}
+		zones = append(zones, reverseZoneName(b, zoneBits, step, suffix))
+	}
+	return zones, nil
+}
+
+// reverseZoneName labels the first zoneBits of an address in reverse order.
+func reverseZoneName(b []byte, zoneBits, step int, suffix string) string {
+	labels := make([]string, 0, zoneBits/step+1)
+	for pos := zoneBits - step; pos >= 0; pos -= step {
+		if step == 8 {
+			labels = append(labels, fmt.Sprintf("%d", b[pos/8]))
+		} else {
+			labels = append(labels, fmt.Sprintf("%x", (b[pos/8]>>(4-pos%8))&0xf))
+		}
+	}
+	return strings.Join(append(labels, suffix), ".")
+}
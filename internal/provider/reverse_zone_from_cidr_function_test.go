@@ -0,0 +1,62 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestReverseZonesForCIDR(t *testing.T) {
+	tests := []struct {
+		name    string
+		cidr    string
+		want    []string
+		wantErr bool
+	}{
+		{"ipv4 /16", "10.20.0.0/16", []string{"20.10.in-addr.arpa"}, false},
+		{"ipv4 /24", "192.0.2.0/24", []string{"2.0.192.in-addr.arpa"}, false},
+		{"ipv4 /8", "10.0.0.0/8", []string{"10.in-addr.arpa"}, false},
+		{"ipv4 /23 spans two zones", "10.20.0.0/23", []string{"0.20.10.in-addr.arpa", "1.20.10.in-addr.arpa"}, false},
+		{"ipv4 /22 offset", "10.20.4.0/22", []string{"4.20.10.in-addr.arpa", "5.20.10.in-addr.arpa", "6.20.10.in-addr.arpa", "7.20.10.in-addr.arpa"}, false},
+		{"ipv4 /26 uses enclosing /24", "192.0.2.64/26", []string{"2.0.192.in-addr.arpa"}, false},
+		{"host bits ignored", "10.20.30.40/16", []string{"20.10.in-addr.arpa"}, false},
+		{"ipv6 /48", "2001:db8:abcd::/48", []string{"d.c.b.a.8.b.d.0.1.0.0.2.ip6.arpa"}, false},
+		{"ipv6 /32", "2001:db8::/32", []string{"8.b.d.0.1.0.0.2.ip6.arpa"}, false},
+		{"ipv6 /63 spans two zones", "2001:db8:0:10::/63", []string{"0.1.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa", "1.1.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa"}, false},
+		{"missing length", "10.20.0.0", nil, true},
+		{"garbage", "not-a-cidr", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := reverseZonesForCIDR(tt.cidr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("reverseZonesForCIDR() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("reverseZonesForCIDR() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReverseZoneFromCIDRFunctionRun(t *testing.T) {
+	ctx := context.Background()
+	req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("10.20.0.0/16")})}
+	resp := function.RunResponse{Result: function.NewResultData(types.ListUnknown(types.StringType))}
+	NewReverseZoneFromCIDRFunction().Run(ctx, req, &resp)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+	want := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("20.10.in-addr.arpa")})
+	if got := resp.Result.Value(); !got.Equal(want) {
+		t.Errorf("unexpected result %s", got)
+	}
+}
@@ -6,9 +6,11 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -24,6 +26,9 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &RRSetResource{}
 var _ resource.ResourceWithImportState = &RRSetResource{}
+var _ resource.ResourceWithModifyPlan = &RRSetResource{}
+var _ resource.ResourceWithValidateConfig = &RRSetResource{}
+var _ resource.ResourceWithMoveState = &RRSetResource{}
 
 func NewRRSetResource() resource.Resource {
 	return &RRSetResource{}
@@ -38,17 +43,34 @@ type RRSetResource struct {
 type RRSetResourceModel struct {
 	ID      types.String       `tfsdk:"id"`
 	ZoneID  types.Int64        `tfsdk:"zone_id"`
-	Name    types.String       `tfsdk:"name"`
+	Name    DNSNameValue       `tfsdk:"name"`
 	Type    types.String       `tfsdk:"type"`
-	TTL     types.Int64        `tfsdk:"ttl"`
+	TTL     TTLValue           `tfsdk:"ttl"`
 	Records []RRSetRecordModel `tfsdk:"records"`
+
+	SecretRecords []RRSetRecordModel `tfsdk:"secret_records"`
+
+	IPAddress types.String `tfsdk:"ip_address"`
+
+	Overwrite types.Bool `tfsdk:"overwrite"`
+	Exclusive types.Bool `tfsdk:"exclusive"`
+
+	SensitiveContent      types.Bool `tfsdk:"sensitive_content"`
+	IgnoreExternalDisable types.Bool `tfsdk:"ignore_external_disable"`
+	AutoQuoteTXT          types.Bool `tfsdk:"auto_quote_txt"`
+
+	WaitForPropagation types.Bool   `tfsdk:"wait_for_propagation"`
+	PropagationTimeout types.String `tfsdk:"propagation_timeout"`
+
+	ChangeDate types.String `tfsdk:"change_date"`
 }
 
 // RRSetRecordModel describes a single record in the RRSet.
 type RRSetRecordModel struct {
-	Content  types.String `tfsdk:"content"`
-	Disabled types.Bool   `tfsdk:"disabled"`
-	Priority types.Int64  `tfsdk:"priority"`
+	Content   types.String `tfsdk:"content"`
+	Disabled  types.Bool   `tfsdk:"disabled"`
+	Priority  types.Int64  `tfsdk:"priority"`
+	CreatePTR types.Bool   `tfsdk:"create_ptr"`
 }
 
 func (r *RRSetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -58,7 +80,7 @@ func (r *RRSetResource) Metadata(ctx context.Context, req resource.MetadataReque
 func (r *RRSetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This describes the provider and how it's configured.
-		MarkdownDescription: "Manages a DNS Resource Record Set (RRSet). An RRSet is a collection of records with the same name and type, managed as a single unit. This matches PowerDNS behavior and is the DNS-correct way to handle multiple records.",
+		MarkdownDescription: "Manages a DNS Resource Record Set (RRSet). An RRSet is a collection of records with the same name and type, managed as a single unit. This matches PowerDNS behavior and is the DNS-correct way to handle multiple records. A `poweradmin_record` can be moved into an RRSet of the same name and type with a `moved` block (Terraform 1.8+).",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -76,48 +98,133 @@ func (r *RRSetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "Record name (use @ for zone apex, or subdomain like 'www')",
-				Required:            true,
+				MarkdownDescription: "Record name (use @ for zone apex, or subdomain like 'www'). Case, a trailing dot or a Unicode spelling cause no diff. Required unless `ip_address` is set.",
+				CustomType:          DNSNameType{},
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					requiresReplaceUnlessSameIDN(),
+				},
+			},
+			"ip_address": schema.StringAttribute{
+				MarkdownDescription: "For PTR RRSets, the IPv4 or IPv6 address the records point back from. The reversed `name` within the zone is derived from it, " +
+					"and the address must belong to the reverse zone. Conflicts with `name`.",
+				Optional: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"type": schema.StringAttribute{
-				MarkdownDescription: "Record type (A, AAAA, CNAME, MX, TXT, etc.)",
-				Required:            true,
+				MarkdownDescription: "Record type (A, AAAA, ALIAS, CNAME, MX, TXT, LUA, etc.). ALIAS RRSets are only allowed at the zone apex, not beside A, AAAA or CNAME records, and hold a single host name target. " +
+					"LUA records take the type they answer for and a Lua snippet, e.g. `A ifportup(443, {'192.0.2.1', '192.0.2.2'})`; the snippet is quoted for PowerDNS when it is not already. " +
+					"The provider's `forbid_lua_records` rejects them.",
+				Required: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
-			"ttl": schema.Int64Attribute{
-				MarkdownDescription: "Time to live (TTL) in seconds. Defaults to 3600.",
+			"ttl": schema.StringAttribute{
+				MarkdownDescription: "Time to live (TTL), in seconds (`300`) or as a duration (`\"5m\"`, `\"1h30m\"`, `\"1d\"`; units s, m, h, d, w). " +
+					"Both forms of the same TTL are equal, so switching between them causes no drift. " +
+					"Defaults to the zone's `default_record_ttl`, or 3600 when the zone sets none.",
+				CustomType: TTLType{},
+				Optional:   true,
+				Computed:   true,
+			},
+			"overwrite": schema.BoolAttribute{
+				MarkdownDescription: "Whether creating this resource may replace an RRSet that already exists on the server with the same name and type. " +
+					"When false (the default), create fails with an import hint instead of silently overwriting the existing records. Only consulted on create.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"exclusive": schema.BoolAttribute{
+				MarkdownDescription: "Whether this resource owns the whole RRSet. When true (the default), records not in `records` are removed. " +
+					"When false, the resource only adds and removes its own records and leaves records written by others in the same RRSet untouched, " +
+					"e.g. for TXT records at the apex shared by SPF and verification tokens. The TTL is shared by the whole RRSet, so all contributors should agree on `ttl`. " +
+					"`overwrite` is not consulted in this mode, since existing records are kept.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"sensitive_content": schema.BoolAttribute{
+				MarkdownDescription: "Keep record contents out of provider debug logs. Defaults to false. " +
+					"This does not hide them in plan output; set the records as `secret_records` instead to hide them there too.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"ignore_external_disable": schema.BoolAttribute{
+				MarkdownDescription: "Leave records disabled when they were disabled outside Terraform, e.g. by an operator during an incident, instead of re-enabling them on the next apply. " +
+					"A server-side `disabled = true` is then not reported as drift for records whose `disabled` is false in the configuration; changing `disabled` in the configuration still takes effect. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"auto_quote_txt": schema.BoolAttribute{
+				MarkdownDescription: "For TXT RRSets, wrap unquoted record contents in quotes before sending them, splitting them into 255-byte strings, and compare the unquoted form on read. " +
+					"Contents that already start with a quote are sent as is. Defaults to the provider's `auto_quote_txt`.",
+				Optional: true,
+			},
+			"wait_for_propagation": schema.BoolAttribute{
+				MarkdownDescription: "After create and update, query the zone's name servers (or the provider's `dns_check_servers`) until they serve exactly the RRSet's enabled records, " +
+					"so dependent checks such as ACME DNS-01 validation do not race the change. Fails when the RRSet is not served within `propagation_timeout`. " +
+					"Supported for A, AAAA, CAA, CNAME, MX, NS, PTR, SRV and TXT RRSets.",
+				Optional: true,
+			},
+			"propagation_timeout": schema.StringAttribute{
+				MarkdownDescription: "How long `wait_for_propagation` waits, as a Go duration such as `90s` or `10m`. Defaults to `5m`.",
 				Optional:            true,
-				Computed:            true,
-				Default:             int64default.StaticInt64(3600),
+			},
+			"change_date": schema.StringAttribute{
+				MarkdownDescription: "When PowerDNS last saw a record of the RRSet change, as an RFC 3339 UTC timestamp. Refreshed on every read, so changes made outside Terraform show up here. " +
+					"Null when the API does not report it.",
+				Computed: true,
 			},
 			"records": schema.SetNestedAttribute{
-				MarkdownDescription: "Set of record contents. All records in the RRSet share the same name, type, and TTL. Order is not significant.",
+				MarkdownDescription: "Set of record contents. All records in the RRSet share the same name, type, and TTL. Order is not significant, and each content and priority pair may appear only once. " +
+					"Required unless `secret_records` is set.",
+				Optional:     true,
+				NestedObject: rrsetRecordObject(),
+			},
+			"secret_records": schema.SetNestedAttribute{
+				MarkdownDescription: "The records, instead of `records`, for contents such as domain verification tokens or ACME keys. " +
+					"They are marked sensitive, so Terraform hides them in plan output, and their contents are kept out of provider debug logs like with `sensitive_content`. Conflicts with `records`.",
+				Optional:     true,
+				Sensitive:    true,
+				NestedObject: rrsetRecordObject(),
+			},
+		},
+	}
+}
+
+// rrsetRecordObject describes a record of records and secret_records.
+func rrsetRecordObject() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"content": schema.StringAttribute{
+				MarkdownDescription: "Record content (IP address, hostname, text, etc.)",
 				Required:            true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"content": schema.StringAttribute{
-							MarkdownDescription: "Record content (IP address, hostname, text, etc.)",
-							Required:            true,
-						},
-						"disabled": schema.BoolAttribute{
-							MarkdownDescription: "Whether this record is disabled. Default: false",
-							Optional:            true,
-							Computed:            true,
-							Default:             booldefault.StaticBool(false),
-						},
-						"priority": schema.Int64Attribute{
-							MarkdownDescription: "Priority for MX, SRV and other priority-bearing records. Default: 0",
-							Optional:            true,
-							Computed:            true,
-							Default:             int64default.StaticInt64(0),
-						},
-					},
-				},
+			},
+			"disabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether this record is disabled. Default: false",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"priority": schema.Int64Attribute{
+				MarkdownDescription: "Priority for MX, SRV and other priority-bearing records. Default: 0",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+			},
+			"create_ptr": schema.BoolAttribute{
+				MarkdownDescription: "Automatically create a PTR (reverse DNS) record for this record when it is added. Only applicable to A and AAAA RRSets. " +
+					"Requires a matching reverse zone. Default: false",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
 			},
 		},
 	}
@@ -143,6 +250,176 @@ func (r *RRSetResource) Configure(ctx context.Context, req resource.ConfigureReq
 	r.client = client
 }
 
+// ModifyPlan rejects new records in a SLAVE zone, misplaced ALIAS RRSets and
+// LUA RRSets forbidden by the provider while planning, instead of failing
+// deep in the apply, plans the zone's default TTL when ttl is unset, and
+// warns about RRSets too large to be intended.
+func (r *RRSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePlannedZoneAcceptsRecords(ctx, r.client, req, &resp.Diagnostics)
+	planPTRName(ctx, r.client, req, resp)
+	planRecordTTL(ctx, r.client, req, resp)
+	validatePlannedALIAS(ctx, r.client, req, &resp.Diagnostics)
+	validatePlannedLUA(ctx, r.client, req, &resp.Diagnostics)
+
+	if r.client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+	var recordType types.String
+	var autoQuote types.Bool
+	var records, secretRecords []RRSetRecordModel
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("type"), &recordType)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("auto_quote_txt"), &autoQuote)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("records"), &records)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("secret_records"), &secretRecords)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	recordsPath := path.Root("records")
+	if secretRecords != nil {
+		records, recordsPath = secretRecords, path.Root("secret_records")
+	}
+	// Judge TXT sizes on the strings that will be sent
+	if autoQuoteTXT(r.client, autoQuote, recordType.ValueString()) {
+		records = slices.Clone(records)
+		for i, rec := range records {
+			if !rec.Content.IsUnknown() && !rec.Content.IsNull() {
+				records[i].Content = types.StringValue(quoteTXTContent(rec.Content.ValueString()))
+			}
+		}
+	}
+	warnRRSetSize(recordType.ValueString(), records, recordsPath, r.client.RRSetSizeWarningThreshold, &resp.Diagnostics)
+}
+
+// DNS limits on TXT data: a character string holds at most 255 bytes, and
+// neither one record's data nor a whole answer can exceed 65535 bytes.
+const (
+	maxTXTStringLength = 255
+	maxDNSDataLength   = 65535
+)
+
+// warnRRSetSize warns, at recordsPath, when an RRSet holds more than
+// threshold records (0 disables) or its TXT content exceeds protocol limits. Such sets are
+// usually produced by a bug in generated configuration rather than intended.
+func warnRRSetSize(recordType string, records []RRSetRecordModel, recordsPath path.Path, threshold int, diags *diag.Diagnostics) {
+	if threshold > 0 && len(records) > threshold {
+		diags.AddAttributeWarning(
+			recordsPath,
+			"Unusually Large RRSet",
+			fmt.Sprintf("The %s RRSet has %d records, more than the rrset_size_warning_threshold of %d. "+
+				"Check the configuration that generates it, or raise the provider's rrset_size_warning_threshold if this is intended.",
+				recordType, len(records), threshold),
+		)
+	}
+
+	upper := strings.ToUpper(recordType)
+	if upper != "TXT" && upper != "SPF" {
+		return
+	}
+	total := 0
+	for _, rec := range records {
+		if rec.Content.IsUnknown() || rec.Content.IsNull() {
+			continue
+		}
+		size := 0
+		for _, str := range splitTXTStrings(rec.Content.ValueString()) {
+			if len(str) > maxTXTStringLength {
+				diags.AddAttributeWarning(
+					recordsPath,
+					"TXT String Too Long",
+					fmt.Sprintf("A %s record holds a string of %d bytes, but DNS character strings are limited to %d bytes. "+
+						"Split the content into several quoted strings (\"part1\" \"part2\").", recordType, len(str), maxTXTStringLength),
+				)
+			}
+			size += len(str) + 1
+		}
+		if size > maxDNSDataLength {
+			diags.AddAttributeWarning(
+				recordsPath,
+				"TXT Record Too Large",
+				fmt.Sprintf("A %s record holds %d bytes of data, more than the %d bytes a DNS record can carry.", recordType, size, maxDNSDataLength),
+			)
+		}
+		total += size
+	}
+	if total > maxDNSDataLength {
+		diags.AddAttributeWarning(
+			recordsPath,
+			"TXT RRSet Too Large",
+			fmt.Sprintf("The %s RRSet holds %d bytes of data in total, more than fits in a single DNS response (%d bytes), even over TCP; "+
+				"resolvers will fail to fetch it.", recordType, total, maxDNSDataLength),
+		)
+	}
+}
+
+// ValidateConfig requires a name, or an ip_address to derive it from on PTR
+// RRSets, host names as ALIAS contents, a type and snippet as LUA contents,
+// a verifiable type for wait_for_propagation, an address type for
+// create_ptr, and distinct (content, priority) pairs in one of records and
+// secret_records.
+func (r *RRSetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data RRSetResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	recordsPath := path.Root("records")
+	switch {
+	case data.Records != nil && data.SecretRecords != nil:
+		resp.Diagnostics.AddAttributeError(path.Root("secret_records"), "Conflicting RRSet Records", "records and secret_records cannot both be set.")
+	case data.Records == nil && data.SecretRecords == nil:
+		resp.Diagnostics.AddAttributeError(recordsPath, "Missing RRSet Records", "records or secret_records is required.")
+	case data.SecretRecords != nil:
+		recordsPath = path.Root("secret_records")
+		data.unsealRecords()
+	}
+	validatePTRAddressConfig(data.Name.StringValue, data.Type, data.IPAddress, &resp.Diagnostics)
+	validatePropagationConfig(data.WaitForPropagation, data.PropagationTimeout, data.Type, &resp.Diagnostics)
+	if recordType := strings.ToUpper(data.Type.ValueString()); !data.Type.IsUnknown() && recordType != "A" && recordType != "AAAA" {
+		for _, rec := range data.Records {
+			if rec.CreatePTR.ValueBool() {
+				resp.Diagnostics.AddAttributeError(
+					recordsPath,
+					"Invalid create_ptr",
+					fmt.Sprintf("create_ptr only applies to A and AAAA RRSets, but type is %q.", data.Type.ValueString()),
+				)
+				break
+			}
+		}
+	}
+	if dup := duplicateRRSetRecord(data.Records); dup != nil {
+		resp.Diagnostics.AddAttributeError(
+			recordsPath,
+			"Duplicate RRSet Record",
+			fmt.Sprintf("Content %q with priority %d is listed more than once. The API keeps a single record per content and priority, "+
+				"which would leave a permanent diff; remove the duplicate.", dup.Content.ValueString(), dup.Priority.ValueInt64()),
+		)
+	}
+	if isLUAType(data.Type.ValueString()) {
+		for _, rec := range data.Records {
+			if rec.Content.IsUnknown() || rec.Content.IsNull() {
+				continue
+			}
+			if err := validateLUAContent(rec.Content.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(recordsPath, "Invalid LUA Content", err.Error())
+			}
+		}
+	}
+	if !isALIASType(data.Type.ValueString()) {
+		return
+	}
+	if len(data.Records) > 1 {
+		resp.Diagnostics.AddAttributeError(recordsPath, "Invalid ALIAS RRSet", "An ALIAS RRSet holds a single target.")
+	}
+	for _, rec := range data.Records {
+		if rec.Content.IsUnknown() || rec.Content.IsNull() {
+			continue
+		}
+		if err := validateALIASTarget(rec.Content.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(recordsPath, "Invalid ALIAS Target", err.Error())
+		}
+	}
+}
+
 func (r *RRSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data RRSetResourceModel
 
@@ -153,12 +430,55 @@ func (r *RRSetResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	secret := data.unsealRecords()
+	ctx = maskContentLogs(ctx, data.SensitiveContent.ValueBool() || secret, rrsetContents(data.Records)...)
+
+	// Zone IDs unknown at plan time are only checked now
+	if !validateZoneAcceptsRecords(ctx, r.client, data.ZoneID.ValueInt64(), &resp.Diagnostics) {
+		return
+	}
+
+	// Names derived from an ip_address unknown at plan time are resolved now
+	if data.Name.IsUnknown() {
+		name, err := resolvePTRName(ctx, r.client, data.ZoneID.ValueInt64(), data.IPAddress.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("ip_address"), "Invalid ip_address", err.Error())
+			return
+		}
+		data.Name = NewDNSNameValue(name)
+	}
+	if isALIASType(data.Type.ValueString()) && !validateALIASPlacement(ctx, r.client, data.ZoneID.ValueInt64(), data.Name.ValueString(), &resp.Diagnostics) {
+		return
+	}
+	if !rejectForbiddenLUA(r.client, data.Type.ValueString(), &resp.Diagnostics) {
+		return
+	}
+	resolveRecordTTL(ctx, r.client, data.ZoneID.ValueInt64(), &data.TTL, &resp.Diagnostics)
+
+	exclusive := data.Exclusive.ValueBool()
+
+	// PUT replaces whatever is there, so look before writing
+	if exclusive && !data.Overwrite.ValueBool() && !r.checkRRSetAbsent(ctx, data, &resp.Diagnostics) {
+		return
+	}
+
+	records := buildRRSetRecordsPayload(data.Records)
+	if !exclusive {
+		current, err := r.currentRecords(ctx, data)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read RRSet before create, got error: %s", err))
+			return
+		}
+		records = mergeRRSetRecords(r.dequotedRecords(data, current, data.Records), nil, data.Records)
+	}
+	r.quotePayload(data, records)
+
 	// Build API request
 	rrsetData := map[string]interface{}{
 		"name":    data.Name.ValueString(),
 		"type":    data.Type.ValueString(),
-		"ttl":     data.TTL.ValueInt64(),
-		"records": buildRRSetRecordsPayload(data.Records),
+		"ttl":     data.TTL.ValueSeconds(),
+		"records": records,
 	}
 
 	tflog.Debug(ctx, "Creating RRSet", map[string]interface{}{
@@ -168,15 +488,19 @@ func (r *RRSetResource) Create(ctx context.Context, req resource.CreateRequest,
 	})
 
 	// Call API to create RRSet
-	err := r.client.CreateRRSet(ctx, data.ZoneID.ValueInt64(), rrsetData)
+	written, err := r.client.CreateRRSet(ctx, data.ZoneID.ValueInt64(), rrsetData)
+	if IsCNAMEConflictError(err) {
+		addCNAMEConflictError(&resp.Diagnostics, data.ZoneID.ValueInt64(), data.Name.ValueString(), data.Type.ValueString(), err)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create RRSet, got error: %s", err))
 		return
 	}
 
-	// Read back the RRSet to get the server's actual values
-	// This ensures state matches what the API stored (normalized values, defaults applied, etc.)
-	rrset, err := r.client.GetRRSet(ctx, data.ZoneID.ValueInt64(), data.Name.ValueString(), data.Type.ValueString())
+	// Map back the server's actual values so state matches what the API
+	// stored (normalized values, defaults applied, etc.)
+	rrset, err := r.storedRRSet(ctx, data, written)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read RRSet after create, got error: %s", err))
 		return
@@ -186,8 +510,9 @@ func (r *RRSetResource) Create(ctx context.Context, req resource.CreateRequest,
 	data.ID = types.StringValue(fmt.Sprintf("%d/%s/%s", data.ZoneID.ValueInt64(), data.Name.ValueString(), data.Type.ValueString()))
 
 	// Update model from API response
-	data.TTL = types.Int64Value(rrset.TTL)
-	data.Records = normalizeRRSetRecords(data.Records, rrset.Records)
+	data.TTL = NewTTLValue(rrset.TTL)
+	data.ChangeDate = changeDateValue(rrsetChangeDate(rrset.Records))
+	data.Records = normalizeRRSetRecords(data.Records, r.managedRecords(data, rrset.Records), data.Type.ValueString())
 
 	tflog.Trace(ctx, "Created RRSet", map[string]interface{}{
 		"zone_id": data.ZoneID.ValueInt64(),
@@ -195,10 +520,83 @@ func (r *RRSetResource) Create(ctx context.Context, req resource.CreateRequest,
 		"type":    data.Type.ValueString(),
 	})
 
+	awaitPropagation(ctx, r.client, data.WaitForPropagation, data.PropagationTimeout, rrsetPropagationCheck(data, rrset), &resp.Diagnostics)
+
 	// Save data into Terraform state
+	data.sealRecords(secret)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// storedRRSet returns the RRSet as stored after a write: the one the API
+// echoed in its response, or else read back, which costs an extra request.
+func (r *RRSetResource) storedRRSet(ctx context.Context, data RRSetResourceModel, written *RRSet) (*RRSet, error) {
+	if written != nil && strings.EqualFold(written.Type, data.Type.ValueString()) {
+		return written, nil
+	}
+	return r.client.GetRRSet(ctx, data.ZoneID.ValueInt64(), data.Name.ValueString(), data.Type.ValueString())
+}
+
+// rrsetChangeDate returns the latest change_date of the records, or 0 when
+// the API reports none.
+func rrsetChangeDate(records []RRSetRecord) int64 {
+	var latest int64
+	for _, rec := range records {
+		latest = max(latest, rec.ChangeDate)
+	}
+	return latest
+}
+
+// rrsetPropagationCheck describes what wait_for_propagation waits for: the
+// enabled records of the whole RRSet as stored, including records owned by
+// others in non-exclusive mode, and nothing else.
+func rrsetPropagationCheck(data RRSetResourceModel, rrset *RRSet) propagationCheck {
+	check := propagationCheck{
+		ZoneID:   data.ZoneID.ValueInt64(),
+		Name:     data.Name.ValueString(),
+		Type:     data.Type.ValueString(),
+		Expected: []string{},
+		Exact:    true,
+	}
+	for _, rec := range rrset.Records {
+		if !rec.Disabled {
+			check.Expected = append(check.Expected, expectedServedValue(check.Type, rec.Content, rec.Priority))
+		}
+	}
+	return check
+}
+
+// checkRRSetAbsent errors when the RRSet to be created already has records
+// on the server; returns false when it added an error.
+func (r *RRSetResource) checkRRSetAbsent(ctx context.Context, data RRSetResourceModel, diags *diag.Diagnostics) bool {
+	zoneID := data.ZoneID.ValueInt64()
+	return checkRRSetAbsent(ctx, r.client, zoneID, data.Name.ValueString(), data.Type.ValueString(),
+		fmt.Sprintf("Import it to manage the existing records:\n\n  terraform import <address> %d/%s/%s\n\n"+
+			"or set overwrite = true to replace them.", zoneID, data.Name.ValueString(), data.Type.ValueString()),
+		diags)
+}
+
+// checkRRSetAbsent errors with hint when the RRSet at name and recordType
+// already has records on the server; returns false when it added an error.
+func checkRRSetAbsent(ctx context.Context, client *Client, zoneID int64, name, recordType, hint string, diags *diag.Diagnostics) bool {
+	existing, err := client.GetRRSet(ctx, zoneID, name, recordType)
+	if IsNotFoundError(err) {
+		return true
+	}
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to check for an existing RRSet, got error: %s", err))
+		return false
+	}
+	if len(existing.Records) == 0 {
+		return true
+	}
+	diags.AddError(
+		"RRSet Already Exists",
+		fmt.Sprintf("Zone %d already has a %s RRSet at %q with %d record(s); creating this resource would overwrite them. %s",
+			zoneID, recordType, name, len(existing.Records), hint),
+	)
+	return false
+}
+
 func (r *RRSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data RRSetResourceModel
 
@@ -209,8 +607,11 @@ func (r *RRSetResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	secret := data.unsealRecords()
+	ctx = maskContentLogs(ctx, data.SensitiveContent.ValueBool() || secret, rrsetContents(data.Records)...)
+
 	// Call API to read RRSet
-	rrset, err := r.client.GetRRSet(ctx, data.ZoneID.ValueInt64(), data.Name.ValueString(), data.Type.ValueString())
+	rrset, err := r.client.GetRRSetCached(ctx, data.ZoneID.ValueInt64(), data.Name.ValueString(), data.Type.ValueString())
 	if err != nil {
 		if IsNotFoundError(err) {
 			resp.State.RemoveResource(ctx)
@@ -220,11 +621,24 @@ func (r *RRSetResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	// Provider-side settings the API does not store; defaulted for state
+	// written by older provider versions
+	if data.Exclusive.IsNull() {
+		data.Exclusive = types.BoolValue(true)
+	}
+	for _, setting := range []*types.Bool{&data.Overwrite, &data.SensitiveContent, &data.IgnoreExternalDisable} {
+		if setting.IsNull() {
+			*setting = types.BoolValue(false)
+		}
+	}
+
 	// Update model from API response
-	data.TTL = types.Int64Value(rrset.TTL)
-	data.Records = normalizeRRSetRecords(data.Records, rrset.Records)
+	data.TTL = NewTTLValue(rrset.TTL)
+	data.ChangeDate = changeDateValue(rrsetChangeDate(rrset.Records))
+	data.Records = normalizeRRSetRecords(data.Records, r.managedRecords(data, rrset.Records), data.Type.ValueString())
 
 	// Save updated data into Terraform state
+	data.sealRecords(secret)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -238,12 +652,44 @@ func (r *RRSetResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	secret := data.unsealRecords()
+	ctx = maskContentLogs(ctx, data.SensitiveContent.ValueBool() || secret, rrsetContents(data.Records)...)
+
+	var prior RRSetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	prior.unsealRecords()
+	resolveRecordTTL(ctx, r.client, data.ZoneID.ValueInt64(), &data.TTL, &resp.Diagnostics)
+
+	// Records already written asked for their PTR then; only ask for new ones
+	planned := withoutExistingPTRRequests(data.Records, prior.Records)
+	var current []RRSetRecord
+	if !data.Exclusive.ValueBool() || data.IgnoreExternalDisable.ValueBool() {
+		var err error
+		current, err = r.currentRecords(ctx, data)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read RRSet before update, got error: %s", err))
+			return
+		}
+	}
+	current = r.dequotedRecords(data, current, append(slices.Clone(prior.Records), planned...))
+	if data.IgnoreExternalDisable.ValueBool() {
+		planned = keepExternalDisables(planned, prior.Records, current)
+	}
+	records := buildRRSetRecordsPayload(planned)
+	if !data.Exclusive.ValueBool() {
+		records = mergeRRSetRecords(current, prior.Records, planned)
+	}
+	r.quotePayload(data, records)
+
 	// Build API request
 	rrsetData := map[string]interface{}{
 		"name":    data.Name.ValueString(),
 		"type":    data.Type.ValueString(),
-		"ttl":     data.TTL.ValueInt64(),
-		"records": buildRRSetRecordsPayload(data.Records),
+		"ttl":     data.TTL.ValueSeconds(),
+		"records": records,
 	}
 
 	tflog.Debug(ctx, "Updating RRSet", map[string]interface{}{
@@ -253,23 +699,24 @@ func (r *RRSetResource) Update(ctx context.Context, req resource.UpdateRequest,
 	})
 
 	// Call API to update RRSet
-	err := r.client.UpdateRRSet(ctx, data.ZoneID.ValueInt64(), rrsetData)
+	written, err := r.client.UpdateRRSet(ctx, data.ZoneID.ValueInt64(), rrsetData)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update RRSet, got error: %s", err))
 		return
 	}
 
-	// Read back the RRSet to get the server's normalized values
-	// This ensures state matches what the API actually stored (normalized TTL, record ordering, etc.)
-	rrset, err := r.client.GetRRSet(ctx, data.ZoneID.ValueInt64(), data.Name.ValueString(), data.Type.ValueString())
+	// Map back the server's normalized values so state matches what the API
+	// actually stored (normalized TTL, record ordering, etc.)
+	rrset, err := r.storedRRSet(ctx, data, written)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read RRSet after update, got error: %s", err))
 		return
 	}
 
 	// Update model from API response
-	data.TTL = types.Int64Value(rrset.TTL)
-	data.Records = normalizeRRSetRecords(data.Records, rrset.Records)
+	data.TTL = NewTTLValue(rrset.TTL)
+	data.ChangeDate = changeDateValue(rrsetChangeDate(rrset.Records))
+	data.Records = normalizeRRSetRecords(data.Records, r.managedRecords(data, rrset.Records), data.Type.ValueString())
 
 	tflog.Trace(ctx, "Updated RRSet", map[string]interface{}{
 		"zone_id": data.ZoneID.ValueInt64(),
@@ -277,7 +724,10 @@ func (r *RRSetResource) Update(ctx context.Context, req resource.UpdateRequest,
 		"type":    data.Type.ValueString(),
 	})
 
+	awaitPropagation(ctx, r.client, data.WaitForPropagation, data.PropagationTimeout, rrsetPropagationCheck(data, rrset), &resp.Diagnostics)
+
 	// Save updated data into Terraform state
+	data.sealRecords(secret)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -291,12 +741,37 @@ func (r *RRSetResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	secret := data.unsealRecords()
+	ctx = maskContentLogs(ctx, data.SensitiveContent.ValueBool() || secret, rrsetContents(data.Records)...)
+
 	tflog.Debug(ctx, "Deleting RRSet", map[string]interface{}{
 		"zone_id": data.ZoneID.ValueInt64(),
 		"name":    data.Name.ValueString(),
 		"type":    data.Type.ValueString(),
 	})
 
+	// Shared RRSets keep the records other contributors wrote
+	if !data.Exclusive.ValueBool() {
+		current, err := r.currentRecords(ctx, data)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read RRSet before delete, got error: %s", err))
+			return
+		}
+		current = r.dequotedRecords(data, current, data.Records)
+		if remaining := mergeRRSetRecords(current, data.Records, nil); len(remaining) > 0 {
+			rrsetData := map[string]interface{}{
+				"name":    data.Name.ValueString(),
+				"type":    data.Type.ValueString(),
+				"ttl":     data.TTL.ValueSeconds(),
+				"records": remaining,
+			}
+			if _, err := r.client.UpdateRRSet(ctx, data.ZoneID.ValueInt64(), rrsetData); err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove records from RRSet, got error: %s", err))
+			}
+			return
+		}
+	}
+
 	// Call API to delete RRSet
 	err := r.client.DeleteRRSet(ctx, data.ZoneID.ValueInt64(), data.Name.ValueString(), data.Type.ValueString())
 	if err != nil {
@@ -320,6 +795,203 @@ func (r *RRSetResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	})
 }
 
+// currentRecords returns the RRSet's records on the server, or none when the
+// RRSet does not exist.
+func (r *RRSetResource) currentRecords(ctx context.Context, data RRSetResourceModel) ([]RRSetRecord, error) {
+	rrset, err := r.client.GetRRSet(ctx, data.ZoneID.ValueInt64(), data.Name.ValueString(), data.Type.ValueString())
+	if IsNotFoundError(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return rrset.Records, nil
+}
+
+// managedRecords narrows the API records to the ones this resource owns:
+// all of them when exclusive, otherwise those matching its own records. With
+// auto_quote_txt, quoted contents are reported in their unquoted form, and
+// with ignore_external_disable, records disabled outside Terraform are
+// reported as the model has them.
+func (r *RRSetResource) managedRecords(data RRSetResourceModel, fromAPI []RRSetRecord) []RRSetRecord {
+	fromAPI = r.dequotedRecords(data, fromAPI, data.Records)
+	if data.IgnoreExternalDisable.ValueBool() {
+		fromAPI = ignoreExternalDisables(data.Records, fromAPI)
+	}
+	if data.Exclusive.ValueBool() {
+		return fromAPI
+	}
+	owned := make([]RRSetRecord, 0, len(fromAPI))
+	for _, rec := range fromAPI {
+		if rrsetRecordListed(data.Records, rec) {
+			owned = append(owned, rec)
+		}
+	}
+	return owned
+}
+
+// dequotedRecords returns the API records with auto_quote_txt, or the
+// quoting of LUA snippets, applied in reverse: quoted contents whose unquoted
+// form is one of the models' contents are replaced by it, so they match their
+// configured records.
+func (r *RRSetResource) dequotedRecords(data RRSetResourceModel, fromAPI []RRSetRecord, models []RRSetRecordModel) []RRSetRecord {
+	normalize := normalizeAutoQuotedTXT
+	switch {
+	case isLUAType(data.Type.ValueString()):
+		normalize = normalizeQuotedLUA
+	case !autoQuoteTXT(r.client, data.AutoQuoteTXT, data.Type.ValueString()):
+		return fromAPI
+	}
+	records := make([]RRSetRecord, len(fromAPI))
+	copy(records, fromAPI)
+	for i, rec := range records {
+		for _, m := range models {
+			if content := normalize(m.Content.ValueString(), rec.Content); content != rec.Content {
+				records[i].Content = content
+				break
+			}
+		}
+	}
+	return records
+}
+
+// quotePayload quotes the unquoted contents of an RRSet request payload when
+// auto_quote_txt applies, and the snippets of LUA RRSets.
+func (r *RRSetResource) quotePayload(data RRSetResourceModel, records []map[string]interface{}) {
+	quote := quoteTXTContent
+	switch {
+	case isLUAType(data.Type.ValueString()):
+		quote = quoteLUAContent
+	case !autoQuoteTXT(r.client, data.AutoQuoteTXT, data.Type.ValueString()):
+		return
+	}
+	for _, rec := range records {
+		if content, ok := rec["content"].(string); ok {
+			rec["content"] = quote(content)
+		}
+	}
+}
+
+// ignoreExternalDisables returns the API records with disabled cleared on
+// those the models list as enabled, so a disable made outside Terraform is
+// not drift.
+func ignoreExternalDisables(models []RRSetRecordModel, fromAPI []RRSetRecord) []RRSetRecord {
+	records := make([]RRSetRecord, len(fromAPI))
+	copy(records, fromAPI)
+	for i, rec := range records {
+		if rec.Disabled && slices.ContainsFunc(models, func(m RRSetRecordModel) bool {
+			return !m.Disabled.ValueBool() && sameRRSetRecord(m, rec)
+		}) {
+			records[i].Disabled = false
+		}
+	}
+	return records
+}
+
+// keepExternalDisables marks planned records disabled when they are disabled
+// on the server but enabled in both the plan and prior state, so an update
+// leaves records disabled outside Terraform as they are. Records the
+// configuration re-enables are sent as planned.
+func keepExternalDisables(planned, prior []RRSetRecordModel, current []RRSetRecord) []RRSetRecordModel {
+	records := make([]RRSetRecordModel, len(planned))
+	copy(records, planned)
+	for i, rec := range records {
+		if rec.Disabled.ValueBool() {
+			continue
+		}
+		enabledBefore := slices.ContainsFunc(prior, func(p RRSetRecordModel) bool {
+			return !p.Disabled.ValueBool() && p.Content.ValueString() == rec.Content.ValueString() && p.Priority.ValueInt64() == rec.Priority.ValueInt64()
+		})
+		disabledNow := slices.ContainsFunc(current, func(c RRSetRecord) bool {
+			return c.Disabled && sameRRSetRecord(rec, c)
+		})
+		if enabledBefore && disabledNow {
+			records[i].Disabled = types.BoolValue(true)
+		}
+	}
+	return records
+}
+
+// mergeRRSetRecords builds the payload for a shared RRSet: current records
+// not listed in remove or add are kept as-is, then add is appended.
+func mergeRRSetRecords(current []RRSetRecord, remove, add []RRSetRecordModel) []map[string]interface{} {
+	records := make([]map[string]interface{}, 0, len(current)+len(add))
+	for _, rec := range current {
+		if rrsetRecordListed(remove, rec) || rrsetRecordListed(add, rec) {
+			continue
+		}
+		records = append(records, map[string]interface{}{
+			"content":  rec.Content,
+			"disabled": rec.Disabled,
+			"priority": rec.Priority,
+		})
+	}
+	return append(records, buildRRSetRecordsPayload(add)...)
+}
+
+// rrsetRecordListed reports whether rec is one of models, comparing content
+// (ignoring a trailing dot the backend may strip) and priority. Disabled is
+// left out so a record toggled elsewhere still counts as the same record.
+func rrsetRecordListed(models []RRSetRecordModel, rec RRSetRecord) bool {
+	return slices.ContainsFunc(models, func(m RRSetRecordModel) bool { return sameRRSetRecord(m, rec) })
+}
+
+// sameRRSetRecord reports whether m and rec are the same record by content,
+// ignoring a trailing dot, and priority.
+func sameRRSetRecord(m RRSetRecordModel, rec RRSetRecord) bool {
+	return strings.TrimSuffix(m.Content.ValueString(), ".") == strings.TrimSuffix(rec.Content, ".") &&
+		m.Priority.ValueInt64() == rec.Priority
+}
+
+// duplicateRRSetRecord returns the first record whose content and priority
+// repeat an earlier one, or nil. Set elements differing only in disabled or
+// create_ptr are distinct to Terraform but not to the API. Contents compare
+// without a trailing dot, which the API strips.
+func duplicateRRSetRecord(records []RRSetRecordModel) *RRSetRecordModel {
+	type key struct {
+		content  string
+		priority int64
+	}
+	seen := make(map[key]bool, len(records))
+	for i, rec := range records {
+		if rec.Content.IsUnknown() || rec.Content.IsNull() || rec.Priority.IsUnknown() {
+			continue
+		}
+		k := key{strings.TrimSuffix(rec.Content.ValueString(), "."), rec.Priority.ValueInt64()}
+		if seen[k] {
+			return &records[i]
+		}
+		seen[k] = true
+	}
+	return nil
+}
+
+// unsealRecords moves secret_records into records for the duration of an
+// operation and reports whether it did.
+func (m *RRSetResourceModel) unsealRecords() bool {
+	if m.SecretRecords == nil {
+		return false
+	}
+	m.Records, m.SecretRecords = m.SecretRecords, nil
+	return true
+}
+
+// sealRecords moves records back into secret_records after unsealRecords.
+func (m *RRSetResourceModel) sealRecords(secret bool) {
+	if secret {
+		m.SecretRecords, m.Records = m.Records, nil
+	}
+}
+
+// rrsetContents returns the content of each record model.
+func rrsetContents(models []RRSetRecordModel) []string {
+	contents := make([]string, len(models))
+	for i, rec := range models {
+		contents[i] = rec.Content.ValueString()
+	}
+	return contents
+}
+
 // buildRRSetRecordsPayload converts configured records to the API request
 // shape, defaulting disabled to false and priority to 0 when unset.
 func buildRRSetRecordsPayload(models []RRSetRecordModel) []map[string]interface{} {
@@ -338,70 +1010,188 @@ func buildRRSetRecordsPayload(models []RRSetRecordModel) []map[string]interface{
 			"disabled": disabled,
 			"priority": priority,
 		}
+		if rec.CreatePTR.ValueBool() {
+			records[i]["create_ptr"] = true
+		}
+	}
+	return records
+}
+
+// withoutExistingPTRRequests clears create_ptr on planned records that prior
+// state already holds with create_ptr set, so an update does not ask for
+// their reverse records again. Records newly added or newly opted in keep it.
+func withoutExistingPTRRequests(planned, prior []RRSetRecordModel) []RRSetRecordModel {
+	records := make([]RRSetRecordModel, len(planned))
+	copy(records, planned)
+	for i, rec := range records {
+		if !rec.CreatePTR.ValueBool() {
+			continue
+		}
+		for _, p := range prior {
+			if p.CreatePTR.ValueBool() && p.Content.ValueString() == rec.Content.ValueString() && p.Priority.ValueInt64() == rec.Priority.ValueInt64() {
+				records[i].CreatePTR = types.BoolValue(false)
+				break
+			}
+		}
 	}
 	return records
 }
 
 // normalizeRRSetRecords maps API records to models, preserving the configured
 // content spelling when it differs only by a trailing dot (PowerDNS backends
-// may strip them). Priority and disabled must also agree so records that
+// may strip them) or is the same A/AAAA address, and create_ptr, which the
+// API does not return. Priority and disabled must also agree so records that
 // collide on stripped content are paired with the right set element.
-func normalizeRRSetRecords(configured []RRSetRecordModel, fromAPI []RRSetRecord) []RRSetRecordModel {
+func normalizeRRSetRecords(configured []RRSetRecordModel, fromAPI []RRSetRecord, recordType string) []RRSetRecordModel {
 	remaining := make([]RRSetRecordModel, len(configured))
 	copy(remaining, configured)
 	records := make([]RRSetRecordModel, len(fromAPI))
 	for i, rec := range fromAPI {
 		content := rec.Content
+		createPTR := false
 		for j, c := range remaining {
 			cc := c.Content.ValueString()
-			sameContent := cc == rec.Content || (cc != "" && strings.TrimSuffix(cc, ".") == rec.Content)
+			sameContent := cc == rec.Content || sameRecordContent(cc, rec.Content, recordType)
 			if sameContent && c.Priority.ValueInt64() == rec.Priority && c.Disabled.ValueBool() == rec.Disabled {
 				content = cc
+				createPTR = c.CreatePTR.ValueBool()
 				remaining = append(remaining[:j], remaining[j+1:]...)
 				break
 			}
 		}
 		records[i] = RRSetRecordModel{
-			Content:  types.StringValue(content),
-			Disabled: types.BoolValue(rec.Disabled),
-			Priority: types.Int64Value(rec.Priority),
+			Content:   types.StringValue(content),
+			Disabled:  types.BoolValue(rec.Disabled),
+			Priority:  types.Int64Value(rec.Priority),
+			CreatePTR: types.BoolValue(createPTR),
 		}
 	}
 	return records
 }
 
 func (r *RRSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import format: zone_id/name/type
+	// Import format: zone/name/type, where zone is the zone ID or name
 	// Example: terraform import poweradmin_rrset.www 123/www/A
 	tflog.Debug(ctx, "Importing RRSet", map[string]interface{}{
 		"import_id": req.ID,
 	})
 
-	// Parse the import ID - format: zone_id/name/type
-	parts := strings.SplitN(req.ID, "/", 3)
-	if len(parts) != 3 {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Import ID must be in format 'zone_id/name/type', got: %s", req.ID),
-		)
+	zoneID, name, recordType, err := resolveRRSetImportID(ctx, r.client, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
 		return
 	}
 
-	zoneID, err := strconv.ParseInt(parts[0], 10, 64)
-	if err != nil {
+	// Set the parsed values in state. Provider-side settings get their
+	// defaults, so config generated from the import plans no changes.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%d/%s/%s", zoneID, name, recordType))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone_id"), zoneID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), recordType)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("overwrite"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("exclusive"), true)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sensitive_content"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ignore_external_disable"), false)...)
+}
+
+// MoveState moves a poweradmin_record into a poweradmin_rrset with a `moved`
+// block, without touching the DNS entries. The record becomes the RRSet's
+// only record in state and the next refresh merges in the other records of
+// the RRSet on the server, so the configuration should list them all.
+func (r *RRSetResource) MoveState(ctx context.Context) []resource.StateMover {
+	var schemaResp resource.SchemaResponse
+	NewRecordResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	return []resource.StateMover{
+		{
+			SourceSchema: &schemaResp.Schema,
+			StateMover:   moveRecordToRRSet,
+		},
+	}
+}
+
+// moveRecordToRRSet converts poweradmin_record state into poweradmin_rrset
+// state and skips any other source resource.
+func moveRecordToRRSet(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	if req.SourceTypeName != "poweradmin_record" {
+		return
+	}
+	if req.SourceState == nil {
 		resp.Diagnostics.AddError(
-			"Invalid Zone ID",
-			fmt.Sprintf("Zone ID must be a valid integer, got: %s", parts[0]),
+			"Unable to Move Record State",
+			"The poweradmin_record state could not be read. Upgrade the provider and refresh the record before moving it.",
 		)
 		return
 	}
 
-	name := parts[1]
-	recordType := parts[2]
+	var record RecordResourceModel
+	resp.Diagnostics.Append(req.SourceState.Get(ctx, &record)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, rrsetFromRecord(record))...)
+}
 
-	// Set the parsed values in state
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone_id"), zoneID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), recordType)...)
+// rrsetFromRecord builds the state of an RRSet holding only record. The name
+// is kept as written, so the RRSet configuration can use the same form
+// without a replacement, and secret_content becomes secret_records.
+func rrsetFromRecord(record RecordResourceModel) RRSetResourceModel {
+	secret := record.unsealContent()
+	recordType := strings.ToUpper(record.Type.ValueString())
+	rrset := RRSetResourceModel{
+		ID:     types.StringValue(fmt.Sprintf("%d/%s/%s", record.ZoneID.ValueInt64(), record.Name.ValueString(), recordType)),
+		ZoneID: record.ZoneID,
+		Name:   record.Name,
+		Type:   types.StringValue(recordType),
+		TTL:    record.TTL,
+		Records: []RRSetRecordModel{
+			{
+				Content:   record.Content,
+				Disabled:  types.BoolValue(record.Disabled.ValueBool()),
+				Priority:  types.Int64Value(record.Priority.ValueInt64()),
+				CreatePTR: types.BoolValue(record.CreatePTR.ValueBool()),
+			},
+		},
+		IPAddress:             record.IPAddress,
+		Overwrite:             types.BoolValue(false),
+		Exclusive:             types.BoolValue(true),
+		SensitiveContent:      types.BoolValue(record.SensitiveContent.ValueBool()),
+		IgnoreExternalDisable: types.BoolValue(record.IgnoreExternalDisable.ValueBool()),
+		AutoQuoteTXT:          record.AutoQuoteTXT,
+		WaitForPropagation:    record.WaitForPropagation,
+		PropagationTimeout:    record.PropagationTimeout,
+		ChangeDate:            record.ChangeDate,
+	}
+	rrset.sealRecords(secret)
+	return rrset
+}
+
+// resolveRRSetImportID parses a "zone/name/type" import ID. The zone may be
+// given by ID or name, and the name relative, "@" or fully qualified, as in
+// the import_id of the poweradmin_rrsets data source. Names are returned
+// relative to the zone in lower case and types in upper case, the way
+// configurations usually write them.
+func resolveRRSetImportID(ctx context.Context, client *Client, id string) (int64, string, string, error) {
+	parts := strings.SplitN(id, "/", 3)
+	if len(parts) >= 2 && parts[1] == "*" && (len(parts) == 2 || parts[2] == "" || parts[2] == "*") {
+		return 0, "", "", fmt.Errorf("an import ID names a single RRSet, so '%s' cannot import a whole zone. "+
+			"Use the import_blocks attribute of the poweradmin_rrsets data source to get an import block for every RRSet of the zone instead", id)
+	}
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return 0, "", "", fmt.Errorf("import ID must be in format 'zone_id/name/type' or 'zone_name/name/type', got: %s", id)
+	}
+
+	var zoneName string
+	zoneID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		zone, err := client.FindZoneByName(ctx, parts[0])
+		if err != nil {
+			return 0, "", "", fmt.Errorf("could not find zone '%s': %w", parts[0], err)
+		}
+		zoneID, zoneName = int64(zone.ID), zone.Name
+	} else if zoneName, err = client.GetZoneName(ctx, zoneID); err != nil {
+		return 0, "", "", fmt.Errorf("could not read zone %d: %w", zoneID, err)
+	}
+
+	return zoneID, canonicalOwnerName(parts[1], zoneName), strings.ToUpper(parts[2]), nil
 }
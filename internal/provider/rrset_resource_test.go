@@ -4,11 +4,21 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestNormalizeRRSetRecords(t *testing.T) {
@@ -22,7 +32,7 @@ func TestNormalizeRRSetRecords(t *testing.T) {
 		{Content: "mail1.example.com", Disabled: false, Priority: 10},
 	}
 
-	got := normalizeRRSetRecords(configured, fromAPI)
+	got := normalizeRRSetRecords(configured, fromAPI, "MX")
 
 	if len(got) != 2 {
 		t.Fatalf("expected 2 records, got %d", len(got))
@@ -49,7 +59,7 @@ func TestNormalizeRRSetRecords_ContentCollision(t *testing.T) {
 		{Content: "mail.example.com", Disabled: false, Priority: 10},
 	}
 
-	got := normalizeRRSetRecords(configured, fromAPI)
+	got := normalizeRRSetRecords(configured, fromAPI, "MX")
 
 	if len(got) != 2 {
 		t.Fatalf("expected 2 records, got %d", len(got))
@@ -70,13 +80,527 @@ func TestNormalizeRRSetRecords_ExternalChangeSurfaces(t *testing.T) {
 		{Content: "new.example.com"},
 	}
 
-	got := normalizeRRSetRecords(configured, fromAPI)
+	got := normalizeRRSetRecords(configured, fromAPI, "CNAME")
 
 	if got[0].Content.ValueString() != "new.example.com" {
 		t.Errorf("expected external change to surface, got %q", got[0].Content.ValueString())
 	}
 }
 
+func TestNormalizeRRSetRecords_IPv6Spelling(t *testing.T) {
+	configured := []RRSetRecordModel{
+		{Content: types.StringValue("2001:db8:0:0::1")},
+		{Content: types.StringValue("2001:DB8::2")},
+	}
+	fromAPI := []RRSetRecord{
+		{Content: "2001:db8::2"},
+		{Content: "2001:db8::1"},
+		{Content: "2001:db8::3"},
+	}
+
+	got := normalizeRRSetRecords(configured, fromAPI, "AAAA")
+
+	want := []string{"2001:DB8::2", "2001:db8:0:0::1", "2001:db8::3"}
+	for i, rec := range got {
+		if rec.Content.ValueString() != want[i] {
+			t.Errorf("record %d: expected %q, got %q", i, want[i], rec.Content.ValueString())
+		}
+	}
+}
+
+func TestNormalizeRRSetRecords_KeepsCreatePTR(t *testing.T) {
+	configured := []RRSetRecordModel{
+		{Content: types.StringValue("192.0.2.1"), Disabled: types.BoolValue(false), Priority: types.Int64Value(0), CreatePTR: types.BoolValue(true)},
+		{Content: types.StringValue("192.0.2.2"), Disabled: types.BoolValue(false), Priority: types.Int64Value(0), CreatePTR: types.BoolValue(false)},
+	}
+	fromAPI := []RRSetRecord{
+		{Content: "192.0.2.2"},
+		{Content: "192.0.2.1"},
+		{Content: "192.0.2.3"},
+	}
+
+	got := normalizeRRSetRecords(configured, fromAPI, "A")
+
+	for _, rec := range got {
+		want := rec.Content.ValueString() == "192.0.2.1"
+		if rec.CreatePTR.IsNull() || rec.CreatePTR.ValueBool() != want {
+			t.Errorf("record %s: expected create_ptr %v, got %v", rec.Content.ValueString(), want, rec.CreatePTR)
+		}
+	}
+}
+
+func TestBuildRRSetRecordsPayload_CreatePTR(t *testing.T) {
+	prior := []RRSetRecordModel{
+		{Content: types.StringValue("192.0.2.1"), Priority: types.Int64Value(0), CreatePTR: types.BoolValue(true)},
+		{Content: types.StringValue("192.0.2.2"), Priority: types.Int64Value(0), CreatePTR: types.BoolValue(false)},
+	}
+	planned := []RRSetRecordModel{
+		{Content: types.StringValue("192.0.2.1"), Priority: types.Int64Value(0), CreatePTR: types.BoolValue(true)},
+		{Content: types.StringValue("192.0.2.2"), Priority: types.Int64Value(0), CreatePTR: types.BoolValue(true)},
+		{Content: types.StringValue("192.0.2.3"), Priority: types.Int64Value(0), CreatePTR: types.BoolValue(true)},
+		{Content: types.StringValue("192.0.2.4"), Priority: types.Int64Value(0)},
+	}
+
+	got := buildRRSetRecordsPayload(withoutExistingPTRRequests(planned, prior))
+
+	want := map[string]bool{"192.0.2.1": false, "192.0.2.2": true, "192.0.2.3": true, "192.0.2.4": false}
+	for _, rec := range got {
+		content := rec["content"].(string)
+		_, requested := rec["create_ptr"]
+		if requested != want[content] {
+			t.Errorf("record %s: expected create_ptr requested = %v, got %v", content, want[content], requested)
+		}
+	}
+	if !planned[0].CreatePTR.ValueBool() {
+		t.Error("expected planned records to be left untouched")
+	}
+}
+
+func TestDuplicateRRSetRecord(t *testing.T) {
+	tests := []struct {
+		name    string
+		records []RRSetRecordModel
+		want    string
+	}{
+		{
+			name: "distinct priorities",
+			records: []RRSetRecordModel{
+				{Content: types.StringValue("mail.example.com"), Priority: types.Int64Value(10)},
+				{Content: types.StringValue("mail.example.com"), Priority: types.Int64Value(20)},
+			},
+		},
+		{
+			name: "differs only in disabled",
+			records: []RRSetRecordModel{
+				{Content: types.StringValue("192.0.2.1"), Disabled: types.BoolValue(false)},
+				{Content: types.StringValue("192.0.2.1"), Disabled: types.BoolValue(true)},
+			},
+			want: "192.0.2.1",
+		},
+		{
+			name: "trailing dot",
+			records: []RRSetRecordModel{
+				{Content: types.StringValue("mail.example.com"), Priority: types.Int64Value(10)},
+				{Content: types.StringValue("mail.example.com."), Priority: types.Int64Value(10)},
+			},
+			want: "mail.example.com.",
+		},
+		{
+			name: "unknown content",
+			records: []RRSetRecordModel{
+				{Content: types.StringUnknown()},
+				{Content: types.StringUnknown()},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := duplicateRRSetRecord(tt.records)
+			if tt.want == "" {
+				if got != nil {
+					t.Errorf("expected no duplicate, got %q", got.Content.ValueString())
+				}
+				return
+			}
+			if got == nil || got.Content.ValueString() != tt.want {
+				t.Errorf("expected duplicate %q, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestWarnRRSetSize(t *testing.T) {
+	records := func(contents ...string) []RRSetRecordModel {
+		models := make([]RRSetRecordModel, len(contents))
+		for i, content := range contents {
+			models[i] = RRSetRecordModel{Content: types.StringValue(content)}
+		}
+		return models
+	}
+	manyA := make([]string, 101)
+	for i := range manyA {
+		manyA[i] = fmt.Sprintf("192.0.2.%d", i)
+	}
+	longString := strings.Repeat("a", 300)
+	bigRecord := strings.Repeat(`"`+strings.Repeat("b", 250)+`" `, 270)
+
+	tests := []struct {
+		name       string
+		recordType string
+		records    []RRSetRecordModel
+		threshold  int
+		want       []string
+	}{
+		{"small", "A", records("192.0.2.1", "192.0.2.2"), 100, nil},
+		{"over threshold", "A", records(manyA...), 100, []string{"Unusually Large RRSet"}},
+		{"threshold disabled", "A", records(manyA...), 0, nil},
+		{"long TXT string", "TXT", records(longString), 100, []string{"TXT String Too Long"}},
+		{"split TXT string", "TXT", records(`"` + longString[:200] + `" "` + longString[200:] + `"`), 100, nil},
+		{"long string in non-TXT", "CNAME", records(longString), 100, nil},
+		{"oversized TXT record", "TXT", records(bigRecord), 100, []string{"TXT Record Too Large", "TXT RRSet Too Large"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			warnRRSetSize(tt.recordType, tt.records, path.Root("records"), tt.threshold, &diags)
+			if diags.HasError() {
+				t.Fatalf("expected only warnings, got %v", diags)
+			}
+			var got []string
+			for _, d := range diags {
+				got = append(got, d.Summary())
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("expected warnings %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCheckRRSetAbsent(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+		want    bool
+	}{
+		{"missing rrset", func(w http.ResponseWriter, r *http.Request) {
+			respondError(t, w, http.StatusNotFound, "RRSet not found")
+		}, true},
+		{"empty rrset", func(w http.ResponseWriter, r *http.Request) {
+			respondJSON(t, w, RRSetResponse{RRSet: RRSet{Name: "www", Type: "A"}})
+		}, true},
+		{"existing rrset", func(w http.ResponseWriter, r *http.Request) {
+			respondJSON(t, w, RRSetResponse{RRSet: RRSet{Name: "www", Type: "A", Records: []RRSetRecord{{Content: "192.0.2.1"}}}})
+		}, false},
+		{"lookup failure", func(w http.ResponseWriter, r *http.Request) {
+			respondError(t, w, http.StatusInternalServerError, "boom")
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &RRSetResource{client: newTestClient(t, tt.handler)}
+			data := RRSetResourceModel{
+				ZoneID: types.Int64Value(1),
+				Name:   NewDNSNameValue("www"),
+				Type:   types.StringValue("A"),
+			}
+
+			var diags diag.Diagnostics
+			if got := r.checkRRSetAbsent(context.Background(), data, &diags); got != tt.want {
+				t.Errorf("checkRRSetAbsent() = %v, want %v", got, tt.want)
+			}
+			if diags.HasError() == tt.want {
+				t.Errorf("unexpected diagnostics: %v", diags)
+			}
+		})
+	}
+}
+
+func TestMergeRRSetRecords(t *testing.T) {
+	current := []RRSetRecord{
+		{Content: "v=spf1 -all"},
+		{Content: "google-site-verification=abc"},
+		{Content: "old-token"},
+	}
+	prior := []RRSetRecordModel{
+		{Content: types.StringValue("old-token"), Priority: types.Int64Value(0)},
+	}
+	planned := []RRSetRecordModel{
+		{Content: types.StringValue("v=spf1 include:_spf.example.com -all"), Priority: types.Int64Value(0)},
+		{Content: types.StringValue("google-site-verification=abc"), Priority: types.Int64Value(0)},
+	}
+
+	got := mergeRRSetRecords(current, prior, planned)
+
+	var contents []string
+	for _, rec := range got {
+		contents = append(contents, rec["content"].(string))
+	}
+	want := []string{"v=spf1 -all", "v=spf1 include:_spf.example.com -all", "google-site-verification=abc"}
+	if fmt.Sprint(contents) != fmt.Sprint(want) {
+		t.Errorf("mergeRRSetRecords() contents = %v, want %v", contents, want)
+	}
+}
+
+func TestIgnoreExternalDisables(t *testing.T) {
+	models := []RRSetRecordModel{
+		{Content: types.StringValue("192.0.2.1"), Disabled: types.BoolValue(false), Priority: types.Int64Value(0)},
+		{Content: types.StringValue("192.0.2.2"), Disabled: types.BoolValue(true), Priority: types.Int64Value(0)},
+	}
+	fromAPI := []RRSetRecord{
+		{Content: "192.0.2.1", Disabled: true},
+		{Content: "192.0.2.2", Disabled: true},
+		{Content: "192.0.2.3", Disabled: true},
+	}
+
+	got := ignoreExternalDisables(models, fromAPI)
+
+	want := []bool{false, true, true}
+	for i, rec := range got {
+		if rec.Disabled != want[i] {
+			t.Errorf("record %s: disabled = %v, want %v", rec.Content, rec.Disabled, want[i])
+		}
+	}
+	if !fromAPI[0].Disabled {
+		t.Error("expected the API records to be left unmodified")
+	}
+}
+
+func TestKeepExternalDisables(t *testing.T) {
+	record := func(content string, disabled bool) RRSetRecordModel {
+		return RRSetRecordModel{Content: types.StringValue(content), Disabled: types.BoolValue(disabled), Priority: types.Int64Value(0)}
+	}
+	prior := []RRSetRecordModel{record("192.0.2.1", false), record("192.0.2.2", true)}
+	planned := []RRSetRecordModel{record("192.0.2.1", false), record("192.0.2.2", false), record("192.0.2.3", false)}
+	current := []RRSetRecord{
+		{Content: "192.0.2.1", Disabled: true},
+		{Content: "192.0.2.2", Disabled: true},
+	}
+
+	got := keepExternalDisables(planned, prior, current)
+
+	// Only the record disabled outside Terraform stays disabled; 192.0.2.2 is
+	// re-enabled by the configuration and 192.0.2.3 is new
+	want := []bool{true, false, false}
+	for i, rec := range got {
+		if rec.Disabled.ValueBool() != want[i] {
+			t.Errorf("record %s: disabled = %v, want %v", rec.Content.ValueString(), rec.Disabled.ValueBool(), want[i])
+		}
+	}
+	if planned[0].Disabled.ValueBool() {
+		t.Error("expected the planned records to be left unmodified")
+	}
+}
+
+func TestRRSetAutoQuoteTXT(t *testing.T) {
+	r := &RRSetResource{client: &Client{AutoQuoteTXT: true}}
+	data := RRSetResourceModel{
+		Type:      types.StringValue("TXT"),
+		Exclusive: types.BoolValue(false),
+		Records: []RRSetRecordModel{
+			{Content: types.StringValue("v=spf1 -all"), Priority: types.Int64Value(0)},
+		},
+	}
+	fromAPI := []RRSetRecord{
+		{Content: `"v=spf1 -all"`},
+		{Content: `"google-site-verification=abc"`},
+	}
+
+	// Shared RRSets find their own records despite the quotes
+	got := r.managedRecords(data, fromAPI)
+	if len(got) != 1 || got[0].Content != "v=spf1 -all" {
+		t.Errorf("managedRecords() = %v, want the unquoted SPF record only", got)
+	}
+
+	payload := buildRRSetRecordsPayload(data.Records)
+	r.quotePayload(data, payload)
+	if payload[0]["content"] != `"v=spf1 -all"` {
+		t.Errorf("expected quoted payload content, got %v", payload[0]["content"])
+	}
+
+	// The resource setting overrides the provider's
+	data.AutoQuoteTXT = types.BoolValue(false)
+	payload = buildRRSetRecordsPayload(data.Records)
+	r.quotePayload(data, payload)
+	if payload[0]["content"] != "v=spf1 -all" {
+		t.Errorf("expected unquoted payload content, got %v", payload[0]["content"])
+	}
+}
+
+func TestManagedRecords(t *testing.T) {
+	r := &RRSetResource{}
+	fromAPI := []RRSetRecord{
+		{Content: "mail.example.com", Priority: 10},
+		{Content: "backup.example.com", Priority: 20},
+	}
+	data := RRSetResourceModel{
+		Exclusive: types.BoolValue(false),
+		Records: []RRSetRecordModel{
+			{Content: types.StringValue("mail.example.com."), Priority: types.Int64Value(10)},
+		},
+	}
+
+	if got := r.managedRecords(data, fromAPI); len(got) != 1 || got[0].Content != "mail.example.com" {
+		t.Errorf("non-exclusive managedRecords() = %v, want only mail.example.com", got)
+	}
+
+	data.Exclusive = types.BoolValue(true)
+	if got := r.managedRecords(data, fromAPI); len(got) != 2 {
+		t.Errorf("exclusive managedRecords() = %v, want all records", got)
+	}
+}
+
+func TestResolveRRSetImportID(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/zones":
+			respondJSON(t, w, ZoneListResponse{Zones: []Zone{{ID: 3, Name: "example.com"}}})
+		case "/api/v2/zones/3":
+			respondJSON(t, w, ZoneResponse{Zone: Zone{ID: 3, Name: "example.com"}})
+		default:
+			respondError(t, w, http.StatusNotFound, "Zone not found")
+		}
+	})
+
+	tests := []struct {
+		id       string
+		wantZone int64
+		wantName string
+		wantType string
+		wantErr  bool
+	}{
+		{"3/www/A", 3, "www", "A", false},
+		{"example.com/www.example.com./mx", 3, "www", "MX", false},
+		{"example.com/example.com/NS", 3, "@", "NS", false},
+		{"3/@/SOA", 3, "@", "SOA", false},
+		{"missing.example/www/A", 0, "", "", true},
+		{"9/www/A", 0, "", "", true},
+		{"3/www", 0, "", "", true},
+		{"3//A", 0, "", "", true},
+		{"3/*", 0, "", "", true},
+		{"3/*/A", 3, "*", "A", false},
+	}
+	for _, tt := range tests {
+		zoneID, name, recordType, err := resolveRRSetImportID(context.Background(), client, tt.id)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("resolveRRSetImportID(%q) error = %v, wantErr %v", tt.id, err, tt.wantErr)
+			continue
+		}
+		if zoneID != tt.wantZone || name != tt.wantName || recordType != tt.wantType {
+			t.Errorf("resolveRRSetImportID(%q) = %d, %q, %q, want %d, %q, %q", tt.id, zoneID, name, recordType, tt.wantZone, tt.wantName, tt.wantType)
+		}
+	}
+}
+
+func TestRRSetChangeDate(t *testing.T) {
+	records := []RRSetRecord{
+		{Content: "192.0.2.1", ChangeDate: 1700000000},
+		{Content: "192.0.2.2", ChangeDate: 1700000500},
+		{Content: "192.0.2.3"},
+	}
+	if got := changeDateValue(rrsetChangeDate(records)).ValueString(); got != "2023-11-14T22:21:40Z" {
+		t.Errorf("expected the latest change, got %q", got)
+	}
+	if got := changeDateValue(rrsetChangeDate(records[2:])); !got.IsNull() {
+		t.Errorf("expected null without change dates, got %s", got)
+	}
+}
+
+func TestMoveRecordToRRSet(t *testing.T) {
+	ctx := context.Background()
+	mover := (&RRSetResource{}).MoveState(ctx)[0]
+	var targetSchema fwresource.SchemaResponse
+	(&RRSetResource{}).Schema(ctx, fwresource.SchemaRequest{}, &targetSchema)
+
+	source := tfsdk.State{Schema: *mover.SourceSchema, Raw: tftypes.NewValue(mover.SourceSchema.Type().TerraformType(ctx), nil)}
+	diags := source.Set(ctx, &RecordResourceModel{
+		ID:                 types.StringValue("42"),
+		ZoneID:             types.Int64Value(7),
+		Name:               NewDNSNameValue("mail"),
+		Type:               types.StringValue("mx"),
+		Content:            types.StringValue("mx1.example.com."),
+		TTL:                NewTTLValue(300),
+		Priority:           types.Int64Value(10),
+		Disabled:           types.BoolValue(false),
+		CreatePTR:          types.BoolValue(false),
+		SensitiveContent:   types.BoolValue(true),
+		WaitForPropagation: types.BoolValue(true),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	newResponse := func() *fwresource.MoveStateResponse {
+		return &fwresource.MoveStateResponse{
+			TargetState: tfsdk.State{Schema: targetSchema.Schema, Raw: tftypes.NewValue(targetSchema.Schema.Type().TerraformType(ctx), nil)},
+		}
+	}
+
+	// Other source resources are skipped
+	resp := newResponse()
+	mover.StateMover(ctx, fwresource.MoveStateRequest{SourceTypeName: "poweradmin_zone", SourceState: &source}, resp)
+	if resp.Diagnostics.HasError() || !resp.TargetState.Raw.IsNull() {
+		t.Fatalf("expected poweradmin_zone to be skipped, got %v", resp.Diagnostics)
+	}
+
+	resp = newResponse()
+	mover.StateMover(ctx, fwresource.MoveStateRequest{SourceTypeName: "poweradmin_record", SourceState: &source}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	var got RRSetResourceModel
+	resp.Diagnostics.Append(resp.TargetState.Get(ctx, &got)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if got.ID.ValueString() != "7/mail/MX" || got.Name.ValueString() != "mail" || got.Type.ValueString() != "MX" || got.TTL.ValueSeconds() != 300 {
+		t.Errorf("unexpected RRSet %s: name %q, type %q, ttl %d", got.ID, got.Name.ValueString(), got.Type.ValueString(), got.TTL.ValueSeconds())
+	}
+	if len(got.Records) != 1 || got.Records[0].Content.ValueString() != "mx1.example.com." || got.Records[0].Priority.ValueInt64() != 10 {
+		t.Errorf("unexpected records %v", got.Records)
+	}
+	if !got.Exclusive.ValueBool() || got.Overwrite.ValueBool() || !got.SensitiveContent.ValueBool() || !got.WaitForPropagation.ValueBool() {
+		t.Errorf("settings not carried over: %+v", got)
+	}
+}
+
+func TestRRSetFromRecord_SecretContent(t *testing.T) {
+	got := rrsetFromRecord(RecordResourceModel{
+		ZoneID:        types.Int64Value(7),
+		Name:          NewDNSNameValue("_acme-challenge"),
+		Type:          types.StringValue("TXT"),
+		SecretContent: types.StringValue(`"token"`),
+		TTL:           NewTTLValue(60),
+	})
+	if got.Records != nil || len(got.SecretRecords) != 1 || got.SecretRecords[0].Content.ValueString() != `"token"` {
+		t.Errorf("expected the content in secret_records only, got records %v, secret_records %v", got.Records, got.SecretRecords)
+	}
+}
+
+func TestAccRRSetResource_MoveFromRecord(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordResourceConfig("test-rrset-move-acc.example.com", "www", "A", "192.0.2.1", 3600),
+			},
+			{
+				Config: testAccProviderConfig() + `
+resource "poweradmin_zone" "test" {
+  name = "test-rrset-move-acc.example.com"
+  type = "MASTER"
+}
+
+moved {
+  from = poweradmin_record.test
+  to   = poweradmin_rrset.test
+}
+
+resource "poweradmin_rrset" "test" {
+  zone_id = poweradmin_zone.test.id
+  name    = "www"
+  type    = "A"
+  ttl     = 3600
+
+  records = [
+    { content = "192.0.2.1" },
+  ]
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("poweradmin_rrset.test", plancheck.ResourceActionNoop),
+					},
+				},
+			},
+		},
+	})
+}
+
 func TestAccRRSetResource(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -96,6 +620,14 @@ func TestAccRRSetResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Import block by zone name and FQDN, generating the configuration
+			{
+				ResourceName:    "poweradmin_rrset.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithID,
+				ImportStateId:   "test-rrset-acc.example.com/www.test-rrset-acc.example.com/a",
+				GenerateConfig:  true,
+			},
 			{
 				Config: testAccRRSetResourceConfig("test-rrset-acc.example.com", "www", "A", 7200, "192.0.2.1"),
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -6,7 +6,10 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -28,17 +31,25 @@ type RRSetsDataSource struct {
 
 // RRSetsDataSourceModel describes the data source data model.
 type RRSetsDataSourceModel struct {
-	ZoneID types.Int64      `tfsdk:"zone_id"`
-	Type   types.String     `tfsdk:"type"`
-	RRSets []RRSetDataModel `tfsdk:"rrsets"`
+	ZoneID    types.Int64      `tfsdk:"zone_id"`
+	Type      types.String     `tfsdk:"type"`
+	Name      types.String     `tfsdk:"name"`
+	NameRegex types.String     `tfsdk:"name_regex"`
+	Page      types.Int64      `tfsdk:"page"`
+	PerPage   types.Int64      `tfsdk:"per_page"`
+	MaxItems  types.Int64      `tfsdk:"max_items"`
+	RRSets    []RRSetDataModel `tfsdk:"rrsets"`
+
+	ImportBlocks types.String `tfsdk:"import_blocks"`
 }
 
 // RRSetDataModel describes an individual RRSet in the data source.
 type RRSetDataModel struct {
-	Name    types.String           `tfsdk:"name"`
-	Type    types.String           `tfsdk:"type"`
-	TTL     types.Int64            `tfsdk:"ttl"`
-	Records []RRSetRecordDataModel `tfsdk:"records"`
+	Name     types.String           `tfsdk:"name"`
+	Type     types.String           `tfsdk:"type"`
+	TTL      types.Int64            `tfsdk:"ttl"`
+	Records  []RRSetRecordDataModel `tfsdk:"records"`
+	ImportID types.String           `tfsdk:"import_id"`
 }
 
 // RRSetRecordDataModel describes a record in an RRSet.
@@ -48,13 +59,27 @@ type RRSetRecordDataModel struct {
 	Priority types.Int64  `tfsdk:"priority"`
 }
 
+// rrsetDataObjectType is the object type of RRSetDataModel, for RRSets held
+// in a types.List.
+var rrsetDataObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"name":      types.StringType,
+	"type":      types.StringType,
+	"ttl":       types.Int64Type,
+	"import_id": types.StringType,
+	"records": types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{
+		"content":  types.StringType,
+		"disabled": types.BoolType,
+		"priority": types.Int64Type,
+	}}},
+}}
+
 func (d *RRSetsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_rrsets"
 }
 
 func (d *RRSetsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Retrieves all Resource Record Sets (RRSets) from a zone. RRSets represent DNS-correct grouping of records with the same name and type.",
+		MarkdownDescription: "Retrieves the Resource Record Sets (RRSets) of a zone, optionally filtered by type and name. RRSets represent DNS-correct grouping of records with the same name and type.",
 
 		Attributes: map[string]schema.Attribute{
 			"zone_id": schema.Int64Attribute{
@@ -65,42 +90,80 @@ func (d *RRSetsDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 				MarkdownDescription: "Optional filter by record type (e.g., 'A', 'AAAA', 'MX')",
 				Optional:            true,
 			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Optional filter by record name, relative to the zone (`www`, `@` for the apex) or fully qualified. " +
+					"Sent to the API so large zones are filtered server-side where supported.",
+				Optional: true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Optional [RE2](https://github.com/google/re2/wiki/Syntax) regular expression the record name, as the API returns it, must match " +
+					"(e.g. `^_acme-challenge\\.`). Applied by the provider after `type` and `name`.",
+				Optional: true,
+			},
+			"page": schema.Int64Attribute{
+				MarkdownDescription: "Fetch only this page (starting at 1) of the API listing instead of every page. Use with `per_page` to process very large zones in slices.",
+				Optional:            true,
+			},
+			"per_page": schema.Int64Attribute{
+				MarkdownDescription: "Number of RRSets requested per API page. Defaults to the server's page size.",
+				Optional:            true,
+			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: "Stop after this many matching RRSets, without fetching further pages. By default every matching RRSet is returned.",
+				Optional:            true,
+			},
 			"rrsets": schema.ListNestedAttribute{
 				MarkdownDescription: "List of RRSets in the zone",
 				Computed:            true,
+				NestedObject:        rrsetDataNestedObject(),
+			},
+			"import_blocks": schema.StringAttribute{
+				MarkdownDescription: "Terraform `import` blocks adopting every returned RRSet except the SOA as a `poweradmin_rrset` resource, " +
+					"named after the record name and type (`poweradmin_rrset.www_a`, `poweradmin_rrset.apex_mx`). " +
+					"Write them to a file with `terraform output -raw` and run `terraform plan -generate-config-out=...` to generate the resource blocks for a whole zone.",
+				Computed: true,
+			},
+		},
+	}
+}
+
+// rrsetDataNestedObject is the schema of one RRSet in the rrsets attribute of
+// the poweradmin_rrsets and poweradmin_zone data sources.
+func rrsetDataNestedObject() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The record name",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The record type",
+				Computed:            true,
+			},
+			"ttl": schema.Int64Attribute{
+				MarkdownDescription: "Time to live in seconds",
+				Computed:            true,
+			},
+			"import_id": schema.StringAttribute{
+				MarkdownDescription: "ID to import this RRSet as a `poweradmin_rrset` with, e.g. in an `import` block",
+				Computed:            true,
+			},
+			"records": schema.ListNestedAttribute{
+				MarkdownDescription: "List of records in this RRSet",
+				Computed:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
-						"name": schema.StringAttribute{
-							MarkdownDescription: "The record name",
-							Computed:            true,
-						},
-						"type": schema.StringAttribute{
-							MarkdownDescription: "The record type",
+						"content": schema.StringAttribute{
+							MarkdownDescription: "Record content/value",
 							Computed:            true,
 						},
-						"ttl": schema.Int64Attribute{
-							MarkdownDescription: "Time to live in seconds",
+						"disabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether the record is disabled",
 							Computed:            true,
 						},
-						"records": schema.ListNestedAttribute{
-							MarkdownDescription: "List of records in this RRSet",
+						"priority": schema.Int64Attribute{
+							MarkdownDescription: "Priority for MX, SRV records",
 							Computed:            true,
-							NestedObject: schema.NestedAttributeObject{
-								Attributes: map[string]schema.Attribute{
-									"content": schema.StringAttribute{
-										MarkdownDescription: "Record content/value",
-										Computed:            true,
-									},
-									"disabled": schema.BoolAttribute{
-										MarkdownDescription: "Whether the record is disabled",
-										Computed:            true,
-									},
-									"priority": schema.Int64Attribute{
-										MarkdownDescription: "Priority for MX, SRV records",
-										Computed:            true,
-									},
-								},
-							},
 						},
 					},
 				},
@@ -147,28 +210,87 @@ func (d *RRSetsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		)
 		return
 	}
-	if data.Type.IsUnknown() {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("type"),
-			"Unknown type value",
-			"The type value is unknown at plan time. Data sources cannot be read until all configuration values are known.",
-		)
+	for _, filter := range []struct {
+		attr  string
+		value types.String
+	}{
+		{"type", data.Type},
+		{"name", data.Name},
+		{"name_regex", data.NameRegex},
+	} {
+		if filter.value.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(filter.attr),
+				fmt.Sprintf("Unknown %s value", filter.attr),
+				fmt.Sprintf("The %s value is unknown at plan time. Data sources cannot be read until all configuration values are known.", filter.attr),
+			)
+			return
+		}
+	}
+
+	for _, limit := range []struct {
+		attr  string
+		value types.Int64
+	}{
+		{"page", data.Page},
+		{"per_page", data.PerPage},
+		{"max_items", data.MaxItems},
+	} {
+		if limit.value.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(limit.attr),
+				fmt.Sprintf("Unknown %s value", limit.attr),
+				fmt.Sprintf("The %s value is unknown at plan time. Data sources cannot be read until all configuration values are known.", limit.attr),
+			)
+			return
+		}
+		if !limit.value.IsNull() && limit.value.ValueInt64() < 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(limit.attr),
+				fmt.Sprintf("Invalid %s", limit.attr),
+				fmt.Sprintf("%s must be at least 1, got %d.", limit.attr, limit.value.ValueInt64()),
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	var nameRegex *regexp.Regexp
+	if data.NameRegex.ValueString() != "" {
+		var err error
+		if nameRegex, err = regexp.Compile(data.NameRegex.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid name_regex",
+				fmt.Sprintf("name_regex is not a valid regular expression: %s", err.Error()),
+			)
+			return
+		}
+	}
+
 	zoneID := data.ZoneID.ValueInt64()
-	recordType := ""
-	if !data.Type.IsNull() {
-		recordType = data.Type.ValueString()
+	filter := RRSetFilter{
+		Type:      data.Type.ValueString(),
+		Name:      data.Name.ValueString(),
+		Page:      int(data.Page.ValueInt64()),
+		PerPage:   int(data.PerPage.ValueInt64()),
+		MaxItems:  int(data.MaxItems.ValueInt64()),
+		NameRegex: nameRegex,
 	}
 
 	tflog.Debug(ctx, "Reading RRSets", map[string]interface{}{
-		"zone_id": zoneID,
-		"type":    recordType,
+		"zone_id":    zoneID,
+		"type":       filter.Type,
+		"name":       filter.Name,
+		"name_regex": data.NameRegex.ValueString(),
+		"page":       filter.Page,
+		"per_page":   filter.PerPage,
+		"max_items":  filter.MaxItems,
 	})
 
 	// Get RRSets from API
-	rrsets, err := d.client.ListRRSets(ctx, zoneID, recordType)
+	rrsets, err := d.client.ListRRSetsMatching(ctx, zoneID, filter)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading RRSets",
@@ -178,7 +300,29 @@ func (d *RRSetsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	// Map response to model
-	data.RRSets = make([]RRSetDataModel, len(rrsets))
+	data.RRSets = rrsetDataModels(zoneID, rrsets)
+
+	// Import blocks name resources relative to the zone; FQDNs still work
+	zoneName, err := d.client.GetZoneName(ctx, zoneID)
+	if err != nil {
+		tflog.Debug(ctx, "Could not determine zone name for import blocks", map[string]interface{}{
+			"zone_id": zoneID,
+			"error":   err.Error(),
+		})
+	}
+	data.ImportBlocks = types.StringValue(rrsetImportBlocks(zoneID, zoneName, rrsets))
+
+	tflog.Trace(ctx, "Read RRSets", map[string]interface{}{
+		"count": len(rrsets),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// rrsetDataModels maps API RRSets to data source models.
+func rrsetDataModels(zoneID int64, rrsets []RRSet) []RRSetDataModel {
+	models := make([]RRSetDataModel, len(rrsets))
 	for i, rrset := range rrsets {
 		records := make([]RRSetRecordDataModel, len(rrset.Records))
 		for j, record := range rrset.Records {
@@ -189,18 +333,56 @@ func (d *RRSetsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 			}
 		}
 
-		data.RRSets[i] = RRSetDataModel{
-			Name:    types.StringValue(rrset.Name),
-			Type:    types.StringValue(rrset.Type),
-			TTL:     types.Int64Value(rrset.TTL),
-			Records: records,
+		models[i] = RRSetDataModel{
+			Name:     types.StringValue(rrset.Name),
+			Type:     types.StringValue(rrset.Type),
+			TTL:      types.Int64Value(rrset.TTL),
+			Records:  records,
+			ImportID: types.StringValue(fmt.Sprintf("%d/%s/%s", zoneID, rrset.Name, rrset.Type)),
 		}
 	}
+	return models
+}
 
-	tflog.Trace(ctx, "Read RRSets", map[string]interface{}{
-		"count": len(rrsets),
-	})
+// rrsetImportBlocks renders an import block per RRSet, leaving out the SOA,
+// with resource names derived from the owner name and type.
+func rrsetImportBlocks(zoneID int64, zoneName string, rrsets []RRSet) string {
+	var b strings.Builder
+	seen := map[string]int{}
+	for _, rrset := range rrsets {
+		if strings.EqualFold(rrset.Type, "SOA") {
+			continue
+		}
+		name := canonicalOwnerName(rrset.Name, zoneName)
+		recordType := strings.ToUpper(rrset.Type)
+		label := rrsetResourceLabel(name, recordType)
+		if seen[label]++; seen[label] > 1 {
+			label = fmt.Sprintf("%s_%d", label, seen[label])
+		}
+		if b.Len() > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "import {\n  to = poweradmin_rrset.%s\n  id = %q\n}\n", label, fmt.Sprintf("%d/%s/%s", zoneID, name, recordType))
+	}
+	return b.String()
+}
 
-	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+// rrsetResourceLabel turns a relative owner name and type into a Terraform
+// resource name, e.g. "_dmarc.mail" and TXT into "_dmarc_mail_txt".
+func rrsetResourceLabel(name, recordType string) string {
+	if name == "@" {
+		name = "apex"
+	}
+	name = strings.ReplaceAll(name, "*", "wildcard")
+	label := strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '_' || r == '-' {
+			return r
+		}
+		return '_'
+	}, strings.ToLower(name+"_"+recordType))
+	// Names must start with a letter or underscore
+	if c := label[0]; c != '_' && (c < 'a' || c > 'z') {
+		label = "_" + label
+	}
+	return label
 }
@@ -5,11 +5,47 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
+func TestRRSetImportBlocks(t *testing.T) {
+	got := rrsetImportBlocks(3, "example.com", []RRSet{
+		{Name: "example.com", Type: "SOA"},
+		{Name: "example.com", Type: "MX"},
+		{Name: "www.example.com", Type: "A"},
+		{Name: "*.example.com", Type: "A"},
+		{Name: "_dmarc.example.com", Type: "TXT"},
+		{Name: "1.example.com", Type: "A"},
+		{Name: "www-1.example.com", Type: "A"},
+		{Name: "www_1.example.com", Type: "A"},
+	})
+	want := strings.Join([]string{
+		"import {\n  to = poweradmin_rrset.apex_mx\n  id = \"3/@/MX\"\n}\n",
+		"import {\n  to = poweradmin_rrset.www_a\n  id = \"3/www/A\"\n}\n",
+		"import {\n  to = poweradmin_rrset.wildcard_a\n  id = \"3/*/A\"\n}\n",
+		"import {\n  to = poweradmin_rrset._dmarc_txt\n  id = \"3/_dmarc/TXT\"\n}\n",
+		"import {\n  to = poweradmin_rrset._1_a\n  id = \"3/1/A\"\n}\n",
+		"import {\n  to = poweradmin_rrset.www-1_a\n  id = \"3/www-1/A\"\n}\n",
+		"import {\n  to = poweradmin_rrset.www_1_a\n  id = \"3/www_1/A\"\n}\n",
+	}, "\n")
+	if got != want {
+		t.Errorf("rrsetImportBlocks() =\n%s\nwant\n%s", got, want)
+	}
+
+	// Names that map to the same resource name are numbered
+	got = rrsetImportBlocks(3, "example.com", []RRSet{
+		{Name: "a.b.example.com", Type: "A"},
+		{Name: "a_b.example.com", Type: "A"},
+	})
+	if !strings.Contains(got, "poweradmin_rrset.a_b_a\n") || !strings.Contains(got, "poweradmin_rrset.a_b_a_2\n") {
+		t.Errorf("expected colliding resource names to be numbered, got:\n%s", got)
+	}
+}
+
 func TestAccRRSetsDataSource(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -20,6 +56,7 @@ func TestAccRRSetsDataSource(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.poweradmin_rrsets.test", "zone_id"),
 					resource.TestCheckResourceAttrSet("data.poweradmin_rrsets.test", "rrsets.#"),
+					resource.TestMatchResourceAttr("data.poweradmin_rrsets.test", "import_blocks", regexp.MustCompile(`to = poweradmin_rrset\.www_a\n`)),
 				),
 			},
 		},
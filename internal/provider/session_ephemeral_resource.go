@@ -0,0 +1,176 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &SessionEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &SessionEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &SessionEphemeralResource{}
+
+// sessionTokenKey is the private data key holding the token to revoke on close.
+const sessionTokenKey = "token"
+
+func NewSessionEphemeralResource() ephemeral.EphemeralResource {
+	return &SessionEphemeralResource{}
+}
+
+// SessionEphemeralResource opens an API session for the duration of a
+// Terraform operation and logs out when it ends.
+type SessionEphemeralResource struct {
+	client *Client
+}
+
+// SessionEphemeralResourceModel describes the ephemeral resource data model.
+type SessionEphemeralResourceModel struct {
+	Username  types.String `tfsdk:"username"`
+	Password  types.String `tfsdk:"password"`
+	Token     types.String `tfsdk:"token"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+}
+
+func (r *SessionEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_session"
+}
+
+func (r *SessionEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Logs in to the Poweradmin API with a username and password and yields a short-lived session token, which is revoked when the Terraform operation ends. " +
+			"The token is accepted wherever an API key is, so it can configure an aliased `poweradmin` provider (`api_key = ephemeral.poweradmin_session.this.token`) or other tools calling the same API, " +
+			"without a long-lived key in variables or state. Requires Terraform 1.10 or later and a Poweradmin API with session login (`/auth/login`).",
+
+		Attributes: map[string]schema.Attribute{
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Username to log in as. Defaults to the provider's `username`.",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password to log in with. Defaults to the provider's `password`.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "Session token, sent as the API key",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "When the session expires, as reported by the API; null when it does not say",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *SessionEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SessionEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data SessionEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	username := r.client.Username
+	if !data.Username.IsNull() {
+		username = data.Username.ValueString()
+	}
+	password := r.client.Password
+	if !data.Password.IsNull() {
+		password = data.Password.ValueString()
+	}
+	if username == "" || password == "" {
+		resp.Diagnostics.AddError(
+			"Missing Session Credentials",
+			"Set username and password on the poweradmin_session ephemeral resource, or configure the provider with username and password.",
+		)
+		return
+	}
+
+	session, err := r.client.Login(ctx, username, password)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Opening Session", fmt.Sprintf("Could not log in as %s: %s", username, err))
+		return
+	}
+	if session.Token == "" {
+		resp.Diagnostics.AddError("Error Opening Session", "The API accepted the login but returned no session token.")
+		return
+	}
+
+	token, err := json.Marshal(session.Token)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Opening Session", fmt.Sprintf("Could not store the session token: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, sessionTokenKey, token)...)
+
+	data.Username = types.StringValue(username)
+	data.Token = types.StringValue(session.Token)
+	data.ExpiresAt = types.StringNull()
+	if session.ExpiresAt != "" {
+		data.ExpiresAt = types.StringValue(session.ExpiresAt)
+	}
+
+	tflog.Debug(ctx, "Opened API session", map[string]interface{}{
+		"username":   username,
+		"expires_at": session.ExpiresAt,
+	})
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *SessionEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	raw, diags := req.Private.GetKey(ctx, sessionTokenKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || raw == nil {
+		return
+	}
+	var token string
+	if err := json.Unmarshal(raw, &token); err != nil {
+		resp.Diagnostics.AddError("Error Closing Session", fmt.Sprintf("Could not read the session token: %s", err))
+		return
+	}
+
+	// A session that already expired is rejected with 401 and needs no logout
+	var apiErr *apiHTTPError
+	if err := r.client.Logout(ctx, token); err != nil && (!errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnauthorized) {
+		resp.Diagnostics.AddWarning(
+			"Error Closing Session",
+			fmt.Sprintf("Could not log out; the session stays valid until it expires: %s", err),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Closed API session")
+}
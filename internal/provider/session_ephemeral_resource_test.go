@@ -0,0 +1,99 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestLoginLogout(t *testing.T) {
+	var loggedOut bool
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /api/v2/auth/login":
+			var req LoginRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Fatalf("failed to decode login: %v", err)
+			}
+			if req.Username != "deploy" || req.Password != "s3cret" {
+				respondError(t, w, http.StatusUnauthorized, "Invalid credentials")
+				return
+			}
+			respondJSON(t, w, Session{Token: "session-token", ExpiresAt: "2026-10-16T10:12:44+00:00"})
+		case "POST /api/v2/auth/logout":
+			if got := r.Header.Get("X-API-Key"); got != "session-token" {
+				t.Errorf("expected logout with the session token, got %q", got)
+			}
+			loggedOut = true
+			respondJSON(t, w, nil)
+		default:
+			respondError(t, w, http.StatusNotFound, "Endpoint not found")
+		}
+	})
+	// Sessions change no DNS data and are allowed in read-only mode
+	client.ReadOnly = true
+	ctx := context.Background()
+
+	if _, err := client.Login(ctx, "deploy", "wrong"); err == nil {
+		t.Fatal("expected wrong credentials to fail")
+	}
+	session, err := client.Login(ctx, "deploy", "s3cret")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if session.Token != "session-token" || session.ExpiresAt == "" {
+		t.Errorf("unexpected session %+v", session)
+	}
+	if err := client.Logout(ctx, session.Token); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !loggedOut {
+		t.Error("expected a logout request")
+	}
+}
+
+func TestAccSessionEphemeralResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if os.Getenv("POWERADMIN_USERNAME") == "" || os.Getenv("POWERADMIN_PASSWORD") == "" {
+				t.Skip("POWERADMIN_USERNAME and POWERADMIN_PASSWORD must be set for session tests")
+			}
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+ephemeral "poweradmin_session" "test" {
+  username = "` + os.Getenv("POWERADMIN_USERNAME") + `"
+  password = "` + os.Getenv("POWERADMIN_PASSWORD") + `"
+}
+
+provider "poweradmin" {
+  alias   = "session"
+  api_url = "` + os.Getenv("POWERADMIN_API_URL") + `"
+  api_key = ephemeral.poweradmin_session.test.token
+}
+
+data "poweradmin_api_status" "test" {
+  provider = poweradmin.session
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.poweradmin_api_status.test", "authenticated", "true"),
+				),
+			},
+		},
+	})
+}
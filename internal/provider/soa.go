@@ -0,0 +1,105 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SOA is the parsed content of an SOA record.
+type SOA struct {
+	PrimaryNS  string
+	Hostmaster string
+	Serial     int64
+	Refresh    int64
+	Retry      int64
+	Expire     int64
+	Minimum    int64
+}
+
+// parseSOA parses SOA content such as
+// "ns1.example.com. hostmaster.example.com. 2024010101 10800 3600 604800 3600".
+func parseSOA(content string) (SOA, error) {
+	fields := strings.Fields(content)
+	if len(fields) != 7 {
+		return SOA{}, fmt.Errorf("SOA content %q must have 7 fields, got %d", content, len(fields))
+	}
+	var numbers [5]int64
+	for i, field := range fields[2:] {
+		n, err := strconv.ParseUint(field, 10, 32)
+		if err != nil {
+			return SOA{}, fmt.Errorf("SOA content %q has an invalid number %q", content, field)
+		}
+		numbers[i] = int64(n)
+	}
+	return SOA{
+		PrimaryNS:  fields[0],
+		Hostmaster: fields[1],
+		Serial:     numbers[0],
+		Refresh:    numbers[1],
+		Retry:      numbers[2],
+		Expire:     numbers[3],
+		Minimum:    numbers[4],
+	}, nil
+}
+
+// String formats the SOA as record content.
+func (s SOA) String() string {
+	return fmt.Sprintf("%s %s %d %d %d %d %d", s.PrimaryNS, s.Hostmaster, s.Serial, s.Refresh, s.Retry, s.Expire, s.Minimum)
+}
+
+// hostmasterToEmail converts an SOA RNAME ("host\.master.example.com.") to
+// email form ("host.master@example.com"). The first unescaped dot separates
+// the local part; an RNAME without one is returned without its trailing dot.
+func hostmasterToEmail(rname string) string {
+	rname = strings.TrimSuffix(rname, ".")
+	var local strings.Builder
+	for i := 0; i < len(rname); i++ {
+		switch {
+		case rname[i] == '\\' && i+1 < len(rname):
+			i++
+			local.WriteByte(rname[i])
+		case rname[i] == '.':
+			return local.String() + "@" + rname[i+1:]
+		default:
+			local.WriteByte(rname[i])
+		}
+	}
+	return rname
+}
+
+// emailToHostmaster converts an email address ("host.master@example.com") to
+// an SOA RNAME ("host\.master.example.com."), escaping dots in the local part.
+func emailToHostmaster(email string) (string, error) {
+	local, domain, found := strings.Cut(strings.TrimSpace(email), "@")
+	domain = strings.TrimSuffix(domain, ".")
+	if !found || local == "" || domain == "" || strings.ContainsAny(local+domain, "@\\ \t") || strings.HasPrefix(domain, ".") {
+		return "", fmt.Errorf("%q is not a valid email address; expected a form such as hostmaster@example.com", email)
+	}
+	return strings.ReplaceAll(local, ".", "\\.") + "." + domain + ".", nil
+}
+
+// zoneSOA returns the zone's apex SOA RRSet and its parsed content; the RRSet
+// is nil when the zone has no SOA yet, e.g. before the first transfer of a
+// SLAVE zone.
+func zoneSOA(ctx context.Context, client *Client, zoneID int64) (*RRSet, SOA, error) {
+	rrsets, err := client.ListRRSetsMatching(ctx, zoneID, RRSetFilter{Type: "SOA", Name: "@"})
+	if err != nil {
+		return nil, SOA{}, err
+	}
+	for i := range rrsets {
+		if len(rrsets[i].Records) == 0 {
+			continue
+		}
+		soa, err := parseSOA(rrsets[i].Records[0].Content)
+		if err != nil {
+			return nil, SOA{}, err
+		}
+		return &rrsets[i], soa, nil
+	}
+	return nil, SOA{}, nil
+}
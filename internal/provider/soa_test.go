@@ -0,0 +1,68 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+)
+
+func TestParseSOA(t *testing.T) {
+	content := "ns1.example.com. hostmaster.example.com. 2024010101 10800 3600 604800 3600"
+	soa, err := parseSOA(content)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := SOA{PrimaryNS: "ns1.example.com.", Hostmaster: "hostmaster.example.com.", Serial: 2024010101, Refresh: 10800, Retry: 3600, Expire: 604800, Minimum: 3600}
+	if soa != want {
+		t.Errorf("parseSOA() = %+v, want %+v", soa, want)
+	}
+	if soa.String() != content {
+		t.Errorf("String() = %q, want %q", soa.String(), content)
+	}
+
+	for _, bad := range []string{
+		"",
+		"ns1.example.com. hostmaster.example.com. 2024010101 10800 3600 604800",
+		"ns1.example.com. hostmaster.example.com. 2024010101 10800 3600 604800 -1",
+		"ns1.example.com. hostmaster.example.com. 99999999999 10800 3600 604800 3600",
+	} {
+		if _, err := parseSOA(bad); err == nil {
+			t.Errorf("expected error for %q", bad)
+		}
+	}
+}
+
+func TestHostmasterEmail(t *testing.T) {
+	tests := []struct {
+		email string
+		rname string
+	}{
+		{"hostmaster@example.com", "hostmaster.example.com."},
+		{"dns.admin@example.com", `dns\.admin.example.com.`},
+		{"a.b.c@sub.example.com", `a\.b\.c.sub.example.com.`},
+	}
+	for _, tt := range tests {
+		rname, err := emailToHostmaster(tt.email)
+		if err != nil || rname != tt.rname {
+			t.Errorf("emailToHostmaster(%q) = %q (err %v), want %q", tt.email, rname, err, tt.rname)
+		}
+		if email := hostmasterToEmail(tt.rname); email != tt.email {
+			t.Errorf("hostmasterToEmail(%q) = %q, want %q", tt.rname, email, tt.email)
+		}
+	}
+
+	// The trailing dot is optional in both forms
+	if rname, err := emailToHostmaster("hostmaster@example.com."); err != nil || rname != "hostmaster.example.com." {
+		t.Errorf("expected a trailing dot to be accepted, got %q (err %v)", rname, err)
+	}
+	if email := hostmasterToEmail("hostmaster.example.com"); email != "hostmaster@example.com" {
+		t.Errorf("expected an RNAME without trailing dot to convert, got %q", email)
+	}
+
+	for _, bad := range []string{"", "hostmaster", "@example.com", "hostmaster@", "a@b@example.com", "host master@example.com", "hostmaster@.example.com"} {
+		if _, err := emailToHostmaster(bad); err == nil {
+			t.Errorf("expected error for %q", bad)
+		}
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &SPFRecordFunction{}
+
+// spfMaxLookups is the RFC 7208 limit on DNS-querying terms per evaluation.
+const spfMaxLookups = 10
+
+func NewSPFRecordFunction() function.Function {
+	return &SPFRecordFunction{}
+}
+
+// SPFRecordFunction builds the content of an SPF TXT record.
+type SPFRecordFunction struct{}
+
+func (f *SPFRecordFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "spf_record"
+}
+
+func (f *SPFRecordFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build an SPF TXT record value",
+		MarkdownDescription: "Assembles and validates a `v=spf1` TXT content string from structured settings. All keys are optional: " +
+			"`ip4` and `ip6` (addresses or CIDR ranges), `a` and `mx` (`true` for the bare mechanism or a list of domains), " +
+			"`include` and `exists` (lists of domains), `redirect` (a domain) and `all` (`-`, `~`, `?`, `+` or `fail`, `softfail`, `neutral`, `pass`; defaults to `~` unless `redirect` is set). " +
+			"Each value may be a single string or a list. Fails when the top-level terms exceed the 10 DNS-lookup limit; lookups inside included records are not counted.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:                "policy",
+				MarkdownDescription: "SPF settings, e.g. `{ ip4 = [\"192.0.2.0/24\"], include = [\"_spf.google.com\"], all = \"-\" }`",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *SPFRecordFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var policy types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &policy))
+	if resp.Error != nil {
+		return
+	}
+
+	attrs, err := dynamicAttributes(policy)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	content, err := buildSPFRecord(attrs)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, content))
+}
+
+// spfAllQualifiers maps accepted all values to their qualifier.
+var spfAllQualifiers = map[string]string{
+	"-": "-", "fail": "-",
+	"~": "~", "softfail": "~",
+	"?": "?", "neutral": "?",
+	"+": "+", "pass": "+",
+}
+
+// buildSPFRecord validates the settings and renders the SPF record content.
+func buildSPFRecord(attrs map[string]attr.Value) (string, error) {
+	known := map[string]bool{"ip4": true, "ip6": true, "a": true, "mx": true, "include": true, "exists": true, "redirect": true, "all": true}
+	var unknown []string
+	for key := range attrs {
+		if !known[key] {
+			unknown = append(unknown, key)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return "", fmt.Errorf("unsupported SPF setting(s): %s", strings.Join(unknown, ", "))
+	}
+
+	terms := []string{"v=spf1"}
+	lookups := 0
+
+	for _, family := range []struct {
+		key  string
+		name string
+		bits int
+	}{{"ip4", "IPv4", 32}, {"ip6", "IPv6", 128}} {
+		values, err := attrStrings(attrs[family.key])
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", family.key, err)
+		}
+		for _, v := range values {
+			prefix, err := netip.ParsePrefix(v)
+			if err != nil {
+				addr, addrErr := netip.ParseAddr(v)
+				if addrErr != nil {
+					return "", fmt.Errorf("%s: %q is not an IP address or CIDR range", family.key, v)
+				}
+				prefix = netip.PrefixFrom(addr, family.bits)
+			}
+			if prefix.Addr().BitLen() != family.bits {
+				return "", fmt.Errorf("%s: %q is not an %s address", family.key, v, family.name)
+			}
+			terms = append(terms, family.key+":"+v)
+		}
+	}
+
+	for _, mech := range []string{"a", "mx"} {
+		value, ok := attrs[mech]
+		if !ok || value.IsNull() {
+			continue
+		}
+		if b, isBool := value.(types.Bool); isBool {
+			if b.ValueBool() {
+				terms = append(terms, mech)
+				lookups++
+			}
+			continue
+		}
+		domains, err := attrStrings(value)
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", mech, err)
+		}
+		for _, d := range domains {
+			if err := validateSPFDomain(d); err != nil {
+				return "", fmt.Errorf("%s: %w", mech, err)
+			}
+			terms = append(terms, mech+":"+d)
+			lookups++
+		}
+	}
+
+	for _, mech := range []string{"include", "exists"} {
+		domains, err := attrStrings(attrs[mech])
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", mech, err)
+		}
+		for _, d := range domains {
+			if err := validateSPFDomain(d); err != nil {
+				return "", fmt.Errorf("%s: %w", mech, err)
+			}
+			terms = append(terms, mech+":"+d)
+			lookups++
+		}
+	}
+
+	redirect, err := attrStrings(attrs["redirect"])
+	if err != nil || len(redirect) > 1 {
+		return "", fmt.Errorf("redirect: expected a single domain")
+	}
+	all, err := attrStrings(attrs["all"])
+	if err != nil || len(all) > 1 {
+		return "", fmt.Errorf("all: expected a single qualifier")
+	}
+
+	switch {
+	case len(redirect) == 1 && len(all) == 1:
+		return "", fmt.Errorf("redirect and all cannot be combined: redirect is ignored when all is present")
+	case len(redirect) == 1:
+		if err := validateSPFDomain(redirect[0]); err != nil {
+			return "", fmt.Errorf("redirect: %w", err)
+		}
+		terms = append(terms, "redirect="+redirect[0])
+		lookups++
+	case len(all) == 1:
+		qualifier, ok := spfAllQualifiers[strings.ToLower(all[0])]
+		if !ok {
+			return "", fmt.Errorf("all: %q must be one of -, ~, ?, + (or fail, softfail, neutral, pass)", all[0])
+		}
+		terms = append(terms, qualifier+"all")
+	default:
+		terms = append(terms, "~all")
+	}
+
+	if lookups > spfMaxLookups {
+		return "", fmt.Errorf("the record needs %d DNS lookups before any includes are expanded, more than the limit of %d; receivers will return permerror", lookups, spfMaxLookups)
+	}
+	return strings.Join(terms, " "), nil
+}
+
+// validateSPFDomain rejects obviously malformed domain specs. SPF macros
+// (%{...}) are allowed as-is.
+func validateSPFDomain(d string) error {
+	if d == "" || strings.ContainsAny(d, " \t\"") {
+		return fmt.Errorf("%q is not a valid domain", d)
+	}
+	if !strings.Contains(d, ".") && !strings.Contains(d, "%{") {
+		return fmt.Errorf("%q is not a fully qualified domain", d)
+	}
+	return nil
+}
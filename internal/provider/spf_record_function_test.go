@@ -0,0 +1,92 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// spfStrings builds a tuple of strings, the shape a list literal arrives in.
+func spfStrings(values ...string) attr.Value {
+	elemTypes := make([]attr.Type, len(values))
+	elems := make([]attr.Value, len(values))
+	for i, v := range values {
+		elemTypes[i] = types.StringType
+		elems[i] = types.StringValue(v)
+	}
+	return types.TupleValueMust(elemTypes, elems)
+}
+
+func TestBuildSPFRecord(t *testing.T) {
+	manyIncludes := make([]string, 11)
+	for i := range manyIncludes {
+		manyIncludes[i] = fmt.Sprintf("_spf%d.example.com", i)
+	}
+
+	tests := []struct {
+		name    string
+		attrs   map[string]attr.Value
+		want    string
+		wantErr bool
+	}{
+		{"empty defaults to softfail", map[string]attr.Value{}, "v=spf1 ~all", false},
+		{
+			"full record in term order",
+			map[string]attr.Value{
+				"all":     types.StringValue("fail"),
+				"include": spfStrings("_spf.google.com"),
+				"mx":      types.BoolValue(true),
+				"ip6":     types.StringValue("2001:db8::/32"),
+				"ip4":     spfStrings("192.0.2.0/24", "198.51.100.7"),
+			},
+			"v=spf1 ip4:192.0.2.0/24 ip4:198.51.100.7 ip6:2001:db8::/32 mx include:_spf.google.com -all",
+			false,
+		},
+		{"a with domains", map[string]attr.Value{"a": spfStrings("mail.example.com"), "all": types.StringValue("-")}, "v=spf1 a:mail.example.com -all", false},
+		{"redirect", map[string]attr.Value{"redirect": types.StringValue("_spf.example.com")}, "v=spf1 redirect=_spf.example.com", false},
+		{"ipv6 in ip4", map[string]attr.Value{"ip4": types.StringValue("2001:db8::1")}, "", true},
+		{"bad address", map[string]attr.Value{"ip4": types.StringValue("192.0.2.300")}, "", true},
+		{"redirect with all", map[string]attr.Value{"redirect": types.StringValue("_spf.example.com"), "all": types.StringValue("-")}, "", true},
+		{"bad qualifier", map[string]attr.Value{"all": types.StringValue("deny")}, "", true},
+		{"unqualified include", map[string]attr.Value{"include": types.StringValue("localhost")}, "", true},
+		{"too many lookups", map[string]attr.Value{"include": spfStrings(manyIncludes...)}, "", true},
+		{"unknown key", map[string]attr.Value{"ptr": types.BoolValue(true)}, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildSPFRecord(tt.attrs)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("buildSPFRecord() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("buildSPFRecord() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSPFRecordFunctionRun(t *testing.T) {
+	ctx := context.Background()
+	policy := types.DynamicValue(types.ObjectValueMust(
+		map[string]attr.Type{"ip4": types.StringType, "all": types.StringType},
+		map[string]attr.Value{"ip4": types.StringValue("192.0.2.1"), "all": types.StringValue("-")},
+	))
+
+	req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{policy})}
+	resp := function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+	NewSPFRecordFunction().Run(ctx, req, &resp)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+	if got := resp.Result.Value().(types.String).ValueString(); got != "v=spf1 ip4:192.0.2.1 -all" {
+		t.Errorf("unexpected result %q", got)
+	}
+}
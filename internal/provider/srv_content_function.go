@@ -0,0 +1,100 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &SRVContentFunction{}
+
+func NewSRVContentFunction() function.Function {
+	return &SRVContentFunction{}
+}
+
+// SRVContentFunction builds the content of an SRV record, less its priority.
+type SRVContentFunction struct{}
+
+func (f *SRVContentFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "srv_content"
+}
+
+func (f *SRVContentFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build an SRV record value",
+		MarkdownDescription: "Returns SRV content in the `<weight> <port> <target>` form PowerDNS stores, e.g. `10 5060 sip.example.com`. " +
+			"The priority is not part of the content; set it in the record's `priority` attribute. " +
+			"Weight and port must be between 0 and 65535. A trailing dot on the target is removed, matching what the API returns; " +
+			"a target of `.` (service not available) is kept as is.",
+		Parameters: []function.Parameter{
+			function.Int64Parameter{
+				Name:                "weight",
+				MarkdownDescription: "Relative weight among records with the same priority (0-65535)",
+			},
+			function.Int64Parameter{
+				Name:                "port",
+				MarkdownDescription: "TCP or UDP port of the service (0-65535)",
+			},
+			function.StringParameter{
+				Name:                "target",
+				MarkdownDescription: "Host name providing the service, or `.` if the service is not available",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *SRVContentFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var weight, port int64
+	var target string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &weight, &port, &target))
+	if resp.Error != nil {
+		return
+	}
+
+	if weight < 0 || weight > 65535 {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("weight must be between 0 and 65535, got %d", weight))
+		return
+	}
+	if port < 0 || port > 65535 {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("port must be between 0 and 65535, got %d", port))
+		return
+	}
+	target, err := normalizeSRVTarget(target)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(2, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, fmt.Sprintf("%d %d %s", weight, port, target)))
+}
+
+// normalizeSRVTarget validates an SRV target host and drops its trailing dot.
+func normalizeSRVTarget(target string) (string, error) {
+	target = strings.TrimSpace(target)
+	if target == "." {
+		return target, nil
+	}
+	host := strings.TrimSuffix(target, ".")
+	if host == "" {
+		return "", fmt.Errorf("target must be a host name or \".\"")
+	}
+	for _, label := range strings.Split(host, ".") {
+		if label == "" || len(label) > 63 {
+			return "", fmt.Errorf("%q is not a valid host name", target)
+		}
+		for _, r := range label {
+			if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_') {
+				return "", fmt.Errorf("%q is not a valid host name", target)
+			}
+		}
+	}
+	return host, nil
+}
@@ -0,0 +1,52 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSRVContentFunctionRun(t *testing.T) {
+	tests := []struct {
+		name    string
+		weight  int64
+		port    int64
+		target  string
+		want    string
+		wantErr bool
+	}{
+		{"plain target", 10, 5060, "sip.example.com", "10 5060 sip.example.com", false},
+		{"trailing dot stripped", 0, 443, "web.example.com.", "0 443 web.example.com", false},
+		{"service not available", 0, 0, ".", "0 0 .", false},
+		{"weight out of range", 65536, 80, "www.example.com", "", true},
+		{"negative port", 0, -1, "www.example.com", "", true},
+		{"empty target", 0, 80, "", "", true},
+		{"empty label", 0, 80, "www..example.com", "", true},
+		{"space in target", 0, 80, "www example.com", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{
+				types.Int64Value(tt.weight), types.Int64Value(tt.port), types.StringValue(tt.target),
+			})}
+			resp := function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+			NewSRVContentFunction().Run(context.Background(), req, &resp)
+
+			if (resp.Error != nil) != tt.wantErr {
+				t.Fatalf("Run() error = %v, wantErr %v", resp.Error, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := resp.Result.Value().(types.String).ValueString(); got != tt.want {
+				t.Errorf("Run() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
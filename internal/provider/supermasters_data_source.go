@@ -0,0 +1,113 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SupermastersDataSource{}
+
+func NewSupermastersDataSource() datasource.DataSource {
+	return &SupermastersDataSource{}
+}
+
+// SupermastersDataSource defines the data source implementation.
+type SupermastersDataSource struct {
+	client *Client
+}
+
+// SupermasterModel describes a supermaster entry.
+type SupermasterModel struct {
+	IP         types.String `tfsdk:"ip"`
+	Nameserver types.String `tfsdk:"nameserver"`
+	Account    types.String `tfsdk:"account"`
+}
+
+// SupermastersDataSourceModel describes the data source data model.
+type SupermastersDataSourceModel struct {
+	Supermasters []SupermasterModel `tfsdk:"supermasters"`
+}
+
+func (d *SupermastersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_supermasters"
+}
+
+func (d *SupermastersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the configured supermasters (PowerDNS autoprimaries): masters whose NOTIFYs make PowerDNS create SLAVE zones automatically. " +
+			"Useful to cross-check the provisioning sources of SLAVE zones in code. Falls back to the PowerDNS API when `powerdns_api_url` is configured and Poweradmin lacks the endpoint.",
+
+		Attributes: map[string]schema.Attribute{
+			"supermasters": schema.ListNestedAttribute{
+				MarkdownDescription: "Supermaster entries",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"ip": schema.StringAttribute{
+							MarkdownDescription: "IP address the supermaster sends NOTIFYs from",
+							Computed:            true,
+						},
+						"nameserver": schema.StringAttribute{
+							MarkdownDescription: "Name server the supermaster must be listed as in the NS records of provisioned zones",
+							Computed:            true,
+						},
+						"account": schema.StringAttribute{
+							MarkdownDescription: "Account assigned to zones the supermaster provisions",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SupermastersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SupermastersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SupermastersDataSourceModel
+
+	supermasters, err := d.client.ListSupermasters(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Supermasters",
+			fmt.Sprintf("Could not list supermasters: %s", err.Error()),
+		)
+		return
+	}
+
+	models := make([]SupermasterModel, len(supermasters))
+	for i, s := range supermasters {
+		models[i] = SupermasterModel{
+			IP:         types.StringValue(s.IP),
+			Nameserver: types.StringValue(s.Nameserver),
+			Account:    types.StringValue(s.Account),
+		}
+	}
+	data.Supermasters = models
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,78 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestListSupermasters(t *testing.T) {
+	want := []Supermaster{
+		{IP: "192.0.2.1", Nameserver: "ns1.example.net", Account: "partner"},
+		{IP: "2001:db8::1", Nameserver: "ns2.example.net"},
+	}
+
+	t.Run("Poweradmin API", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet || r.URL.Path != "/api/v2/supermasters" {
+				t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			}
+			respondJSON(t, w, SupermasterListResponse{Supermasters: want})
+		})
+		got, err := client.ListSupermasters(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got %+v, want %+v", got, want)
+		}
+	})
+
+	t.Run("PowerDNS fallback", func(t *testing.T) {
+		pdns := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/api/v1/servers/localhost/autoprimaries" {
+				t.Errorf("unexpected PowerDNS request: %s", r.URL.Path)
+			}
+			_ = json.NewEncoder(w).Encode(want)
+		}))
+		t.Cleanup(pdns.Close)
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			respondError(t, w, http.StatusNotFound, "Endpoint not found")
+		})
+		client.PowerDNSAPIURL = pdns.URL
+		client.PowerDNSAPIKey = "pdns-key"
+
+		got, err := client.ListSupermasters(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got %+v, want %+v", got, want)
+		}
+	})
+}
+
+func TestAccSupermastersDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+data "poweradmin_supermasters" "all" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.poweradmin_supermasters.all", "supermasters.#"),
+				),
+			},
+		},
+	})
+}
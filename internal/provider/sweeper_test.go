@@ -0,0 +1,194 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// Sweepers remove objects leaked by failed acceptance test runs on the shared
+// test server: go test ./internal/provider -v -sweep=all
+// They only delete objects named like the acceptance test fixtures.
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	resource.AddTestSweepers("poweradmin_zone", &resource.Sweeper{
+		Name: "poweradmin_zone",
+		F:    sweepZones,
+	})
+	resource.AddTestSweepers("poweradmin_user", &resource.Sweeper{
+		Name: "poweradmin_user",
+		// Users may own zones, which are removed first
+		Dependencies: []string{"poweradmin_zone"},
+		F:            sweepUsers,
+	})
+	resource.AddTestSweepers("poweradmin_group", &resource.Sweeper{
+		Name: "poweradmin_group",
+		F:    sweepGroups,
+	})
+	resource.AddTestSweepers("poweradmin_zone_template", &resource.Sweeper{
+		Name: "poweradmin_zone_template",
+		F:    sweepZoneTemplates,
+	})
+}
+
+// sweeperClient builds an API client from the acceptance test environment.
+func sweeperClient() (*Client, error) {
+	if os.Getenv("POWERADMIN_API_URL") == "" {
+		return nil, errors.New("POWERADMIN_API_URL must be set to run sweepers")
+	}
+	return NewClient(context.Background(), &PoweradminProviderModel{
+		ApiUrl:   types.StringValue(os.Getenv("POWERADMIN_API_URL")),
+		ApiKey:   types.StringValue(os.Getenv("POWERADMIN_API_KEY")),
+		Username: types.StringValue(os.Getenv("POWERADMIN_USERNAME")),
+		Password: types.StringValue(os.Getenv("POWERADMIN_PASSWORD")),
+	})
+}
+
+// isSweepableZone matches the zone names acceptance tests create:
+// test-*.example.com and test-*.com.
+func isSweepableZone(name string) bool {
+	return strings.HasPrefix(name, "test-") && (strings.HasSuffix(name, ".example.com") || strings.HasSuffix(name, "-acc.com"))
+}
+
+// sweepableUsers are the fixed user names the user resource tests create.
+var sweepableUsers = []string{"testuser", "testuser-nopass"}
+
+// isSweepableUser matches the user names acceptance tests create:
+// test-*-acc and the fixed names in sweepableUsers. The account the sweeper
+// authenticates as is never matched.
+func isSweepableUser(username string) bool {
+	if username == os.Getenv("POWERADMIN_USERNAME") {
+		return false
+	}
+	return (strings.HasPrefix(username, "test-") && strings.HasSuffix(username, "-acc")) || slices.Contains(sweepableUsers, username)
+}
+
+func sweepZones(_ string) error {
+	client, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	zones, err := client.ListZones(ctx)
+	if err != nil {
+		return fmt.Errorf("error listing zones: %w", err)
+	}
+	var errs []error
+	for _, zone := range zones {
+		if !isSweepableZone(zone.Name) {
+			continue
+		}
+		if err := client.DeleteZone(ctx, zone.ID); err != nil && !IsNotFoundError(err) {
+			errs = append(errs, fmt.Errorf("error deleting zone %s: %w", zone.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func sweepUsers(_ string) error {
+	client, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	users, err := client.ListUsers(ctx)
+	if err != nil {
+		return fmt.Errorf("error listing users: %w", err)
+	}
+	var errs []error
+	for _, user := range users {
+		if !isSweepableUser(user.Username) {
+			continue
+		}
+		if err := client.DeleteUser(ctx, user.UserID, nil); err != nil && !IsNotFoundError(err) {
+			errs = append(errs, fmt.Errorf("error deleting user %s: %w", user.Username, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func sweepGroups(_ string) error {
+	client, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	groups, err := client.ListGroups(ctx)
+	if err != nil {
+		return fmt.Errorf("error listing groups: %w", err)
+	}
+	var errs []error
+	for _, group := range groups {
+		if !strings.HasPrefix(group.Name, "test-") {
+			continue
+		}
+		if err := client.DeleteGroup(ctx, group.ID); err != nil && !IsNotFoundError(err) {
+			errs = append(errs, fmt.Errorf("error deleting group %s: %w", group.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func sweepZoneTemplates(_ string) error {
+	client, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	templates, err := client.ListZoneTemplates(ctx)
+	if err != nil {
+		return fmt.Errorf("error listing zone templates: %w", err)
+	}
+	var errs []error
+	for _, template := range templates {
+		if !strings.HasPrefix(template.Name, "acc-template") {
+			continue
+		}
+		if err := client.DeleteZoneTemplate(ctx, template.ID); err != nil && !IsNotFoundError(err) {
+			errs = append(errs, fmt.Errorf("error deleting zone template %s: %w", template.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func TestSweepableNames(t *testing.T) {
+	t.Setenv("POWERADMIN_USERNAME", "testadmin")
+
+	for name, want := range map[string]bool{
+		"test-record-acc.example.com": true,
+		"test-example-acc.com":        true,
+		"example.com":                 false,
+		"test-prod.example.org":       false,
+		"latest-test.example.com":     false,
+	} {
+		if got := isSweepableZone(name); got != want {
+			t.Errorf("isSweepableZone(%q) = %v, want %v", name, got, want)
+		}
+	}
+	for name, want := range map[string]bool{
+		"testuser":             true,
+		"testuser-nopass":      true,
+		"test-member-user-acc": true,
+		"testadmin":            false,
+		"tester":               false,
+		"test-ops":             false,
+		"admin":                false,
+	} {
+		if got := isSweepableUser(name); got != want {
+			t.Errorf("isSweepableUser(%q) = %v, want %v", name, got, want)
+		}
+	}
+}
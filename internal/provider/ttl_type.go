@@ -0,0 +1,177 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// TTL attributes accept either seconds (300) or a duration ("5m", "1h30m").
+// Values are compared in seconds, so state keeps whichever form was
+// configured while the API reports plain seconds.
+
+var (
+	_ basetypes.StringTypable                    = TTLType{}
+	_ basetypes.StringValuableWithSemanticEquals = TTLValue{}
+	_ xattr.ValidateableAttribute                = TTLValue{}
+)
+
+// ttlUnits maps duration suffixes to seconds.
+var ttlUnits = map[byte]int64{
+	's': 1,
+	'm': 60,
+	'h': 3600,
+	'd': 86400,
+	'w': 604800,
+}
+
+// TTLType is the attribute type of TTLValue.
+type TTLType struct {
+	basetypes.StringType
+}
+
+func (t TTLType) String() string {
+	return "TTLType"
+}
+
+func (t TTLType) Equal(o attr.Type) bool {
+	other, ok := o.(TTLType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t TTLType) ValueType(ctx context.Context) attr.Value {
+	return TTLValue{}
+}
+
+func (t TTLType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return TTLValue{StringValue: in}, nil
+}
+
+func (t TTLType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return TTLValue{StringValue: stringValue}, nil
+}
+
+// TTLValue is a TTL in seconds or as a duration string.
+type TTLValue struct {
+	basetypes.StringValue
+}
+
+// NewTTLValue returns a known TTL of the given number of seconds.
+func NewTTLValue(seconds int64) TTLValue {
+	return TTLValue{StringValue: basetypes.NewStringValue(strconv.FormatInt(seconds, 10))}
+}
+
+func (v TTLValue) Type(ctx context.Context) attr.Type {
+	return TTLType{}
+}
+
+func (v TTLValue) Equal(o attr.Value) bool {
+	other, ok := o.(TTLValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals treats TTLs of the same length as equal, so 300 and
+// "5m" do not produce a diff.
+func (v TTLValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	newValue, ok := newValuable.(TTLValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+	prior, err := parseTTL(v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	updated, err := parseTTL(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	return prior == updated, diags
+}
+
+// ValidateAttribute rejects TTLs that are neither seconds nor a duration.
+func (v TTLValue) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+	if _, err := parseTTL(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid TTL", err.Error())
+	}
+}
+
+// ValueSeconds returns the TTL in seconds. Values have passed
+// ValidateAttribute by the time resources read them, so an unparsable value
+// yields 0.
+func (v TTLValue) ValueSeconds() int64 {
+	seconds, _ := parseTTL(v.ValueString())
+	return seconds
+}
+
+// parseTTL converts seconds ("300") or a duration made of s, m, h, d and w
+// components ("5m", "1h30m", "1d") to seconds, capped at 2^31-1 (RFC 2181).
+func parseTTL(value string) (int64, error) {
+	s := strings.ToLower(strings.TrimSpace(value))
+	if s == "" {
+		return 0, fmt.Errorf("TTL must not be empty")
+	}
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		if n < 0 || n > math.MaxInt32 {
+			return 0, fmt.Errorf("TTL %d is out of range (0 to %d seconds)", n, math.MaxInt32)
+		}
+		return n, nil
+	}
+
+	var total int64
+	for s != "" {
+		digits := 0
+		for digits < len(s) && s[digits] >= '0' && s[digits] <= '9' {
+			digits++
+		}
+		if digits == 0 || digits == len(s) {
+			return 0, fmt.Errorf("%q is not a valid TTL; use seconds (300) or a duration such as \"5m\", \"1h30m\" or \"1d\"", value)
+		}
+		unit, ok := ttlUnits[s[digits]]
+		if !ok {
+			return 0, fmt.Errorf("%q is not a valid TTL: unknown unit %q (use s, m, h, d or w)", value, s[digits])
+		}
+		n, err := strconv.ParseInt(s[:digits], 10, 64)
+		if err != nil || n > math.MaxInt32 {
+			return 0, fmt.Errorf("TTL %q is out of range (0 to %d seconds)", value, math.MaxInt32)
+		}
+		total += n * unit
+		if total > math.MaxInt32 {
+			return 0, fmt.Errorf("TTL %q is out of range (0 to %d seconds)", value, math.MaxInt32)
+		}
+		s = s[digits+1:]
+	}
+	return total, nil
+}
@@ -0,0 +1,101 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestParseTTL(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    int64
+		wantErr bool
+	}{
+		{"300", 300, false},
+		{"0", 0, false},
+		{"5m", 300, false},
+		{"1h", 3600, false},
+		{"1H30M", 5400, false},
+		{" 1d ", 86400, false},
+		{"1w2d", 777600, false},
+		{"90s", 90, false},
+		{"", 0, true},
+		{"-1", 0, true},
+		{"2147483648", 0, true},
+		{"1y", 0, true},
+		{"h", 0, true},
+		{"10", 10, false},
+		{"1h30", 0, true},
+		{"5 m", 0, true},
+		{"100000w", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseTTL(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseTTL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("parseTTL() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTTLValueSemanticEquals(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		prior, updated string
+		want           bool
+	}{
+		{"5m", "300", true},
+		{"300", "5m", true},
+		{"1h", "3600", true},
+		{"1h", "300", false},
+		{"bogus", "300", false},
+	}
+	for _, tt := range tests {
+		prior := TTLValue{StringValue: basetypes.NewStringValue(tt.prior)}
+		updated := TTLValue{StringValue: basetypes.NewStringValue(tt.updated)}
+		got, diags := prior.StringSemanticEquals(ctx, updated)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if got != tt.want {
+			t.Errorf("StringSemanticEquals(%q, %q) = %v, want %v", tt.prior, tt.updated, got, tt.want)
+		}
+	}
+}
+
+func TestTTLValueValidateAttribute(t *testing.T) {
+	ctx := context.Background()
+	req := xattr.ValidateAttributeRequest{Path: path.Root("ttl")}
+
+	for value, wantErr := range map[string]bool{"1h": false, "3600": false, "1 hour": true} {
+		resp := xattr.ValidateAttributeResponse{}
+		TTLValue{StringValue: basetypes.NewStringValue(value)}.ValidateAttribute(ctx, req, &resp)
+		if resp.Diagnostics.HasError() != wantErr {
+			t.Errorf("ValidateAttribute(%q) errors = %v, wantErr %v", value, resp.Diagnostics, wantErr)
+		}
+	}
+
+	resp := xattr.ValidateAttributeResponse{}
+	TTLValue{StringValue: basetypes.NewStringUnknown()}.ValidateAttribute(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("unknown TTL should not be validated: %v", resp.Diagnostics)
+	}
+}
+
+func TestNewTTLValue(t *testing.T) {
+	v := NewTTLValue(300)
+	if v.ValueString() != "300" || v.ValueSeconds() != 300 {
+		t.Errorf("NewTTLValue(300) = %q (%d seconds)", v.ValueString(), v.ValueSeconds())
+	}
+}
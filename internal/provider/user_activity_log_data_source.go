@@ -0,0 +1,207 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UserActivityLogDataSource{}
+
+// defaultUserActivityLogLimit bounds the entries returned when limit is unset.
+const defaultUserActivityLogLimit = 100
+
+func NewUserActivityLogDataSource() datasource.DataSource {
+	return &UserActivityLogDataSource{}
+}
+
+// UserActivityLogDataSource defines the data source implementation.
+type UserActivityLogDataSource struct {
+	client *Client
+}
+
+// UserActivityLogDataSourceModel describes the data source data model.
+type UserActivityLogDataSourceModel struct {
+	Username types.String               `tfsdk:"username"`
+	Since    types.String               `tfsdk:"since"`
+	Until    types.String               `tfsdk:"until"`
+	Limit    types.Int64                `tfsdk:"limit"`
+	Entries  []UserActivityLogDataModel `tfsdk:"entries"`
+}
+
+// UserActivityLogDataModel describes a single activity log entry.
+type UserActivityLogDataModel struct {
+	ID        types.Int64  `tfsdk:"id"`
+	User      types.String `tfsdk:"user"`
+	Event     types.String `tfsdk:"event"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+func (d *UserActivityLogDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_activity_log"
+}
+
+func (d *UserActivityLogDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches entries from the Poweradmin user activity log (logins, user and permission changes), optionally filtered by user and time range. Useful for compliance reporting. Requires Poweradmin 4.2.0+.",
+
+		Attributes: map[string]schema.Attribute{
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Only return entries for this username. Optional.",
+				Optional:            true,
+			},
+			"since": schema.StringAttribute{
+				MarkdownDescription: "Only return entries at or after this RFC 3339 timestamp (e.g. `2026-01-01T00:00:00Z`). Optional.",
+				Optional:            true,
+			},
+			"until": schema.StringAttribute{
+				MarkdownDescription: "Only return entries before this RFC 3339 timestamp. Optional.",
+				Optional:            true,
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of entries to return, newest first. Defaults to %d.", defaultUserActivityLogLimit),
+				Optional:            true,
+			},
+			"entries": schema.ListNestedAttribute{
+				MarkdownDescription: "Activity log entries, newest first",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Log entry ID",
+							Computed:            true,
+						},
+						"user": schema.StringAttribute{
+							MarkdownDescription: "Username the entry is about",
+							Computed:            true,
+						},
+						"event": schema.StringAttribute{
+							MarkdownDescription: "Description of the activity as recorded by Poweradmin",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Time of the activity",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *UserActivityLogDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UserActivityLogDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UserActivityLogDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := UserLogFilter{
+		Username: data.Username.ValueString(),
+		Since:    data.Since.ValueString(),
+		Until:    data.Until.ValueString(),
+		Limit:    defaultUserActivityLogLimit,
+	}
+
+	var since, until time.Time
+	for _, ts := range []struct {
+		attr  string
+		value string
+		into  *time.Time
+	}{
+		{"since", filter.Since, &since},
+		{"until", filter.Until, &until},
+	} {
+		if ts.value == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, ts.value)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(ts.attr),
+				"Invalid Timestamp",
+				fmt.Sprintf("%s must be an RFC 3339 timestamp such as 2026-01-01T00:00:00Z, got %q.", ts.attr, ts.value),
+			)
+			continue
+		}
+		*ts.into = parsed
+	}
+	if !since.IsZero() && !until.IsZero() && !since.Before(until) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("until"),
+			"Invalid Time Range",
+			fmt.Sprintf("until (%s) must be later than since (%s).", filter.Until, filter.Since),
+		)
+	}
+	if !data.Limit.IsNull() {
+		filter.Limit = data.Limit.ValueInt64()
+		if filter.Limit < 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("limit"),
+				"Invalid limit",
+				fmt.Sprintf("limit must be at least 1, got %d.", filter.Limit),
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logs, err := d.client.ListUserLogs(ctx, filter)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user activity log, got error: %s", err))
+		return
+	}
+	// Don't trust the server to honour the limit
+	if int64(len(logs)) > filter.Limit {
+		logs = logs[:filter.Limit]
+	}
+
+	// Map response to model
+	entries := make([]UserActivityLogDataModel, len(logs))
+	for i, entry := range logs {
+		entries[i] = UserActivityLogDataModel{
+			ID:        types.Int64Value(int64(entry.ID)),
+			User:      types.StringValue(entry.User),
+			Event:     types.StringValue(entry.Event),
+			CreatedAt: types.StringValue(entry.CreatedAt),
+		}
+	}
+	data.Entries = entries
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
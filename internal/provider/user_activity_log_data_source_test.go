@@ -0,0 +1,39 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserActivityLogDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+data "poweradmin_user_activity_log" "test" {
+  since = "2020-01-01T00:00:00Z"
+  limit = 10
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.poweradmin_user_activity_log.test", "entries.#"),
+				),
+			},
+			{
+				Config: testAccProviderConfig() + `
+data "poweradmin_user_activity_log" "test" {
+  since = "yesterday"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Timestamp`),
+			},
+		},
+	})
+}
@@ -0,0 +1,340 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &UserPermissionResource{}
+var _ resource.ResourceWithImportState = &UserPermissionResource{}
+var _ resource.ResourceWithValidateConfig = &UserPermissionResource{}
+var _ resource.ResourceWithModifyPlan = &UserPermissionResource{}
+
+func NewUserPermissionResource() resource.Resource {
+	return &UserPermissionResource{}
+}
+
+// UserPermissionResource defines the resource implementation.
+type UserPermissionResource struct {
+	client *Client
+}
+
+// UserPermissionResourceModel describes the resource data model.
+type UserPermissionResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	UserID         types.Int64  `tfsdk:"user_id"`
+	PermissionID   types.Int64  `tfsdk:"permission_id"`
+	PermissionName types.String `tfsdk:"permission_name"`
+}
+
+func (r *UserPermissionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_permission"
+}
+
+func (r *UserPermissionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Assigns a single permission directly to a Poweradmin user, so several modules can grant permissions to a shared user independently. " +
+			"Do not combine with the `permissions` attribute of `poweradmin_user` for the same user, which revokes permissions missing from its set.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Composite identifier in the format `user_id/permission_id`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the user",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"permission_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the permission to assign. Exactly one of `permission_id` and `permission_name` must be set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"permission_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the permission to assign (e.g., `zone_content_edit_own`). Exactly one of `permission_id` and `permission_name` must be set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+		},
+	}
+}
+
+func (r *UserPermissionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig requires exactly one of permission_id and permission_name.
+func (r *UserPermissionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data UserPermissionResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasID := !data.PermissionID.IsNull()
+	hasName := !data.PermissionName.IsNull()
+	switch {
+	case !hasID && !hasName:
+		resp.Diagnostics.AddError(
+			"Missing Required Attribute",
+			"Either 'permission_id' or 'permission_name' must be specified",
+		)
+	case hasID && hasName:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("permission_name"),
+			"Conflicting Attributes",
+			"Only one of 'permission_id' or 'permission_name' may be specified, not both",
+		)
+	}
+}
+
+// ModifyPlan fills in whichever of permission_id and permission_name was not
+// configured, so the plan shows both and an unknown name fails before apply.
+// Lookup failures leave the value unknown for Create to resolve.
+func (r *UserPermissionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+	var data UserPermissionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.PermissionID.IsUnknown() == data.PermissionName.IsUnknown() {
+		return
+	}
+
+	permission, err := r.findPermission(ctx, data)
+	if err != nil {
+		var notFound *permissionNotFoundError
+		if errors.As(err, &notFound) {
+			resp.Diagnostics.AddError("Unknown Permission", err.Error())
+			return
+		}
+		tflog.Debug(ctx, "Could not resolve permission at plan time", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("permission_id"), int64(permission.ID))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("permission_name"), permission.Name)...)
+}
+
+// permissionNotFoundError reports a configured permission that does not exist.
+type permissionNotFoundError struct {
+	what string
+}
+
+func (e *permissionNotFoundError) Error() string {
+	return fmt.Sprintf("permission %s does not exist; use a name or ID listed by the poweradmin_permission data source", e.what)
+}
+
+// findPermission looks up the permission by whichever of ID and name is known.
+func (r *UserPermissionResource) findPermission(ctx context.Context, data UserPermissionResourceModel) (*Permission, error) {
+	permissions, err := r.client.ListPermissions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range permissions {
+		if !data.PermissionID.IsNull() && !data.PermissionID.IsUnknown() {
+			if int64(p.ID) == data.PermissionID.ValueInt64() {
+				return &p, nil
+			}
+		} else if p.Name == data.PermissionName.ValueString() {
+			return &p, nil
+		}
+	}
+	if !data.PermissionID.IsNull() && !data.PermissionID.IsUnknown() {
+		return nil, &permissionNotFoundError{what: fmt.Sprintf("ID %d", data.PermissionID.ValueInt64())}
+	}
+	return nil, &permissionNotFoundError{what: fmt.Sprintf("%q", data.PermissionName.ValueString())}
+}
+
+func (r *UserPermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserPermissionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID := int(data.UserID.ValueInt64())
+
+	permission, err := r.findPermission(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Resolving Permission",
+			fmt.Sprintf("Could not resolve the permission to assign to user %d: %s", userID, err.Error()),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Assigning permission to user", map[string]interface{}{
+		"user_id":       userID,
+		"permission_id": permission.ID,
+	})
+
+	err = r.client.AssignUserPermission(ctx, userID, permission.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Assigning User Permission",
+			fmt.Sprintf("Could not assign permission %q to user %d: %s", permission.Name, userID, err.Error()),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%d/%d", userID, permission.ID))
+	data.PermissionID = types.Int64Value(int64(permission.ID))
+	data.PermissionName = types.StringValue(permission.Name)
+
+	tflog.Debug(ctx, "User permission assigned successfully")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserPermissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserPermissionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID := int(data.UserID.ValueInt64())
+	permissionID := int(data.PermissionID.ValueInt64())
+
+	tflog.Debug(ctx, "Reading user permission", map[string]interface{}{
+		"user_id":       userID,
+		"permission_id": permissionID,
+	})
+
+	// Verify the assignment by listing the user's direct permissions
+	permissions, err := r.client.ListUserPermissions(ctx, userID)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading User Permission",
+			fmt.Sprintf("Could not read permissions of user %d: %s", userID, err.Error()),
+		)
+		return
+	}
+
+	var found *Permission
+	for i := range permissions {
+		if permissions[i].ID == permissionID {
+			found = &permissions[i]
+			break
+		}
+	}
+
+	if found == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.PermissionName = types.StringValue(found.Name)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserPermissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Changing the user or permission forces replacement, and the computed
+	// counterpart is resolved at plan time, so Update has nothing to send.
+	var data UserPermissionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserPermissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data UserPermissionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID := int(data.UserID.ValueInt64())
+	permissionID := int(data.PermissionID.ValueInt64())
+
+	tflog.Debug(ctx, "Revoking permission from user", map[string]interface{}{
+		"user_id":       userID,
+		"permission_id": permissionID,
+	})
+
+	err := r.client.RevokeUserPermission(ctx, userID, permissionID)
+	if err != nil {
+		if IsNotFoundError(err) {
+			tflog.Info(ctx, "User permission already revoked, ignoring error", map[string]interface{}{
+				"user_id":       userID,
+				"permission_id": permissionID,
+			})
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Revoking User Permission",
+			fmt.Sprintf("Could not revoke permission %d from user %d: %s", permissionID, userID, err.Error()),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "User permission revoked successfully")
+}
+
+func (r *UserPermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	userID, permissionID, err := parseImportIDPair(req.ID, "user_id/permission_id")
+	if err != nil {
+		resp.Diagnostics.AddError("Error Importing User Permission", err.Error())
+		return
+	}
+
+	data := UserPermissionResourceModel{
+		ID:             types.StringValue(req.ID),
+		UserID:         types.Int64Value(userID),
+		PermissionID:   types.Int64Value(permissionID),
+		PermissionName: types.StringNull(), // filled in by Read
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
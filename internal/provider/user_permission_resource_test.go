@@ -0,0 +1,91 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserPermissionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserPermissionResourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("poweradmin_user_permission.test", "id"),
+					resource.TestCheckResourceAttrSet("poweradmin_user_permission.test", "permission_id"),
+					resource.TestCheckResourceAttr("poweradmin_user_permission.test", "permission_name", "zone_content_view_own"),
+				),
+			},
+			{
+				ResourceName:      "poweradmin_user_permission.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccUserPermissionResourceConfig() string {
+	return testAccProviderConfig() + `
+resource "poweradmin_user" "test" {
+  username = "test-permission-user-acc"
+  password = "TestPassword123!"
+  fullname = "Test Permission User"
+  email    = "permission-acc@example.com"
+  active   = true
+}
+
+resource "poweradmin_user_permission" "test" {
+  user_id         = poweradmin_user.test.id
+  permission_name = "zone_content_view_own"
+}
+`
+}
+
+func TestUserPermissionFindPermission(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(t, w, PermissionListResponse{Permissions: testPermissions})
+	})
+	r := &UserPermissionResource{client: client}
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		data     UserPermissionResourceModel
+		wantID   int
+		notFound bool
+	}{
+		{"by name", UserPermissionResourceModel{PermissionID: types.Int64Unknown(), PermissionName: types.StringValue("user_view_others")}, 53, false},
+		{"by id", UserPermissionResourceModel{PermissionID: types.Int64Value(41), PermissionName: types.StringUnknown()}, 41, false},
+		{"unknown name", UserPermissionResourceModel{PermissionID: types.Int64Unknown(), PermissionName: types.StringValue("nope")}, 0, true},
+		{"unknown id", UserPermissionResourceModel{PermissionID: types.Int64Value(99), PermissionName: types.StringUnknown()}, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := r.findPermission(ctx, tt.data)
+			if tt.notFound {
+				var notFound *permissionNotFoundError
+				if !errors.As(err, &notFound) {
+					t.Fatalf("expected permissionNotFoundError, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.ID != tt.wantID {
+				t.Errorf("expected permission ID %d, got %d", tt.wantID, got.ID)
+			}
+		})
+	}
+}
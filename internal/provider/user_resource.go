@@ -5,9 +5,13 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/mail"
 	"strconv"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -21,6 +25,11 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &UserResource{}
 var _ resource.ResourceWithImportState = &UserResource{}
+var _ resource.ResourceWithValidateConfig = &UserResource{}
+var _ resource.ResourceWithModifyPlan = &UserResource{}
+
+// usernameMaxLength matches the width of Poweradmin's users.username column.
+const usernameMaxLength = 64
 
 func NewUserResource() resource.Resource {
 	return &UserResource{}
@@ -42,6 +51,9 @@ type UserResourceModel struct {
 	Active      types.Bool   `tfsdk:"active"`
 	PermTempl   types.Int64  `tfsdk:"perm_templ"`
 	UseLdap     types.Bool   `tfsdk:"use_ldap"`
+	Permissions types.Set    `tfsdk:"permissions"`
+
+	TransferZonesTo types.Int64 `tfsdk:"transfer_zones_to"`
 }
 
 func (r *UserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -61,20 +73,21 @@ func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				},
 			},
 			"username": schema.StringAttribute{
-				MarkdownDescription: "Unique username for the user",
+				MarkdownDescription: "Unique username for the user. Letters, digits, `.`, `_`, `-` and `@`, up to 64 characters.",
 				Required:            true,
 			},
 			"password": schema.StringAttribute{
-				MarkdownDescription: "User password (will be hashed). Cannot be read back from the API.",
-				Required:            true,
-				Sensitive:           true,
+				MarkdownDescription: "User password (will be hashed). Cannot be read back from the API. Required when creating a user; " +
+					"may be omitted for imported users, e.g. LDAP or pre-existing accounts, to keep their current credentials. Setting or changing it later updates the password.",
+				Optional:  true,
+				Sensitive: true,
 			},
 			"fullname": schema.StringAttribute{
 				MarkdownDescription: "Full name of the user",
 				Required:            true,
 			},
 			"email": schema.StringAttribute{
-				MarkdownDescription: "Email address of the user",
+				MarkdownDescription: "Email address of the user, e.g. `jane@example.com` (no display name)",
 				Required:            true,
 			},
 			"description": schema.StringAttribute{
@@ -101,6 +114,18 @@ func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
 			},
+			"permissions": schema.SetAttribute{
+				MarkdownDescription: "Permissions assigned directly to the user in addition to those of `perm_templ`, by name (e.g., `zone_content_edit_own`) or ID. " +
+					"When set, direct permissions missing from the set are revoked; when omitted, direct permissions are not managed. Leave unset when using `poweradmin_user_permission` for this user.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"transfer_zones_to": schema.Int64Attribute{
+				MarkdownDescription: "ID of the user that receives the zones this user owns when it is destroyed. " +
+					"Without it, destroying a user who still owns zones fails instead of leaving the zones to the server's default handling. " +
+					"The value must be applied before the destroy, since deletion uses the value in state.",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -124,6 +149,248 @@ func (r *UserResource) Configure(ctx context.Context, req resource.ConfigureRequ
 	r.client = client
 }
 
+// ValidateConfig checks username and email locally, so typos surface as
+// attribute errors at plan time instead of an API 400 during apply.
+func (r *UserResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data UserResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Username.IsNull() && !data.Username.IsUnknown() {
+		if err := validateUsername(data.Username.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("username"), "Invalid Username", err.Error())
+		}
+	}
+	if !data.Email.IsNull() && !data.Email.IsUnknown() {
+		if err := validateEmail(data.Email.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("email"), "Invalid Email Address", err.Error())
+		}
+	}
+	if !data.Permissions.IsNull() && !data.Permissions.IsUnknown() {
+		var permissions []types.String
+		resp.Diagnostics.Append(data.Permissions.ElementsAs(ctx, &permissions, false)...)
+		for _, p := range permissions {
+			if !p.IsNull() && !p.IsUnknown() && strings.TrimSpace(p.ValueString()) == "" {
+				resp.Diagnostics.AddAttributeError(path.Root("permissions"), "Invalid Permission", "permission names must not be empty")
+			}
+		}
+	}
+}
+
+// ModifyPlan requires a password when creating a user, since only imported
+// users may keep credentials Terraform does not know, and rejects creating
+// a user whose username is already taken, with an import hint, instead of
+// failing the apply with a generic API error. Usernames are looked up in one
+// user listing shared by the whole plan. Lookup failures are only logged and
+// left for the create itself to report.
+func (r *UserResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() {
+		return
+	}
+	var password, username types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("password"), &password)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("username"), &username)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if password.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("password"),
+			"Missing Password",
+			"A password is required to create a user. It may only be omitted for users imported into Terraform, which keep their current credentials.",
+		)
+	}
+	if r.client == nil || username.IsNull() || username.IsUnknown() {
+		return
+	}
+
+	checkUsernameAvailable(ctx, r.client, username.ValueString(), &resp.Diagnostics)
+}
+
+// checkUsernameAvailable errors when username is already taken; returns
+// false when it added an error.
+func checkUsernameAvailable(ctx context.Context, client *Client, username string, diags *diag.Diagnostics) bool {
+	existing, err := client.FindUserByUsername(ctx, username)
+	if errors.Is(err, errUserNotFound) {
+		return true
+	}
+	if err != nil {
+		tflog.Debug(ctx, "Could not check username availability", map[string]interface{}{
+			"username": username,
+			"error":    err.Error(),
+		})
+		return true
+	}
+	diags.AddAttributeError(
+		path.Root("username"),
+		"User Already Exists",
+		fmt.Sprintf("A user named %q already exists (ID %d). Import it to manage the existing account:\n\n  terraform import <address> %d\n\nor choose another username.",
+			existing.Username, existing.UserID, existing.UserID),
+	)
+	return false
+}
+
+// checkUserOwnsNoZones refuses to delete a user who still owns zones when
+// no transfer target is set, so the zones are neither deleted nor orphaned
+// silently. A user that is already gone passes; returns false when it added
+// an error.
+func checkUserOwnsNoZones(ctx context.Context, client *Client, userID int, diags *diag.Diagnostics) bool {
+	user, err := client.GetUser(ctx, userID)
+	if IsNotFoundError(err) {
+		return true
+	}
+	if err != nil {
+		diags.AddError(
+			"Error Deleting User",
+			fmt.Sprintf("Could not check the zones owned by user ID %d before deleting it: %s", userID, err.Error()),
+		)
+		return false
+	}
+	if user.ZoneCount == 0 {
+		return true
+	}
+	diags.AddError(
+		"User Still Owns Zones",
+		fmt.Sprintf("User %q (ID %d) still owns %d zone(s). Set transfer_zones_to to the ID of the user who should receive them and apply before destroying, "+
+			"or move the zones to another owner first.", user.Username, userID, user.ZoneCount),
+	)
+	return false
+}
+
+// validateUsername enforces the characters Poweradmin accepts in usernames.
+func validateUsername(username string) error {
+	if username == "" {
+		return fmt.Errorf("username must not be empty")
+	}
+	if len(username) > usernameMaxLength {
+		return fmt.Errorf("username must be at most %d characters, got %d", usernameMaxLength, len(username))
+	}
+	for _, r := range username {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("._-@", r)) {
+			return fmt.Errorf("username %q contains %q; only letters, digits, '.', '_', '-' and '@' are allowed", username, r)
+		}
+	}
+	return nil
+}
+
+// validateEmail requires a bare addr-spec with a dotted domain.
+func validateEmail(email string) error {
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email || addr.Name != "" {
+		return fmt.Errorf("%q is not a valid email address; use the bare form, e.g. jane@example.com", email)
+	}
+	domain := email[strings.LastIndex(email, "@")+1:]
+	if !strings.Contains(domain, ".") || strings.HasPrefix(domain, ".") || strings.HasSuffix(domain, ".") {
+		return fmt.Errorf("%q is not a valid email address: domain %q is not fully qualified", email, domain)
+	}
+	return nil
+}
+
+// resolvePermissionIDs maps each configured permission, given by name or
+// numeric ID, to its ID, keyed back to the configured spelling.
+func resolvePermissionIDs(entries []string, all []Permission) (map[int]string, error) {
+	byName := make(map[string]int, len(all))
+	byID := make(map[int]bool, len(all))
+	for _, p := range all {
+		byName[p.Name] = p.ID
+		byID[p.ID] = true
+	}
+
+	resolved := make(map[int]string, len(entries))
+	for _, entry := range entries {
+		id, ok := byName[entry]
+		if !ok {
+			n, err := strconv.Atoi(entry)
+			if err != nil || !byID[n] {
+				return nil, fmt.Errorf("unknown permission %q; use a name or ID listed by the poweradmin_permission data source", entry)
+			}
+			id = n
+		}
+		if prior, dup := resolved[id]; dup {
+			return nil, fmt.Errorf("permissions %q and %q refer to the same permission", prior, entry)
+		}
+		resolved[id] = entry
+	}
+	return resolved, nil
+}
+
+// syncPermissions makes the user's direct permissions match the configured
+// set, assigning missing ones and revoking the rest. A null set leaves them
+// unmanaged.
+func (r *UserResource) syncPermissions(ctx context.Context, userID int, configured types.Set) error {
+	if configured.IsNull() || configured.IsUnknown() {
+		return nil
+	}
+	var entries []string
+	if diags := configured.ElementsAs(ctx, &entries, false); diags.HasError() {
+		return fmt.Errorf("could not read permissions from plan")
+	}
+
+	all, err := r.client.ListPermissions(ctx)
+	if err != nil {
+		return fmt.Errorf("could not list permissions: %w", err)
+	}
+	desired, err := resolvePermissionIDs(entries, all)
+	if err != nil {
+		return err
+	}
+	current, err := r.client.ListUserPermissions(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("could not list user permissions: %w", err)
+	}
+
+	assigned := make(map[int]bool, len(current))
+	for _, p := range current {
+		assigned[p.ID] = true
+		if _, keep := desired[p.ID]; keep {
+			continue
+		}
+		tflog.Debug(ctx, "Revoking user permission", map[string]interface{}{
+			"user_id":       userID,
+			"permission_id": p.ID,
+		})
+		if err := r.client.RevokeUserPermission(ctx, userID, p.ID); err != nil && !IsNotFoundError(err) {
+			return fmt.Errorf("could not revoke permission %q: %w", p.Name, err)
+		}
+	}
+	for id, entry := range desired {
+		if assigned[id] {
+			continue
+		}
+		tflog.Debug(ctx, "Assigning user permission", map[string]interface{}{
+			"user_id":       userID,
+			"permission_id": id,
+		})
+		if err := r.client.AssignUserPermission(ctx, userID, id); err != nil {
+			return fmt.Errorf("could not assign permission %q: %w", entry, err)
+		}
+	}
+	return nil
+}
+
+// permissionsState maps the user's direct permissions back to state,
+// keeping the configured spelling (name or ID) of each one.
+func permissionsState(ctx context.Context, configured types.Set, assigned []Permission) (types.Set, diag.Diagnostics) {
+	var prior []string
+	if !configured.IsNull() && !configured.IsUnknown() {
+		configured.ElementsAs(ctx, &prior, false)
+	}
+	values := make([]string, 0, len(assigned))
+	for _, p := range assigned {
+		value := p.Name
+		for _, entry := range prior {
+			if entry == p.Name || entry == strconv.Itoa(p.ID) {
+				value = entry
+				break
+			}
+		}
+		values = append(values, value)
+	}
+	return types.SetValueFrom(ctx, types.StringType, values)
+}
+
 func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data UserResourceModel
 
@@ -195,6 +462,16 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 	// Password is write-only, keep it in state
 	// data.Password is already set from plan
 
+	if err := r.syncPermissions(ctx, user.UserID, data.Permissions); err != nil {
+		// Keep the created user in state so the next apply retries the assignment
+		resp.Diagnostics.AddError(
+			"Error Assigning User Permissions",
+			fmt.Sprintf("User ID %d was created but its permissions could not be set: %s", user.UserID, err.Error()),
+		)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
 	tflog.Debug(ctx, "User created successfully", map[string]interface{}{
 		"id": data.ID.ValueInt64(),
 	})
@@ -256,6 +533,20 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	data.UseLdap = types.BoolValue(user.UseLdap)
 
+	if !data.Permissions.IsNull() {
+		assigned, err := r.client.ListUserPermissions(ctx, userID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading User Permissions",
+				fmt.Sprintf("Could not read permissions of user ID %d: %s", userID, err.Error()),
+			)
+			return
+		}
+		permissions, diags := permissionsState(ctx, data.Permissions, assigned)
+		resp.Diagnostics.Append(diags...)
+		data.Permissions = permissions
+	}
+
 	// Password cannot be read from API, keep existing value in state
 
 	tflog.Debug(ctx, "User read successfully")
@@ -283,7 +574,8 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		Email:    data.Email.ValueString(),
 	}
 
-	// Check if password changed
+	// Check if password changed; a password removed from the configuration
+	// is not sent, so the user keeps the current one
 	var oldData UserResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &oldData)...)
 	if !resp.Diagnostics.HasError() {
@@ -325,6 +617,14 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	if err := r.syncPermissions(ctx, userID, data.Permissions); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Assigning User Permissions",
+			fmt.Sprintf("Could not set permissions of user ID %d: %s", userID, err.Error()),
+		)
+		return
+	}
+
 	tflog.Debug(ctx, "User updated successfully")
 
 	// Save updated data into Terraform state
@@ -343,12 +643,21 @@ func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 
 	userID := int(data.ID.ValueInt64())
 
+	var transferTo *int
+	if !data.TransferZonesTo.IsNull() {
+		target := int(data.TransferZonesTo.ValueInt64())
+		transferTo = &target
+	} else if !checkUserOwnsNoZones(ctx, r.client, userID, &resp.Diagnostics) {
+		return
+	}
+
 	tflog.Debug(ctx, "Deleting user", map[string]interface{}{
-		"id": userID,
+		"id":                userID,
+		"transfer_zones_to": data.TransferZonesTo.ValueInt64(),
 	})
 
 	// Call API to delete user
-	err := r.client.DeleteUser(ctx, userID, nil)
+	err := r.client.DeleteUser(ctx, userID, transferTo)
 	if err != nil {
 		// User already gone - treat as a successful deletion
 		if IsNotFoundError(err) {
@@ -4,9 +4,16 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -46,6 +53,40 @@ func TestAccUserResource(t *testing.T) {
 	})
 }
 
+func TestAccUserResource_WithoutPassword(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Creating a user needs a password
+			{
+				Config:      testAccUserResourceConfigWithoutPassword("testuser-nopass"),
+				ExpectError: regexp.MustCompile("Missing Password"),
+			},
+			{
+				Config: testAccUserResourceConfig("testuser-nopass", "Test User", "testuser-nopass@example.com", true),
+			},
+			// Dropping the password keeps the user's credentials
+			{
+				Config: testAccUserResourceConfigWithoutPassword("testuser-nopass"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("poweradmin_user.test", "password"),
+				),
+			},
+		},
+	})
+}
+
+func testAccUserResourceConfigWithoutPassword(username string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "poweradmin_user" "test" {
+  username = %[1]q
+  fullname = "Test User"
+  email    = "%[1]s@example.com"
+}
+`, username)
+}
+
 func testAccUserResourceConfig(username, fullname, email string, active bool) string {
 	return testAccProviderConfig() + fmt.Sprintf(`
 resource "poweradmin_user" "test" {
@@ -57,3 +98,229 @@ resource "poweradmin_user" "test" {
 }
 `, username, fullname, email, active)
 }
+
+func TestValidateUsername(t *testing.T) {
+	for _, username := range []string{"admin", "jane.doe", "ops_team-2", "jane@example.com"} {
+		if err := validateUsername(username); err != nil {
+			t.Errorf("validateUsername(%q) = %v, want nil", username, err)
+		}
+	}
+	for _, username := range []string{"", "jane doe", "jane/doe", "jäne", strings.Repeat("a", usernameMaxLength+1)} {
+		if err := validateUsername(username); err == nil {
+			t.Errorf("validateUsername(%q) = nil, want error", username)
+		}
+	}
+}
+
+func TestValidateEmail(t *testing.T) {
+	for _, email := range []string{"jane@example.com", "jane.doe+dns@mail.example.org"} {
+		if err := validateEmail(email); err != nil {
+			t.Errorf("validateEmail(%q) = %v, want nil", email, err)
+		}
+	}
+	for _, email := range []string{"", "jane", "jane@", "@example.com", "jane@localhost", "Jane <jane@example.com>", " jane@example.com", "jane@example.com."} {
+		if err := validateEmail(email); err == nil {
+			t.Errorf("validateEmail(%q) = nil, want error", email)
+		}
+	}
+}
+
+func TestCheckUsernameAvailable(t *testing.T) {
+	tests := []struct {
+		name     string
+		username string
+		status   int
+		want     bool
+	}{
+		{"available", "newuser", http.StatusOK, true},
+		{"taken", "admin", http.StatusOK, false},
+		{"lookup failure left to the create", "admin", http.StatusForbidden, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if tt.status != http.StatusOK {
+					respondError(t, w, tt.status, "forbidden")
+					return
+				}
+				respondJSON(t, w, UserListResponse{Users: []User{{UserID: 1, Username: "admin"}}})
+			})
+
+			var diags diag.Diagnostics
+			if got := checkUsernameAvailable(context.Background(), client, tt.username, &diags); got != tt.want {
+				t.Errorf("checkUsernameAvailable() = %v, want %v", got, tt.want)
+			}
+			if diags.HasError() == tt.want {
+				t.Errorf("unexpected diagnostics: %v", diags)
+			}
+		})
+	}
+}
+
+func TestCheckUsernameAvailable_SharesListing(t *testing.T) {
+	listings := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost:
+			respondJSON(t, w, CreateUserResponse{UserID: 2})
+		case r.URL.Path == "/api/v2/users":
+			listings++
+			respondJSON(t, w, UserListResponse{Users: []User{{UserID: 1, Username: "admin"}}})
+		default:
+			respondJSON(t, w, UserResponse{User: User{UserID: 2, Username: "alice"}})
+		}
+	})
+	ctx := context.Background()
+
+	for _, username := range []string{"alice", "bob", "carol"} {
+		var diags diag.Diagnostics
+		if !checkUsernameAvailable(ctx, client, username, &diags) {
+			t.Errorf("expected %s to be available: %v", username, diags)
+		}
+	}
+	if listings != 1 {
+		t.Errorf("expected one user listing for the plan, got %d", listings)
+	}
+
+	// Creating a user drops the listing
+	if _, err := client.CreateUser(ctx, CreateUserRequest{Username: "alice"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var diags diag.Diagnostics
+	checkUsernameAvailable(ctx, client, "alice", &diags)
+	if listings != 2 {
+		t.Errorf("expected the listing to be fetched again, got %d listings", listings)
+	}
+}
+
+func TestCheckUserOwnsNoZones(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		zoneCount int
+		want      bool
+	}{
+		{"no zones", http.StatusOK, 0, true},
+		{"owns zones", http.StatusOK, 3, false},
+		{"already deleted", http.StatusNotFound, 0, true},
+		{"lookup failure", http.StatusForbidden, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/v2/users/5" {
+					t.Errorf("unexpected path: %s", r.URL.Path)
+				}
+				if tt.status != http.StatusOK {
+					respondError(t, w, tt.status, "error")
+					return
+				}
+				respondJSON(t, w, UserResponse{User: User{UserID: 5, Username: "alice", ZoneCount: tt.zoneCount}})
+			})
+
+			var diags diag.Diagnostics
+			if got := checkUserOwnsNoZones(context.Background(), client, 5, &diags); got != tt.want {
+				t.Errorf("checkUserOwnsNoZones() = %v, want %v", got, tt.want)
+			}
+			if diags.HasError() == tt.want {
+				t.Errorf("unexpected diagnostics: %v", diags)
+			}
+			if tt.zoneCount > 0 && (len(diags) == 0 || !strings.Contains(diags[0].Detail(), "3 zone(s)")) {
+				t.Errorf("expected the zone count in the error, got %v", diags)
+			}
+		})
+	}
+}
+
+var testPermissions = []Permission{
+	{ID: 41, Name: "zone_content_view_own"},
+	{ID: 42, Name: "zone_content_edit_own"},
+	{ID: 53, Name: "user_view_others"},
+}
+
+func TestResolvePermissionIDs(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []string
+		want    map[int]string
+		wantErr string
+	}{
+		{"by name", []string{"zone_content_edit_own"}, map[int]string{42: "zone_content_edit_own"}, ""},
+		{"by id", []string{"53"}, map[int]string{53: "53"}, ""},
+		{"mixed", []string{"41", "user_view_others"}, map[int]string{41: "41", 53: "user_view_others"}, ""},
+		{"empty", nil, map[int]string{}, ""},
+		{"unknown name", []string{"zone_master_add"}, nil, "unknown permission"},
+		{"unknown id", []string{"99"}, nil, "unknown permission"},
+		{"same permission twice", []string{"42", "zone_content_edit_own"}, nil, "same permission"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolvePermissionIDs(tt.entries, testPermissions)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("resolvePermissionIDs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUserSyncPermissions(t *testing.T) {
+	var calls []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/permissions":
+			respondJSON(t, w, PermissionListResponse{Permissions: testPermissions})
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/users/7/permissions":
+			respondJSON(t, w, PermissionListResponse{Permissions: testPermissions[:2]})
+		default:
+			calls = append(calls, r.Method+" "+r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+	r := &UserResource{client: client}
+	ctx := context.Background()
+
+	desired := types.SetValueMust(types.StringType, []attr.Value{
+		types.StringValue("42"),
+		types.StringValue("user_view_others"),
+	})
+	if err := r.syncPermissions(ctx, 7, desired); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{"DELETE /api/v2/users/7/permissions/41", "POST /api/v2/users/7/permissions"}
+	if fmt.Sprint(calls) != fmt.Sprint(want) {
+		t.Errorf("expected calls %v, got %v", want, calls)
+	}
+
+	calls = nil
+	if err := r.syncPermissions(ctx, 7, types.SetNull(types.StringType)); err != nil || len(calls) != 0 {
+		t.Errorf("expected a null set to leave permissions alone, got calls %v (err %v)", calls, err)
+	}
+}
+
+func TestPermissionsState(t *testing.T) {
+	configured := types.SetValueMust(types.StringType, []attr.Value{
+		types.StringValue("41"),
+		types.StringValue("zone_content_edit_own"),
+	})
+	got, diags := permissionsState(context.Background(), configured, testPermissions)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	want := types.SetValueMust(types.StringType, []attr.Value{
+		types.StringValue("41"),
+		types.StringValue("zone_content_edit_own"),
+		types.StringValue("user_view_others"),
+	})
+	if !got.Equal(want) {
+		t.Errorf("permissionsState() = %v, want %v", got, want)
+	}
+}
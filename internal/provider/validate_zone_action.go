@@ -0,0 +1,242 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &ValidateZoneAction{}
+var _ action.ActionWithConfigure = &ValidateZoneAction{}
+
+func NewValidateZoneAction() action.Action {
+	return &ValidateZoneAction{}
+}
+
+// ValidateZoneAction checks a zone's records for common DNS mistakes on
+// demand, failing the run when any are found.
+type ValidateZoneAction struct {
+	client *Client
+}
+
+// ValidateZoneActionModel describes the action data model.
+type ValidateZoneActionModel struct {
+	ZoneID         types.Int64 `tfsdk:"zone_id"`
+	FailOnWarnings types.Bool  `tfsdk:"fail_on_warnings"`
+}
+
+func (a *ValidateZoneAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_validate_zone"
+}
+
+func (a *ValidateZoneAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Validates the records of a zone and fails the run with the list of problems found: a missing or duplicate apex SOA, missing apex NS records, " +
+			"CNAMEs next to other data, MX, NS and SRV targets that are CNAMEs or have no address records in the zone, in-zone nameservers without glue, and names outside the zone. " +
+			"Having fewer than two nameservers is reported as a warning. Disabled records are ignored. Requires Terraform 1.14 or later.",
+
+		Attributes: map[string]schema.Attribute{
+			"zone_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the zone to validate",
+				Required:            true,
+			},
+			"fail_on_warnings": schema.BoolAttribute{
+				MarkdownDescription: "Fail the run on warnings as well as errors. Defaults to `false`.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (a *ValidateZoneAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+}
+
+func (a *ValidateZoneAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data ValidateZoneActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneID := data.ZoneID.ValueInt64()
+	zoneName, err := a.client.GetZoneName(ctx, zoneID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Zone", fmt.Sprintf("Could not read zone %d: %s", zoneID, err))
+		return
+	}
+	rrsets, err := a.client.ListRRSets(ctx, zoneID, "")
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Records", fmt.Sprintf("Could not list the records of zone %s: %s", zoneName, err))
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Validating %d RRSets of zone %s", len(rrsets), zoneName),
+	})
+
+	problems, warnings := validateZoneRRSets(zoneName, rrsets)
+	if data.FailOnWarnings.ValueBool() {
+		problems, warnings = append(problems, warnings...), nil
+	}
+
+	tflog.Debug(ctx, "Validated zone", map[string]interface{}{
+		"zone":     zoneName,
+		"problems": len(problems),
+		"warnings": len(warnings),
+	})
+
+	if len(warnings) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Zone Validation Warnings",
+			fmt.Sprintf("Zone %s:\n  - %s", zoneName, strings.Join(warnings, "\n  - ")),
+		)
+	}
+	if len(problems) > 0 {
+		resp.Diagnostics.AddError(
+			"Zone Validation Failed",
+			fmt.Sprintf("Zone %s has %d problem(s):\n  - %s", zoneName, len(problems), strings.Join(problems, "\n  - ")),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Zone %s is valid", zoneName),
+	})
+}
+
+// validateZoneRRSets checks the enabled records of a zone and returns the
+// problems, which fail validation, and the warnings, which do not.
+func validateZoneRRSets(zoneName string, rrsets []RRSet) (problems, warnings []string) {
+	apex := canonicalDNSName(zoneName)
+	inZone := func(name string) bool {
+		return name == apex || strings.HasSuffix(name, "."+apex)
+	}
+
+	// Enabled record contents by owner name and type
+	names := map[string]map[string][]string{}
+	var order []string
+	for _, rrset := range rrsets {
+		name := canonicalDNSName(rrset.Name)
+		if rrset.Name == "@" {
+			name = apex
+		}
+		for _, record := range rrset.Records {
+			if record.Disabled {
+				continue
+			}
+			if names[name] == nil {
+				names[name] = map[string][]string{}
+				order = append(order, name)
+			}
+			recordType := strings.ToUpper(rrset.Type)
+			names[name][recordType] = append(names[name][recordType], record.Content)
+		}
+	}
+
+	// Names at or below a delegation are served by the child zone
+	var delegations []string
+	for _, name := range order {
+		if name != apex && len(names[name]["NS"]) > 0 {
+			delegations = append(delegations, name)
+		}
+	}
+	delegated := func(name string) bool {
+		for _, cut := range delegations {
+			if name == cut || strings.HasSuffix(name, "."+cut) {
+				return true
+			}
+		}
+		return false
+	}
+
+	switch soa := len(names[apex]["SOA"]); {
+	case soa == 0:
+		problems = append(problems, "no SOA record at the zone apex")
+	case soa > 1:
+		problems = append(problems, fmt.Sprintf("%d SOA records at the zone apex; exactly one is allowed", soa))
+	}
+	switch ns := len(names[apex]["NS"]); {
+	case ns == 0:
+		problems = append(problems, "no NS records at the zone apex")
+	case ns == 1:
+		warnings = append(warnings, "only one nameserver at the zone apex; at least two are recommended for redundancy")
+	}
+
+	for _, name := range order {
+		byType := names[name]
+		if !inZone(name) {
+			problems = append(problems, fmt.Sprintf("%s is outside the zone", name))
+			continue
+		}
+		if cnames := len(byType["CNAME"]); cnames > 0 {
+			if cnames > 1 {
+				problems = append(problems, fmt.Sprintf("%s has %d CNAME records; only one is allowed", name, cnames))
+			}
+			var others []string
+			for recordType := range byType {
+				if recordType != "CNAME" {
+					others = append(others, recordType)
+				}
+			}
+			if len(others) > 0 {
+				slices.Sort(others)
+				problems = append(problems, fmt.Sprintf("%s has a CNAME next to other records (%s)", name, strings.Join(others, ", ")))
+			}
+		}
+
+		for _, recordType := range []string{"NS", "MX", "SRV"} {
+			for _, content := range byType[recordType] {
+				fields := strings.Fields(content)
+				if len(fields) == 0 {
+					continue
+				}
+				target := canonicalDNSName(fields[len(fields)-1])
+				// A null MX or SRV target ("."), an out-of-zone target, or one
+				// served by a child zone cannot be checked here; in-zone NS
+				// targets below a delegation are glue and must be present.
+				if target == "" || !inZone(target) || (recordType != "NS" && delegated(target)) {
+					continue
+				}
+				targetTypes := names[target]
+				switch {
+				case len(targetTypes["CNAME"]) > 0:
+					problems = append(problems, fmt.Sprintf("%s %s target %s is a CNAME", name, recordType, target))
+				case len(targetTypes["A"]) == 0 && len(targetTypes["AAAA"]) == 0:
+					if recordType == "NS" {
+						problems = append(problems, fmt.Sprintf("%s NS target %s is inside the zone but has no A or AAAA glue records", name, target))
+					} else {
+						problems = append(problems, fmt.Sprintf("%s %s target %s has no A or AAAA records", name, recordType, target))
+					}
+				}
+			}
+		}
+	}
+	return problems, warnings
+}
@@ -0,0 +1,107 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateZoneRRSets(t *testing.T) {
+	rrset := func(name, recordType string, contents ...string) RRSet {
+		records := make([]RRSetRecord, len(contents))
+		for i, content := range contents {
+			records[i] = RRSetRecord{Content: content}
+		}
+		return RRSet{Name: name, Type: recordType, TTL: 3600, Records: records}
+	}
+	valid := []RRSet{
+		rrset("example.com", "SOA", "ns1.example.com. hostmaster.example.com. 2026101601 10800 3600 604800 3600"),
+		rrset("example.com", "NS", "ns1.example.com.", "ns2.example.net."),
+		rrset("example.com", "MX", "10 mail.example.com."),
+		rrset("ns1.example.com", "A", "192.0.2.1"),
+		rrset("mail.example.com", "AAAA", "2001:db8::25"),
+		rrset("www.example.com", "CNAME", "example.com."),
+		rrset("_sip._tcp.example.com", "SRV", "10 5060 ."),
+		// Delegation with glue; targets inside the child zone are not checked
+		rrset("sub.example.com", "NS", "ns.sub.example.com."),
+		rrset("ns.sub.example.com", "A", "192.0.2.53"),
+		rrset("sub.example.com", "MX", "10 mx.sub.example.com."),
+	}
+
+	tests := []struct {
+		name         string
+		rrsets       []RRSet
+		wantProblems []string
+		wantWarnings []string
+	}{
+		{"valid zone", valid, nil, nil},
+		{
+			"missing SOA and NS",
+			[]RRSet{rrset("www.example.com", "A", "192.0.2.1")},
+			[]string{"no SOA record", "no NS records"},
+			nil,
+		},
+		{
+			"single nameserver",
+			[]RRSet{
+				rrset("example.com", "SOA", "ns.example.net. hostmaster.example.com. 1 10800 3600 604800 3600"),
+				rrset("example.com", "NS", "ns.example.net."),
+			},
+			nil,
+			[]string{"only one nameserver"},
+		},
+		{
+			"CNAME conflicts and dangling targets",
+			append(append([]RRSet{}, valid...),
+				rrset("www.example.com", "TXT", "\"hello\""),
+				rrset("example.com", "MX", "20 www.example.com."),
+				rrset("sub2.example.com", "NS", "ns.sub2.example.com."),
+				rrset("_xmpp._tcp.example.com", "SRV", "5 5269 xmpp.example.com."),
+				rrset("stray.example.org", "A", "192.0.2.9"),
+			),
+			[]string{
+				"www.example.com has a CNAME next to other records (TXT)",
+				"MX target www.example.com is a CNAME",
+				"NS target ns.sub2.example.com is inside the zone but has no A or AAAA glue",
+				"SRV target xmpp.example.com has no A or AAAA",
+				"stray.example.org is outside the zone",
+			},
+			nil,
+		},
+		{
+			"disabled records are ignored",
+			append(append([]RRSet{}, valid...), RRSet{
+				Name: "www.example.com", Type: "TXT",
+				Records: []RRSetRecord{{Content: "\"off\"", Disabled: true}},
+			}),
+			nil,
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			problems, warnings := validateZoneRRSets("example.com", tt.rrsets)
+			checkMessages(t, "problems", problems, tt.wantProblems)
+			checkMessages(t, "warnings", warnings, tt.wantWarnings)
+		})
+	}
+}
+
+// checkMessages expects one message containing each wanted substring, and no others.
+func checkMessages(t *testing.T, kind string, got, want []string) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("expected %d %s, got %d: %q", len(want), kind, len(got), got)
+	}
+	for _, substr := range want {
+		found := false
+		for _, message := range got {
+			found = found || strings.Contains(message, substr)
+		}
+		if !found {
+			t.Errorf("expected %s to contain %q, got %q", kind, substr, got)
+		}
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// defaultVaultAPIKeyField is the secret field holding the API key unless
+// vault_api_key_field says otherwise.
+const defaultVaultAPIKeyField = "api_key"
+
+// vaultRequestTimeout bounds each read of the API key from Vault.
+const vaultRequestTimeout = 30 * time.Second
+
+// vaultToken returns the Vault token from VAULT_TOKEN, falling back to the
+// ~/.vault-token file written by `vault login`.
+func vaultToken() (string, error) {
+	if token := strings.TrimSpace(os.Getenv("VAULT_TOKEN")); token != "" {
+		return token, nil
+	}
+	if home, err := os.UserHomeDir(); err == nil {
+		if data, err := os.ReadFile(filepath.Join(home, ".vault-token")); err == nil {
+			if token := strings.TrimSpace(string(data)); token != "" {
+				return token, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("no Vault token: set VAULT_TOKEN or run `vault login`")
+}
+
+// vaultHTTPClient returns an HTTP client for the Vault server that honors
+// the Vault CLI's TLS settings: VAULT_CACERT, a PEM file, or else VAULT_CAPATH,
+// a directory of PEM files, replaces the system CA pool, and
+// VAULT_SKIP_VERIFY disables certificate verification.
+func vaultHTTPClient() (*http.Client, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if value := os.Getenv("VAULT_SKIP_VERIFY"); value != "" {
+		skip, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid VAULT_SKIP_VERIFY %q: must be a boolean", value)
+		}
+		tlsConfig.InsecureSkipVerify = skip //nolint:gosec // G402: opt-in via VAULT_SKIP_VERIFY, as in the Vault CLI
+	}
+
+	var caFiles []string
+	if caCert := os.Getenv("VAULT_CACERT"); caCert != "" {
+		caFiles = []string{caCert}
+	} else if caPath := os.Getenv("VAULT_CAPATH"); caPath != "" {
+		entries, err := os.ReadDir(caPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read VAULT_CAPATH: %w", err)
+		}
+		for _, entry := range entries {
+			if !entry.IsDir() {
+				caFiles = append(caFiles, filepath.Join(caPath, entry.Name()))
+			}
+		}
+	}
+	if len(caFiles) > 0 {
+		pool := x509.NewCertPool()
+		for _, file := range caFiles {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read Vault CA certificate: %w", err)
+			}
+			pool.AppendCertsFromPEM(data)
+		}
+		if pool.Equal(x509.NewCertPool()) {
+			return nil, fmt.Errorf("no PEM certificates found in VAULT_CACERT or VAULT_CAPATH")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	return &http.Client{Timeout: vaultRequestTimeout, Transport: transport}, nil
+}
+
+// readVaultAPIKey reads field from the KV secret at secretPath, e.g.
+// "secret/data/poweradmin" for a KV version 2 engine mounted at secret/, from
+// the Vault server at VAULT_ADDR. Both KV versions are supported; VAULT_NAMESPACE
+// is sent when set.
+func readVaultAPIKey(ctx context.Context, secretPath, field string) (string, error) {
+	addr := strings.TrimRight(os.Getenv("VAULT_ADDR"), "/")
+	if addr == "" {
+		return "", fmt.Errorf("VAULT_ADDR must be set to read the API key from Vault")
+	}
+	if parsed, err := url.Parse(addr); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return "", fmt.Errorf("invalid VAULT_ADDR %q: must be an http(s) URL with a host", addr)
+	}
+	token, err := vaultToken()
+	if err != nil {
+		return "", err
+	}
+
+	httpClient, err := vaultHTTPClient()
+	if err != nil {
+		return "", err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, vaultRequestTimeout)
+	defer cancel()
+
+	secretPath = strings.Trim(secretPath, "/")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, addr+"/v1/"+secretPath, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create Vault request: %w", err)
+	}
+	req.Header.Set("X-Vault-Token", token)
+	req.Header.Set("X-Vault-Request", "true")
+	if namespace := os.Getenv("VAULT_NAMESPACE"); namespace != "" {
+		req.Header.Set("X-Vault-Namespace", namespace)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("Vault request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+	if err != nil {
+		return "", fmt.Errorf("failed to read Vault response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var vaultErr struct {
+			Errors []string `json:"errors"`
+		}
+		msg := http.StatusText(resp.StatusCode)
+		if json.Unmarshal(body, &vaultErr) == nil && len(vaultErr.Errors) > 0 {
+			msg = strings.Join(vaultErr.Errors, "; ")
+		}
+		return "", fmt.Errorf("failed to read Vault secret %s (HTTP %d): %s", secretPath, resp.StatusCode, msg)
+	}
+
+	var secret struct {
+		Data map[string]json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(body, &secret); err != nil {
+		return "", fmt.Errorf("failed to parse Vault response: %w", err)
+	}
+
+	// KV version 2 nests the secret under data.data, next to data.metadata
+	fields := secret.Data
+	if nested, ok := fields["data"]; ok {
+		if _, versioned := fields["metadata"]; versioned {
+			fields = nil
+			if err := json.Unmarshal(nested, &fields); err != nil {
+				return "", fmt.Errorf("failed to parse Vault secret %s: %w", secretPath, err)
+			}
+		}
+	}
+
+	raw, ok := fields[field]
+	if !ok {
+		return "", fmt.Errorf("Vault secret %s has no field %q", secretPath, field)
+	}
+	var key string
+	if err := json.Unmarshal(raw, &key); err != nil {
+		return "", fmt.Errorf("field %q of Vault secret %s is not a string", field, secretPath)
+	}
+	if key = strings.TrimSpace(key); key == "" {
+		return "", fmt.Errorf("field %q of Vault secret %s is empty", field, secretPath)
+	}
+	return key, nil
+}
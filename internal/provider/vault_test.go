@@ -0,0 +1,165 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testVaultServer serves KV secrets by API path and requires the token "root".
+func testVaultServer(t *testing.T, secrets map[string]string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "root" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+			return
+		}
+		body, ok := secrets[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":[]}`))
+			return
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestReadVaultAPIKey(t *testing.T) {
+	vault := testVaultServer(t, map[string]string{
+		"/v1/secret/data/poweradmin": `{"data":{"data":{"api_key":"kv2-key","token":"other"},"metadata":{"version":3}}}`,
+		"/v1/kv/poweradmin":          `{"data":{"api_key":"kv1-key","count":2}}`,
+	})
+	t.Setenv("VAULT_ADDR", vault.URL+"/")
+	t.Setenv("VAULT_TOKEN", "root")
+
+	tests := []struct {
+		name    string
+		path    string
+		field   string
+		want    string
+		wantErr string
+	}{
+		{"KV version 2", "secret/data/poweradmin", "api_key", "kv2-key", ""},
+		{"KV version 2 custom field", "/secret/data/poweradmin", "token", "other", ""},
+		{"KV version 1", "kv/poweradmin", "api_key", "kv1-key", ""},
+		{"missing field", "kv/poweradmin", "apikey", "", `has no field "apikey"`},
+		{"non-string field", "kv/poweradmin", "count", "", "is not a string"},
+		{"missing secret", "kv/other", "api_key", "", "(HTTP 404)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := readVaultAPIKey(context.Background(), tt.path, tt.field)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("wrong token", func(t *testing.T) {
+		t.Setenv("VAULT_TOKEN", "wrong")
+		_, err := readVaultAPIKey(context.Background(), "kv/poweradmin", "api_key")
+		if err == nil || !strings.Contains(err.Error(), "(HTTP 403): permission denied") {
+			t.Fatalf("expected a permission error, got %v", err)
+		}
+	})
+	t.Run("no address", func(t *testing.T) {
+		t.Setenv("VAULT_ADDR", "")
+		_, err := readVaultAPIKey(context.Background(), "kv/poweradmin", "api_key")
+		if err == nil || !strings.Contains(err.Error(), "VAULT_ADDR must be set") {
+			t.Fatalf("expected a missing address error, got %v", err)
+		}
+	})
+}
+
+func TestReadVaultAPIKey_TLS(t *testing.T) {
+	vault := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"api_key":"tls-key"}}`))
+	}))
+	t.Cleanup(vault.Close)
+	t.Setenv("VAULT_ADDR", vault.URL)
+	t.Setenv("VAULT_TOKEN", "root")
+
+	caDir := t.TempDir()
+	caFile := filepath.Join(caDir, "ca.pem")
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: vault.Certificate().Raw})
+	if err := os.WriteFile(caFile, cert, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		env     map[string]string
+		wantErr string
+	}{
+		{"untrusted", nil, "certificate"},
+		{"VAULT_CACERT", map[string]string{"VAULT_CACERT": caFile}, ""},
+		{"VAULT_CAPATH", map[string]string{"VAULT_CAPATH": caDir}, ""},
+		{"VAULT_SKIP_VERIFY", map[string]string{"VAULT_SKIP_VERIFY": "true"}, ""},
+		{"invalid VAULT_SKIP_VERIFY", map[string]string{"VAULT_SKIP_VERIFY": "maybe"}, "invalid VAULT_SKIP_VERIFY"},
+		{"no certificates", map[string]string{"VAULT_CACERT": filepath.Join(caDir, "missing.pem")}, "failed to read Vault CA certificate"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range []string{"VAULT_CACERT", "VAULT_CAPATH", "VAULT_SKIP_VERIFY"} {
+				t.Setenv(name, tt.env[name])
+			}
+			got, err := readVaultAPIKey(context.Background(), "kv/poweradmin", "api_key")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil || got != "tls-key" {
+				t.Errorf("got %q, %v", got, err)
+			}
+		})
+	}
+}
+
+func TestNewClient_VaultAPIKey(t *testing.T) {
+	secrets := map[string]string{"/v1/secret/data/poweradmin": `{"data":{"data":{"key":"first"},"metadata":{}}}`}
+	vault := testVaultServer(t, secrets)
+	t.Setenv("VAULT_ADDR", vault.URL)
+	t.Setenv("VAULT_TOKEN", "root")
+
+	client, err := NewClient(context.Background(), &PoweradminProviderModel{
+		ApiUrl:           types.StringValue("https://dns.example.com"),
+		VaultAPIKeyPath:  types.StringValue("secret/data/poweradmin"),
+		VaultAPIKeyField: types.StringValue("key"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if client.APIKey != "first" {
+		t.Errorf("expected the key from Vault, got %q", client.APIKey)
+	}
+
+	// A rotated secret is picked up on reload
+	secrets["/v1/secret/data/poweradmin"] = `{"data":{"data":{"key":"second"},"metadata":{}}}`
+	if key, err := client.ReloadAPIKey(context.Background()); err != nil || key != "second" {
+		t.Errorf("expected the rotated key, got %q, %v", key, err)
+	}
+}
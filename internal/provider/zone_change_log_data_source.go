@@ -0,0 +1,168 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ZoneChangeLogDataSource{}
+
+// defaultZoneChangeLogLimit bounds the entries returned when limit is unset.
+const defaultZoneChangeLogLimit = 100
+
+func NewZoneChangeLogDataSource() datasource.DataSource {
+	return &ZoneChangeLogDataSource{}
+}
+
+// ZoneChangeLogDataSource defines the data source implementation.
+type ZoneChangeLogDataSource struct {
+	client *Client
+}
+
+// ZoneChangeLogDataSourceModel describes the data source data model.
+type ZoneChangeLogDataSourceModel struct {
+	ZoneID  types.Int64              `tfsdk:"zone_id"`
+	Limit   types.Int64              `tfsdk:"limit"`
+	Entries []ZoneChangeLogDataModel `tfsdk:"entries"`
+}
+
+// ZoneChangeLogDataModel describes a single change-log entry.
+type ZoneChangeLogDataModel struct {
+	ID        types.Int64  `tfsdk:"id"`
+	User      types.String `tfsdk:"user"`
+	Event     types.String `tfsdk:"event"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+func (d *ZoneChangeLogDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_zone_change_log"
+}
+
+func (d *ZoneChangeLogDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches recent change-log entries for a zone: who changed it, when, and what was done. Useful for generating audit reports from Terraform outputs. Requires Poweradmin 4.2.0+.",
+
+		Attributes: map[string]schema.Attribute{
+			"zone_id": schema.Int64Attribute{
+				MarkdownDescription: "Zone ID to read the change log of",
+				Required:            true,
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of entries to return, newest first. Defaults to %d.", defaultZoneChangeLogLimit),
+				Optional:            true,
+			},
+			"entries": schema.ListNestedAttribute{
+				MarkdownDescription: "Change-log entries, newest first",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Log entry ID",
+							Computed:            true,
+						},
+						"user": schema.StringAttribute{
+							MarkdownDescription: "Username that made the change",
+							Computed:            true,
+						},
+						"event": schema.StringAttribute{
+							MarkdownDescription: "Description of the change as recorded by Poweradmin",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Time of the change",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ZoneChangeLogDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ZoneChangeLogDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ZoneChangeLogDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check for unknown values - data sources cannot be read until all inputs are known
+	if data.ZoneID.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("zone_id"),
+			"Unknown zone_id value",
+			"The zone_id value is unknown at plan time. Data sources cannot be read until all configuration values are known.",
+		)
+		return
+	}
+
+	limit := int64(defaultZoneChangeLogLimit)
+	if !data.Limit.IsNull() && !data.Limit.IsUnknown() {
+		limit = data.Limit.ValueInt64()
+		if limit < 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("limit"),
+				"Invalid limit",
+				fmt.Sprintf("limit must be at least 1, got %d.", limit),
+			)
+			return
+		}
+	}
+
+	logs, err := d.client.ListZoneLogs(ctx, data.ZoneID.ValueInt64(), limit)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read zone change log, got error: %s", err))
+		return
+	}
+	// Don't trust the server to honour the limit
+	if int64(len(logs)) > limit {
+		logs = logs[:limit]
+	}
+
+	// Map response to model
+	entries := make([]ZoneChangeLogDataModel, len(logs))
+	for i, entry := range logs {
+		entries[i] = ZoneChangeLogDataModel{
+			ID:        types.Int64Value(int64(entry.ID)),
+			User:      types.StringValue(entry.User),
+			Event:     types.StringValue(entry.Event),
+			CreatedAt: types.StringValue(entry.CreatedAt),
+		}
+	}
+	data.Entries = entries
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -34,6 +35,10 @@ type ZoneDataSourceModel struct {
 	Masters     types.String `tfsdk:"masters"`
 	Account     types.String `tfsdk:"account"`
 	Description types.String `tfsdk:"description"`
+	Tags        types.Map    `tfsdk:"tags"`
+
+	IncludeRRSets types.Bool       `tfsdk:"include_rrsets"`
+	RRSets        []RRSetDataModel `tfsdk:"rrsets"`
 }
 
 func (d *ZoneDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -71,6 +76,20 @@ func (d *ZoneDataSource) Schema(ctx context.Context, req datasource.SchemaReques
 				MarkdownDescription: "Description of the zone",
 				Computed:            true,
 			},
+			"tags": schema.MapAttribute{
+				MarkdownDescription: "Tags of the zone, as set by the `tags` attribute of `poweradmin_zone`",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"include_rrsets": schema.BoolAttribute{
+				MarkdownDescription: "Also read every RRSet of the zone into `rrsets`, so modules needing both the zone and its contents do not need a separate `poweradmin_rrsets` data source. Defaults to `false`.",
+				Optional:            true,
+			},
+			"rrsets": schema.ListNestedAttribute{
+				MarkdownDescription: "RRSets of the zone, as returned by the `poweradmin_rrsets` data source; null unless `include_rrsets` is `true`",
+				Computed:            true,
+				NestedObject:        rrsetDataNestedObject(),
+			},
 		},
 	}
 }
@@ -150,7 +169,7 @@ func (d *ZoneDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 
 	// Map API response to data source model
 	data.ID = types.StringValue(strconv.Itoa(zone.ID))
-	data.Name = types.StringValue(zone.Name)
+	data.Name = types.StringValue(normalizeIDNName(data.Name.ValueString(), zone.Name))
 	data.Type = types.StringValue(zone.Type)
 
 	if zone.Masters != "" {
@@ -171,6 +190,30 @@ func (d *ZoneDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		data.Description = types.StringNull()
 	}
 
+	tags, err := d.client.GetZoneTags(ctx, int64(zone.ID))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Zone Tags",
+			fmt.Sprintf("Could not read tags of zone ID %d: %s", zone.ID, err.Error()),
+		)
+		return
+	}
+	var diags diag.Diagnostics
+	data.Tags, diags = types.MapValueFrom(ctx, types.StringType, tags)
+	resp.Diagnostics.Append(diags...)
+
+	if data.IncludeRRSets.ValueBool() {
+		rrsets, err := d.client.ListRRSets(ctx, int64(zone.ID), "")
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading RRSets",
+				fmt.Sprintf("Could not read RRSets for zone %d: %s", zone.ID, err.Error()),
+			)
+			return
+		}
+		data.RRSets = rrsetDataModels(int64(zone.ID), rrsets)
+	}
+
 	tflog.Trace(ctx, "Read zone data source")
 
 	// Save data into Terraform state
@@ -22,6 +22,20 @@ func TestAccZoneDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.poweradmin_zone.test", "name", "test-datasource.example.com"),
 					resource.TestCheckResourceAttr("data.poweradmin_zone.test", "type", "MASTER"),
 					resource.TestCheckResourceAttrSet("data.poweradmin_zone.test", "id"),
+					resource.TestCheckNoResourceAttr("data.poweradmin_zone.test", "rrsets.#"),
+				),
+			},
+			// Read the zone contents inline
+			{
+				Config: testAccZoneDataSourceConfig("test-datasource.example.com") + `
+data "poweradmin_zone" "with_rrsets" {
+  id             = poweradmin_zone.test.id
+  include_rrsets = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.poweradmin_zone.with_rrsets", "name", "test-datasource.example.com"),
+					resource.TestCheckResourceAttrSet("data.poweradmin_zone.with_rrsets", "rrsets.#"),
 				),
 			},
 		},
@@ -0,0 +1,117 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ZoneDefaultsDataSource{}
+
+func NewZoneDefaultsDataSource() datasource.DataSource {
+	return &ZoneDefaultsDataSource{}
+}
+
+// ZoneDefaultsDataSource defines the data source implementation.
+type ZoneDefaultsDataSource struct {
+	client *Client
+}
+
+// ZoneDefaultsDataSourceModel describes the data source data model.
+type ZoneDefaultsDataSourceModel struct {
+	Hostmaster  types.String `tfsdk:"hostmaster"`
+	Nameservers types.List   `tfsdk:"nameservers"`
+	DefaultTTL  types.Int64  `tfsdk:"default_ttl"`
+	RecordTypes types.List   `tfsdk:"record_types"`
+}
+
+func (d *ZoneDefaultsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_zone_defaults"
+}
+
+func (d *ZoneDefaultsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves the DNS defaults configured on the Poweradmin server (default nameservers, SOA hostmaster, default TTL, and allowed record types), so modules can align the records they create with server policy.",
+
+		Attributes: map[string]schema.Attribute{
+			"hostmaster": schema.StringAttribute{
+				MarkdownDescription: "Default SOA hostmaster (contact) used for new zones",
+				Computed:            true,
+			},
+			"nameservers": schema.ListAttribute{
+				MarkdownDescription: "Default nameservers written into new zones, in configured order",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"default_ttl": schema.Int64Attribute{
+				MarkdownDescription: "Default TTL in seconds for new records",
+				Computed:            true,
+			},
+			"record_types": schema.ListAttribute{
+				MarkdownDescription: "Record types the server allows to be created",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *ZoneDefaultsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ZoneDefaultsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ZoneDefaultsDataSourceModel
+
+	tflog.Debug(ctx, "Reading zone defaults")
+
+	defaults, err := d.client.GetZoneDefaults(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Zone Defaults",
+			fmt.Sprintf("Could not read DNS defaults: %s", err.Error()),
+		)
+		return
+	}
+
+	data.Hostmaster = types.StringValue(defaults.Hostmaster)
+	data.DefaultTTL = types.Int64Value(int64(defaults.TTL))
+
+	nameservers, diags := types.ListValueFrom(ctx, types.StringType, nonNilStrings(defaults.Nameservers))
+	resp.Diagnostics.Append(diags...)
+	recordTypes, diags := types.ListValueFrom(ctx, types.StringType, nonNilStrings(defaults.RecordTypes))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Nameservers = nameservers
+	data.RecordTypes = recordTypes
+
+	tflog.Trace(ctx, "Read zone defaults data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
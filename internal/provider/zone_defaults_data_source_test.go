@@ -0,0 +1,29 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccZoneDefaultsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+data "poweradmin_zone_defaults" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.poweradmin_zone_defaults.test", "default_ttl"),
+					resource.TestCheckResourceAttrSet("data.poweradmin_zone_defaults.test", "nameservers.#"),
+					resource.TestCheckResourceAttrSet("data.poweradmin_zone_defaults.test", "record_types.#"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,227 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ZoneDSRecordsDataSource{}
+
+func NewZoneDSRecordsDataSource() datasource.DataSource {
+	return &ZoneDSRecordsDataSource{}
+}
+
+// ZoneDSRecordsDataSource defines the data source implementation.
+type ZoneDSRecordsDataSource struct {
+	client *Client
+}
+
+// ZoneDSRecordsDataSourceModel describes the data source data model.
+type ZoneDSRecordsDataSourceModel struct {
+	ZoneID     types.Int64         `tfsdk:"zone_id"`
+	DigestType types.Int64         `tfsdk:"digest_type"`
+	DSRecords  []DSRecordDataModel `tfsdk:"ds_records"`
+	DS         []types.String      `tfsdk:"ds"`
+}
+
+// DSRecordDataModel describes a single DS record and the key it covers.
+type DSRecordDataModel struct {
+	KeyID      types.Int64  `tfsdk:"key_id"`
+	KeyTag     types.Int64  `tfsdk:"key_tag"`
+	Algorithm  types.Int64  `tfsdk:"algorithm"`
+	DigestType types.Int64  `tfsdk:"digest_type"`
+	Digest     types.String `tfsdk:"digest"`
+	DS         types.String `tfsdk:"ds"`
+	Flags      types.Int64  `tfsdk:"flags"`
+	PublicKey  types.String `tfsdk:"public_key"`
+	DNSKey     types.String `tfsdk:"dnskey"`
+}
+
+func (d *ZoneDSRecordsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_zone_ds_records"
+}
+
+func (d *ZoneDSRecordsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Returns the DS records of a DNSSEC-signed zone, for publishing the delegation at the registrar or parent zone. " +
+			"Each record is given as a ready-to-paste DS string and as separate key tag, algorithm, digest type and digest values, " +
+			"along with the DNSKEY flags and public key for registrars that take the key instead. Only active, published KSK and CSK keys are included.",
+
+		Attributes: map[string]schema.Attribute{
+			"zone_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the signed zone",
+				Required:            true,
+			},
+			"digest_type": schema.Int64Attribute{
+				MarkdownDescription: "Only return DS records with this digest type, e.g. `2` (SHA-256), which most registrars require. By default every digest the server computes is returned.",
+				Optional:            true,
+			},
+			"ds": schema.ListAttribute{
+				MarkdownDescription: "DS records as `key_tag algorithm digest_type digest` strings",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"ds_records": schema.ListNestedAttribute{
+				MarkdownDescription: "DS records with their fields and the key they cover",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key_id": schema.Int64Attribute{
+							MarkdownDescription: "ID of the DNSSEC key on the server",
+							Computed:            true,
+						},
+						"key_tag": schema.Int64Attribute{
+							MarkdownDescription: "Key tag",
+							Computed:            true,
+						},
+						"algorithm": schema.Int64Attribute{
+							MarkdownDescription: "DNSSEC algorithm number, e.g. `13` (ECDSAP256SHA256)",
+							Computed:            true,
+						},
+						"digest_type": schema.Int64Attribute{
+							MarkdownDescription: "Digest type, e.g. `2` (SHA-256)",
+							Computed:            true,
+						},
+						"digest": schema.StringAttribute{
+							MarkdownDescription: "Digest in upper-case hexadecimal",
+							Computed:            true,
+						},
+						"ds": schema.StringAttribute{
+							MarkdownDescription: "The whole record as a `key_tag algorithm digest_type digest` string",
+							Computed:            true,
+						},
+						"flags": schema.Int64Attribute{
+							MarkdownDescription: "DNSKEY flags, `257` for key signing keys",
+							Computed:            true,
+						},
+						"public_key": schema.StringAttribute{
+							MarkdownDescription: "Base64 public key of the DNSKEY",
+							Computed:            true,
+						},
+						"dnskey": schema.StringAttribute{
+							MarkdownDescription: "The DNSKEY record as `flags protocol algorithm public_key`",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ZoneDSRecordsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ZoneDSRecordsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ZoneDSRecordsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check for unknown values - data sources cannot be read until all inputs are known
+	if data.ZoneID.IsUnknown() || data.DigestType.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Unknown configuration value",
+			"The zone_id or digest_type value is unknown at plan time. Data sources cannot be read until all configuration values are known.",
+		)
+		return
+	}
+
+	zoneID := data.ZoneID.ValueInt64()
+	keys, err := d.client.ListDNSSECKeys(ctx, zoneID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DNSSEC keys of zone %d, got error: %s", zoneID, err))
+		return
+	}
+
+	records, err := dsRecordModels(keys, data.DigestType)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid DNSSEC Key Data", fmt.Sprintf("Zone %d: %s", zoneID, err))
+		return
+	}
+	if len(records) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("zone_id"),
+			"No DS Records",
+			fmt.Sprintf("Zone %d has no active, published key signing key with a matching DS record. Sign the zone (or activate its KSK) first.", zoneID),
+		)
+		return
+	}
+
+	data.DSRecords = records
+	data.DS = make([]types.String, len(records))
+	for i, record := range records {
+		data.DS[i] = record.DS
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// dsRecordModels returns the DS records of the active, published key
+// signing keys (KSK or CSK), optionally only those of one digest type.
+func dsRecordModels(keys []DNSSECKey, digestType types.Int64) ([]DSRecordDataModel, error) {
+	var records []DSRecordDataModel
+	for _, key := range keys {
+		keyType := strings.ToLower(key.KeyType)
+		if !key.Active || !key.Published || (keyType != "ksk" && keyType != "csk") {
+			continue
+		}
+		flags, _, publicKey, err := parseDNSKEY(key.DNSKey)
+		if err != nil {
+			return nil, err
+		}
+		for _, value := range key.DS {
+			ds, err := parseDSRecord(value)
+			if err != nil {
+				return nil, err
+			}
+			if !digestType.IsNull() && int64(ds.DigestType) != digestType.ValueInt64() {
+				continue
+			}
+			records = append(records, DSRecordDataModel{
+				KeyID:      types.Int64Value(int64(key.ID)),
+				KeyTag:     types.Int64Value(int64(ds.KeyTag)),
+				Algorithm:  types.Int64Value(int64(ds.Algorithm)),
+				DigestType: types.Int64Value(int64(ds.DigestType)),
+				Digest:     types.StringValue(ds.Digest),
+				DS:         types.StringValue(ds.String()),
+				Flags:      types.Int64Value(int64(flags)),
+				PublicKey:  types.StringValue(publicKey),
+				DNSKey:     types.StringValue(key.DNSKey),
+			})
+		}
+	}
+	return records, nil
+}
@@ -0,0 +1,77 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseDSRecord(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{"sha256", "12345 13 2 3e0b1a6b4b5ee7c0dd8e8cc1f6e3f8d21e2a2d37b1a4c9bde1bd0dc2a8c4f0a1", "12345 13 2 3E0B1A6B4B5EE7C0DD8E8CC1F6E3F8D21E2A2D37B1A4C9BDE1BD0DC2A8C4F0A1", false},
+		{"split digest", "12345 8 2 3e0b1a6b 4b5ee7c0", "12345 8 2 3E0B1A6B4B5EE7C0", false},
+		{"missing digest", "12345 13 2", "", true},
+		{"non-numeric key tag", "abc 13 2 3e0b", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseDSRecord(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseDSRecord(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if !tt.wantErr && got.String() != tt.want {
+				t.Errorf("parseDSRecord(%q) = %q, want %q", tt.value, got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestDSRecordModels(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/api/v2/zones/10/dnssec/keys" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		respondJSON(t, w, DNSSECKeyListResponse{Keys: []DNSSECKey{
+			{ID: 1, KeyType: "ksk", Active: true, Published: true, DNSKey: "257 3 13 mdsswUyr3DPW 132myNFI",
+				DS: []string{"12345 13 1 a1b2c3", "12345 13 2 d4e5f6"}},
+			{ID: 2, KeyType: "zsk", Active: true, Published: true, DNSKey: "256 3 13 abcd",
+				DS: []string{"54321 13 2 aaaa"}},
+			{ID: 3, KeyType: "ksk", Active: false, Published: true, DNSKey: "257 3 13 efgh",
+				DS: []string{"11111 13 2 bbbb"}},
+		}})
+	})
+
+	keys, err := client.ListDNSSECKeys(context.Background(), 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	records, err := dsRecordModels(keys, types.Int64Null())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("expected the 2 DS records of the active KSK, got %d", len(records))
+	}
+	if records[0].Flags.ValueInt64() != 257 || records[0].PublicKey.ValueString() != "mdsswUyr3DPW132myNFI" {
+		t.Errorf("unexpected DNSKEY fields: flags %d, public key %q", records[0].Flags.ValueInt64(), records[0].PublicKey.ValueString())
+	}
+
+	records, err = dsRecordModels(keys, types.Int64Value(2))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(records) != 1 || records[0].DS.ValueString() != "12345 13 2 D4E5F6" {
+		t.Errorf("expected only the SHA-256 DS record, got %+v", records)
+	}
+}
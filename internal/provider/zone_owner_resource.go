@@ -0,0 +1,261 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &ZoneOwnerResource{}
+var _ resource.ResourceWithImportState = &ZoneOwnerResource{}
+
+func NewZoneOwnerResource() resource.Resource {
+	return &ZoneOwnerResource{}
+}
+
+// ZoneOwnerResource defines the resource implementation.
+type ZoneOwnerResource struct {
+	client *Client
+}
+
+// ZoneOwnerResourceModel describes the resource data model.
+type ZoneOwnerResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	ZoneID   types.Int64  `tfsdk:"zone_id"`
+	UserID   types.Int64  `tfsdk:"user_id"`
+	Username types.String `tfsdk:"username"`
+}
+
+func (r *ZoneOwnerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_zone_owner"
+}
+
+func (r *ZoneOwnerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Makes a user one of the owners of a DNS zone. Poweradmin allows several owners per zone; declare one resource per owner. " +
+			"Owners not declared in Terraform, such as the user that created the zone, are left untouched. " +
+			"Adding a user who already owns the zone fails with an import hint instead of silently adopting the ownership.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Composite identifier in the format `zone_id/user_id`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"zone_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the zone",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the user to make an owner of the zone",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Username of the owner",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ZoneOwnerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// findZoneOwner returns the owner entry for userID, or nil.
+func findZoneOwner(owners []ZoneOwner, userID int) *ZoneOwner {
+	for i := range owners {
+		if owners[i].UserID == userID {
+			return &owners[i]
+		}
+	}
+	return nil
+}
+
+func (r *ZoneOwnerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ZoneOwnerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneID := int(data.ZoneID.ValueInt64())
+	userID := int(data.UserID.ValueInt64())
+
+	owners, err := r.client.ListZoneOwners(ctx, zoneID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Zone Owners",
+			fmt.Sprintf("Could not read owners of zone %d: %s", zoneID, err.Error()),
+		)
+		return
+	}
+	if findZoneOwner(owners, userID) != nil {
+		resp.Diagnostics.AddError(
+			"User Already Owns Zone",
+			fmt.Sprintf("User %d already owns zone %d, possibly as its creator or through another configuration. Import the ownership to manage it here:\n\n  terraform import <address> %d/%d",
+				userID, zoneID, zoneID, userID),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Adding zone owner", map[string]interface{}{
+		"zone_id": zoneID,
+		"user_id": userID,
+	})
+
+	if err := r.client.AddZoneOwner(ctx, zoneID, userID); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Adding Zone Owner",
+			fmt.Sprintf("Could not add user %d as owner of zone %d: %s", userID, zoneID, err.Error()),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%d/%d", zoneID, userID))
+	data.Username = types.StringNull()
+	if owners, err := r.client.ListZoneOwners(ctx, zoneID); err == nil {
+		if owner := findZoneOwner(owners, userID); owner != nil {
+			data.Username = types.StringValue(owner.Username)
+		}
+	}
+
+	tflog.Debug(ctx, "Zone owner added successfully")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneOwnerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ZoneOwnerResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneID := int(data.ZoneID.ValueInt64())
+	userID := int(data.UserID.ValueInt64())
+
+	tflog.Debug(ctx, "Reading zone owner", map[string]interface{}{
+		"zone_id": zoneID,
+		"user_id": userID,
+	})
+
+	owners, err := r.client.ListZoneOwners(ctx, zoneID)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading Zone Owner",
+			fmt.Sprintf("Could not read owners of zone %d: %s", zoneID, err.Error()),
+		)
+		return
+	}
+
+	owner := findZoneOwner(owners, userID)
+	if owner == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	data.Username = types.StringValue(owner.Username)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneOwnerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All configurable attributes use RequiresReplace, so Update should never be called
+	resp.Diagnostics.AddError(
+		"Error Updating Zone Owner",
+		"Zone owners do not support in-place updates. All changes require replacement.",
+	)
+}
+
+func (r *ZoneOwnerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ZoneOwnerResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneID := int(data.ZoneID.ValueInt64())
+	userID := int(data.UserID.ValueInt64())
+
+	tflog.Debug(ctx, "Removing zone owner", map[string]interface{}{
+		"zone_id": zoneID,
+		"user_id": userID,
+	})
+
+	err := r.client.RemoveZoneOwner(ctx, zoneID, userID)
+	if err != nil {
+		if IsNotFoundError(err) {
+			tflog.Info(ctx, "Zone owner already removed, ignoring error", map[string]interface{}{
+				"zone_id": zoneID,
+				"user_id": userID,
+			})
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Removing Zone Owner",
+			fmt.Sprintf("Could not remove user %d as owner of zone %d: %s", userID, zoneID, err.Error()),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Zone owner removed successfully")
+}
+
+func (r *ZoneOwnerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	zoneID, userID, err := parseImportIDPair(req.ID, "zone_id/user_id")
+	if err != nil {
+		resp.Diagnostics.AddError("Error Importing Zone Owner", err.Error())
+		return
+	}
+
+	data := ZoneOwnerResourceModel{
+		ID:       types.StringValue(req.ID),
+		ZoneID:   types.Int64Value(zoneID),
+		UserID:   types.Int64Value(userID),
+		Username: types.StringNull(), // resolved by Read
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
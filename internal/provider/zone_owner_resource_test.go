@@ -0,0 +1,104 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestZoneOwnerClient(t *testing.T) {
+	var added ZoneOwnerRequest
+	var removed bool
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/zones/10/owners":
+			respondJSON(t, w, ZoneOwnerListResponse{Owners: []ZoneOwner{
+				{UserID: 1, Username: "admin"},
+				{UserID: 3, Username: "alice"},
+			}})
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v2/zones/10/owners":
+			if err := json.NewDecoder(r.Body).Decode(&added); err != nil {
+				t.Errorf("failed to decode body: %v", err)
+			}
+			respondJSON(t, w, nil)
+		case r.Method == http.MethodDelete && r.URL.Path == "/api/v2/zones/10/owners/3":
+			removed = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	owners, err := client.ListZoneOwners(context.Background(), 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if owner := findZoneOwner(owners, 3); owner == nil || owner.Username != "alice" {
+		t.Errorf("expected alice among owners, got %+v", owners)
+	}
+	if findZoneOwner(owners, 7) != nil {
+		t.Error("expected user 7 not to be an owner")
+	}
+
+	if err := client.AddZoneOwner(context.Background(), 10, 7); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if added.UserID != 7 {
+		t.Errorf("expected user_id 7 in request, got %d", added.UserID)
+	}
+	if err := client.RemoveZoneOwner(context.Background(), 10, 3); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !removed {
+		t.Error("expected a DELETE for owner 3")
+	}
+}
+
+func TestAccZoneOwnerResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneOwnerResourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("poweradmin_zone_owner.test", "id"),
+					resource.TestCheckResourceAttr("poweradmin_zone_owner.test", "username", "test-zone-owner-acc"),
+				),
+			},
+			{
+				ResourceName:      "poweradmin_zone_owner.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccZoneOwnerResourceConfig() string {
+	return testAccProviderConfig() + `
+resource "poweradmin_zone" "test" {
+  name = "test-zone-owner-acc.example.com"
+  type = "MASTER"
+}
+
+resource "poweradmin_user" "test" {
+  username = "test-zone-owner-acc"
+  password = "TestPassword123!"
+  fullname = "Test Zone Owner"
+  email    = "zone-owner-acc@example.com"
+  active   = true
+}
+
+resource "poweradmin_zone_owner" "test" {
+  zone_id = poweradmin_zone.test.id
+  user_id = poweradmin_user.test.id
+}
+`
+}
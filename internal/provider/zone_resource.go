@@ -5,18 +5,26 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/netip"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
+	"unicode"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/net/idna"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -36,14 +44,40 @@ type ZoneResource struct {
 // ZoneResourceModel describes the resource data model.
 type ZoneResourceModel struct {
 	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
+	Name        DNSNameValue `tfsdk:"name"`
 	Type        types.String `tfsdk:"type"`
 	Masters     types.String `tfsdk:"masters"`
 	Account     types.String `tfsdk:"account"`
 	Description types.String `tfsdk:"description"`
 	Template    types.String `tfsdk:"template"`
+
+	VerifyAccount types.Bool `tfsdk:"verify_account"`
+
+	WaitForTransfer types.Bool   `tfsdk:"wait_for_transfer"`
+	TransferTimeout types.String `tfsdk:"transfer_timeout"`
+
+	Tags types.Map `tfsdk:"tags"`
+
+	AlsoNotify types.Set `tfsdk:"also_notify"`
+
+	DefaultRecordTTL TTLValue `tfsdk:"default_record_ttl"`
+
+	HostmasterEmail types.String `tfsdk:"hostmaster_email"`
+
+	Nameservers types.List `tfsdk:"nameservers"`
+
+	RecordCount types.Int64 `tfsdk:"record_count"`
+
+	TemplateRRSets types.List `tfsdk:"template_rrsets"`
 }
 
+// defaultTransferTimeout bounds wait_for_transfer when transfer_timeout is unset.
+const defaultTransferTimeout = 5 * time.Minute
+
+// transferPollInterval is how often a new SLAVE zone is checked for its
+// first transfer; a variable so tests can shorten it.
+var transferPollInterval = 5 * time.Second
+
 func (r *ZoneResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_zone"
 }
@@ -61,10 +95,11 @@ func (r *ZoneResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				},
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The zone name (e.g., example.com)",
+				MarkdownDescription: "The zone name (e.g., example.com). Internationalized names may be given in Unicode (e.g., münchen.example); they are sent to the API in punycode.",
+				CustomType:          DNSNameType{},
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					requiresReplaceUnlessSameIDN(),
 				},
 			},
 			"type": schema.StringAttribute{
@@ -82,13 +117,19 @@ func (r *ZoneResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 					"  - IP with port: `192.0.2.1:5300`\n" +
 					"  - Multiple with ports: `192.0.2.1:5300,192.0.2.2:5300`\n" +
 					"  - IPv6 with port (requires brackets): `[2001:db8::1]:5300`\n\n" +
-					"  Only valid for SLAVE zones; setting it on other zone types is an error.",
+					"  Entries are IP addresses, not hostnames, and are sent as written; a port of 53 is the default and equivalent to no port.\n" +
+					"  Required for SLAVE zones and only valid for them; setting it on other zone types is an error.",
 				Optional: true,
 			},
 			"account": schema.StringAttribute{
 				MarkdownDescription: "Account name for the zone",
 				Optional:            true,
 			},
+			"verify_account": schema.BoolAttribute{
+				MarkdownDescription: "When `account` is set or changed, check that a Poweradmin user with that username exists and warn if not, " +
+					"since a mistyped account silently breaks ownership-based permissions. The zone is written either way. Defaults to false.",
+				Optional: true,
+			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "Description of the zone",
 				Optional:            true,
@@ -100,6 +141,112 @@ func (r *ZoneResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
+			"tags": schema.MapAttribute{
+				MarkdownDescription: "Free-form key/value tags kept with the zone, e.g. owner, cost center or ticket references. " +
+					"Stored as `" + zoneTagsMetadataKind + "` zone metadata and exposed by the `poweradmin_zone` data source. Keys must not be empty or contain `=`. " +
+					"Tags set outside Terraform are only tracked once this attribute is configured.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"also_notify": schema.SetAttribute{
+				MarkdownDescription: "IP addresses, optionally with a port (`192.0.2.1:5300`, `[2001:db8::1]:5300`), to send NOTIFY messages to in addition to the zone's nameservers, " +
+					"e.g. the public secondaries behind a hidden master. Stored as `" + metadataAlsoNotify + "` zone metadata; an empty set or removing the attribute clears it. " +
+					"Targets set outside Terraform are only tracked once this attribute is configured. Do not also manage `also_notify` of the zone with `poweradmin_zone_transfer_acl`.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"default_record_ttl": schema.StringAttribute{
+				MarkdownDescription: "TTL that `poweradmin_record` and `poweradmin_rrset` resources in this zone use when they do not set `ttl`, in seconds or as a duration like `ttl`. " +
+					"Stored as `" + zoneDefaultTTLMetadataKind + "` zone metadata and looked up once per zone while planning those resources, falling back to 3600 with a warning when it cannot be read; records without a `ttl` follow later changes of it. " +
+					"Without it they default to 3600. Removing the attribute clears the default.",
+				CustomType: TTLType{},
+				Optional:   true,
+			},
+			"hostmaster_email": schema.StringAttribute{
+				MarkdownDescription: "Contact email of the zone (`hostmaster@example.com`), i.e. the RNAME of its SOA record in email form. " +
+					"Converted to and from the SOA's DNS form (`hostmaster.example.com.`, dots in the local part escaped) automatically. " +
+					"Read from the apex SOA when not set; setting it rewrites the SOA's RNAME. Not supported on SLAVE zones, whose SOA comes from the masters.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"wait_for_transfer": schema.BoolAttribute{
+				MarkdownDescription: "For SLAVE zones, wait after creation until the first transfer from the masters has completed (the zone has an SOA serial), " +
+					"so dependent resources do not see an empty zone. Fails when no transfer completes within `transfer_timeout`. Only applies during creation.",
+				Optional: true,
+			},
+			"transfer_timeout": schema.StringAttribute{
+				MarkdownDescription: "How long `wait_for_transfer` waits, as a Go duration such as `90s` or `10m`. Defaults to `5m`.",
+				Optional:            true,
+			},
+			"nameservers": schema.ListAttribute{
+				MarkdownDescription: "Nameservers of the zone, read from its apex NS RRSet: sorted host names without the trailing dot, ready to pass to a registrar. " +
+					"Empty until the zone has NS records, e.g. before the first transfer of a SLAVE zone.",
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"template_rrsets": schema.ListNestedAttribute{
+				MarkdownDescription: "RRSets the zone held right after being created from `template`, i.e. the ones the template generated, including SOA and NS. " +
+					"Use it to keep `poweradmin_rrset` resources from colliding with them, or adopt them with `import` blocks using each `import_id`. " +
+					"Captured once at creation and not refreshed; null when the zone was created without a template or imported.",
+				Computed: true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The record name",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The record type",
+							Computed:            true,
+						},
+						"ttl": schema.Int64Attribute{
+							MarkdownDescription: "Time to live in seconds",
+							Computed:            true,
+						},
+						"import_id": schema.StringAttribute{
+							MarkdownDescription: "ID to import this RRSet as a `poweradmin_rrset` with, e.g. in an `import` block",
+							Computed:            true,
+						},
+						"records": schema.ListNestedAttribute{
+							MarkdownDescription: "Records in this RRSet",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"content": schema.StringAttribute{
+										MarkdownDescription: "Record content/value",
+										Computed:            true,
+									},
+									"disabled": schema.BoolAttribute{
+										MarkdownDescription: "Whether the record is disabled",
+										Computed:            true,
+									},
+									"priority": schema.Int64Attribute{
+										MarkdownDescription: "Priority for MX, SRV records",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"record_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of records in the zone, including SOA, NS and disabled records, as of the last refresh. " +
+					"Records added or removed outside this resource show up after the next refresh.",
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
@@ -123,28 +270,181 @@ func (r *ZoneResource) Configure(ctx context.Context, req resource.ConfigureRequ
 	r.client = client
 }
 
-// ValidateConfig rejects masters on an explicitly non-SLAVE zone. When type is
-// omitted the actual type may still be SLAVE (kept from state), so the
-// resolved-type guards in Create/Update cover that case instead.
+// ValidateConfig checks the zone name, tag keys, NOTIFY targets and hostmaster email, and rejects masters on an
+// explicitly non-SLAVE zone. When type is omitted the actual type may still be SLAVE
+// (kept from state), so the resolved-type guards in Create/Update cover that
+// case instead.
 func (r *ZoneResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	var data ZoneResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	if data.Masters.IsNull() || data.Masters.IsUnknown() || data.Masters.ValueString() == "" {
-		return
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
+		if err := validateZoneName(data.Name.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name"), "Invalid Zone Name", err.Error())
+		}
+	}
+	if !data.TransferTimeout.IsNull() && !data.TransferTimeout.IsUnknown() {
+		if _, err := parseTransferTimeout(data.TransferTimeout); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("transfer_timeout"), "Invalid Transfer Timeout", err.Error())
+		}
 	}
-	if data.Type.IsNull() || data.Type.IsUnknown() || data.Type.ValueString() == "" {
+	if !data.Tags.IsNull() && !data.Tags.IsUnknown() {
+		for key := range data.Tags.Elements() {
+			if key == "" || strings.ContainsAny(key, "=\n") {
+				resp.Diagnostics.AddAttributeError(path.Root("tags"), "Invalid Tag Key", fmt.Sprintf("Tag key %q must not be empty or contain '=' or a newline.", key))
+			}
+		}
+	}
+	if !data.AlsoNotify.IsNull() && !data.AlsoNotify.IsUnknown() {
+		var targets []types.String
+		resp.Diagnostics.Append(data.AlsoNotify.ElementsAs(ctx, &targets, false)...)
+		for _, target := range targets {
+			if target.IsNull() || target.IsUnknown() {
+				continue
+			}
+			if _, err := canonicalTransferACLEntry(metadataAlsoNotify, target.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("also_notify"), "Invalid NOTIFY Target", err.Error())
+			}
+		}
+	}
+	if data.WaitForTransfer.ValueBool() && !data.Type.IsNull() && !data.Type.IsUnknown() && !strings.EqualFold(data.Type.ValueString(), "SLAVE") {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("wait_for_transfer"),
+			"Invalid Zone Configuration",
+			fmt.Sprintf("wait_for_transfer only applies to SLAVE zones, but type is %q.", data.Type.ValueString()),
+		)
+	}
+	if !data.HostmasterEmail.IsNull() && !data.HostmasterEmail.IsUnknown() {
+		if _, err := emailToHostmaster(data.HostmasterEmail.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("hostmaster_email"), "Invalid Hostmaster Email", err.Error())
+		} else if strings.EqualFold(data.Type.ValueString(), "SLAVE") {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("hostmaster_email"),
+				"Invalid Zone Configuration",
+				"hostmaster_email cannot be set on a SLAVE zone: its SOA is transferred from the masters.",
+			)
+		}
+	}
+	if !data.Masters.IsNull() && !data.Masters.IsUnknown() && data.Masters.ValueString() != "" {
+		if _, err := parseMasters(data.Masters.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("masters"), "Invalid Masters", err.Error())
+		}
+	}
+	if data.Masters.IsUnknown() || data.Type.IsNull() || data.Type.IsUnknown() || data.Type.ValueString() == "" {
 		return
 	}
 	validateMastersForType(data.Masters.ValueString(), data.Type.ValueString(), &resp.Diagnostics)
 }
 
-// validateMastersForType errors when masters is set for a non-SLAVE zone;
-// returns false when it added an error.
+// parseMasters splits a masters value on commas, semicolons or whitespace
+// and returns each entry in canonical form: the address alone when no port
+// or the default port 53 is given, otherwise address:port with IPv6
+// addresses in brackets. IPv6 addresses need brackets to carry a port.
+func parseMasters(masters string) ([]string, error) {
+	entries := strings.FieldsFunc(masters, func(r rune) bool {
+		return r == ',' || r == ';' || unicode.IsSpace(r)
+	})
+	canonical := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if addr, err := netip.ParseAddr(strings.TrimSuffix(strings.TrimPrefix(entry, "["), "]")); err == nil && addr.Zone() == "" {
+			canonical = append(canonical, addr.String())
+			continue
+		}
+		addrPort, err := netip.ParseAddrPort(entry)
+		if err != nil || addrPort.Port() == 0 || addrPort.Addr().Zone() != "" {
+			return nil, fmt.Errorf("invalid master %q: must be an IP address with an optional port, such as 192.0.2.1, 192.0.2.1:5300 or [2001:db8::1]:5300", entry)
+		}
+		if addrPort.Port() == 53 {
+			canonical = append(canonical, addrPort.Addr().String())
+		} else {
+			canonical = append(canonical, addrPort.String())
+		}
+	}
+	return canonical, nil
+}
+
+// validateZoneName applies hostname rules to a zone name: 1-63 character
+// labels of letters, digits, hyphens and underscores, at most 253 characters
+// in total. Unicode labels are checked in their punycode form. RFC 2317
+// classless reverse zones may use '/' in their in-addr.arpa labels.
+func validateZoneName(name string) error {
+	switch {
+	case name == "":
+		return fmt.Errorf("zone name must not be empty")
+	case strings.Contains(name, "://"):
+		return fmt.Errorf("%q looks like a URL; use only the domain name, e.g. example.com", name)
+	case strings.ContainsAny(name, " \t@:?#"):
+		return fmt.Errorf("%q is not a domain name; use only the domain, e.g. example.com", name)
+	case strings.HasPrefix(name, "."):
+		return fmt.Errorf("%q must not start with a dot", name)
+	case strings.HasSuffix(name, "."):
+		return fmt.Errorf("%q must not end with a dot; Poweradmin stores zone names without it, so use %q", name, strings.TrimSuffix(name, "."))
+	}
+
+	classless := strings.HasSuffix(strings.ToLower(name), ".in-addr.arpa")
+	total := 0
+	for _, label := range strings.Split(name, ".") {
+		ascii := label
+		if !isASCII(label) {
+			converted, err := idna.Lookup.ToASCII(label)
+			if err != nil {
+				return fmt.Errorf("%q has an invalid internationalized label %q: %s", name, label, err)
+			}
+			ascii = converted
+		}
+		if ascii == "" {
+			return fmt.Errorf("%q has an empty label (two dots in a row)", name)
+		}
+		if len(ascii) > 63 {
+			return fmt.Errorf("%q has a label longer than 63 characters: %q", name, label)
+		}
+		if strings.HasPrefix(ascii, "-") || strings.HasSuffix(ascii, "-") {
+			return fmt.Errorf("%q has a label starting or ending with a hyphen: %q", name, label)
+		}
+		for _, r := range ascii {
+			if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_' || (r == '/' && classless) {
+				continue
+			}
+			if r == '/' {
+				return fmt.Errorf("%q contains '/'; if this is a URL path, use only the domain name", name)
+			}
+			return fmt.Errorf("%q contains %q, which is not allowed in a domain name", name, r)
+		}
+		total += len(ascii) + 1
+	}
+	if total-1 > 253 {
+		return fmt.Errorf("%q is longer than 253 characters", name)
+	}
+	return nil
+}
+
+// isASCII reports whether s contains only ASCII characters.
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= 0x80 {
+			return false
+		}
+	}
+	return true
+}
+
+// validateMastersForType errors when a SLAVE zone has no masters or masters
+// is set for another zone type; returns false when it added an error.
 func validateMastersForType(masters, zoneType string, diags *diag.Diagnostics) bool {
-	if masters == "" || strings.EqualFold(zoneType, "SLAVE") {
+	if strings.EqualFold(zoneType, "SLAVE") {
+		if strings.TrimSpace(masters) != "" {
+			return true
+		}
+		diags.AddAttributeError(
+			path.Root("masters"),
+			"SLAVE Zone Requires Masters",
+			"A SLAVE zone transfers its records from its masters, so masters must list at least one master server; the server would reject the zone otherwise.",
+		)
+		return false
+	}
+	if masters == "" {
 		return true
 	}
 	diags.AddAttributeError(
@@ -195,6 +495,9 @@ func (r *ZoneResource) Create(ctx context.Context, req resource.CreateRequest, r
 	if !validateMastersForType(createReq.Masters, createReq.Type, &resp.Diagnostics) {
 		return
 	}
+	if data.VerifyAccount.ValueBool() {
+		warnUnknownAccount(ctx, r.client, data.Account, &resp.Diagnostics)
+	}
 
 	tflog.Debug(ctx, "Creating zone", map[string]interface{}{
 		"name": createReq.Name,
@@ -228,19 +531,110 @@ func (r *ZoneResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	// Map response back to model
 	data.ID = types.StringValue(strconv.Itoa(zone.ID))
-	data.Name = types.StringValue(zone.Name)
+	data.Name = NewDNSNameValue(normalizeIDNName(data.Name.ValueString(), zone.Name))
 	data.Type = types.StringValue(normalizeTypeCase(data.Type.ValueString(), zone.Type))
 
 	// Mirror Read's mapping so a value the server dropped surfaces immediately
 	// as an inconsistent-apply error instead of silent drift on the next plan
-	data.Masters = normalizeEmptyString(data.Masters, zone.Masters)
+	data.Masters = normalizeMasters(data.Masters, zone.Masters)
 	data.Account = normalizeEmptyString(data.Account, zone.Account)
 	data.Description = normalizeEmptyString(data.Description, zone.Description)
 
+	// Capture what the template generated before anything else changes it
+	data.TemplateRRSets = types.ListNull(rrsetDataObjectType)
+	if createReq.Template != "" {
+		r.readTemplateRRSets(ctx, &data, zone.ID, &resp.Diagnostics)
+	}
+
+	if tags := zoneTags(ctx, data.Tags, &resp.Diagnostics); len(tags) > 0 {
+		if err := r.client.SetZoneTags(ctx, int64(zone.ID), tags); err != nil {
+			addPostCreateError(&resp.Diagnostics, "Error Setting Zone Tags", &data, zone.ID, "its tags could not be set", err)
+		}
+	}
+
+	if targets := zoneAlsoNotify(ctx, data.AlsoNotify, &resp.Diagnostics); len(targets) > 0 {
+		if err := r.client.SetZoneAlsoNotify(ctx, int64(zone.ID), targets); err != nil {
+			addPostCreateError(&resp.Diagnostics, "Error Setting Zone NOTIFY Targets", &data, zone.ID, "its ALSO-NOTIFY targets could not be set", err)
+		}
+	}
+
+	if !data.DefaultRecordTTL.IsNull() {
+		if err := r.client.SetZoneDefaultTTL(ctx, int64(zone.ID), data.DefaultRecordTTL.ValueSeconds()); err != nil {
+			addPostCreateError(&resp.Diagnostics, "Error Setting Zone Default Record TTL", &data, zone.ID, "its default record TTL could not be set", err)
+		}
+	}
+
+	if !data.HostmasterEmail.IsUnknown() && !data.HostmasterEmail.IsNull() {
+		if err := setZoneHostmaster(ctx, r.client, zone.ID, zone.Type, data.HostmasterEmail.ValueString()); err != nil {
+			addPostCreateError(&resp.Diagnostics, "Error Setting Zone Hostmaster", &data, zone.ID, "its hostmaster email could not be set", err)
+		}
+	}
+
+	if data.WaitForTransfer.ValueBool() && strings.EqualFold(zone.Type, "SLAVE") {
+		timeout, _ := parseTransferTimeout(data.TransferTimeout)
+		if err := waitForTransfer(ctx, r.client, zone.ID, timeout); err != nil {
+			err = fmt.Errorf("%w; check that the masters (%s) are reachable from the PowerDNS server and allow zone transfers to it", err, zone.Masters)
+			addPostCreateError(&resp.Diagnostics, "Zone Transfer Not Completed", &data, zone.ID, "its first transfer did not complete", err)
+		}
+	}
+
+	r.readNameservers(ctx, &data, zone.ID, &resp.Diagnostics)
+	r.readHostmaster(ctx, &data, zone.ID, &resp.Diagnostics)
+	r.readRecordCount(ctx, &data, zone.ID, &resp.Diagnostics)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// parseTransferTimeout returns the configured transfer_timeout, or the
+// default when it is unset.
+func parseTransferTimeout(v types.String) (time.Duration, error) {
+	if v.IsNull() || v.IsUnknown() || v.ValueString() == "" {
+		return defaultTransferTimeout, nil
+	}
+	timeout, err := time.ParseDuration(v.ValueString())
+	if err != nil || timeout <= 0 {
+		return 0, fmt.Errorf("invalid transfer_timeout %q: must be a positive duration such as 90s or 10m", v.ValueString())
+	}
+	return timeout, nil
+}
+
+// waitForTransfer polls a new SLAVE zone until its first transfer has
+// completed, i.e. it reports an SOA serial or holds an SOA record.
+func waitForTransfer(ctx context.Context, client *Client, zoneID int, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		zone, err := client.GetZone(ctx, zoneID)
+		if err == nil && zone.SOASerial > 0 {
+			return nil
+		}
+		if err == nil {
+			var soa []Record
+			soa, err = client.ListRecords(ctx, int64(zoneID), "SOA")
+			if err == nil && len(soa) > 0 {
+				return nil
+			}
+		}
+		if err != nil && ctx.Err() == nil {
+			tflog.Debug(ctx, "Transfer check failed, retrying", map[string]interface{}{
+				"zone_id": zoneID,
+				"error":   err.Error(),
+			})
+		}
+
+		tflog.Debug(ctx, "Waiting for initial zone transfer", map[string]interface{}{
+			"zone_id": zoneID,
+		})
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("no transfer from its masters completed within %s", timeout)
+		case <-time.After(transferPollInterval):
+		}
+	}
+}
+
 func (r *ZoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data ZoneResourceModel
 
@@ -285,13 +679,65 @@ func (r *ZoneResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	// Update model with fresh data
 	data.ID = types.StringValue(strconv.Itoa(zone.ID))
-	data.Name = types.StringValue(zone.Name)
+	data.Name = NewDNSNameValue(normalizeIDNName(data.Name.ValueString(), zone.Name))
 	data.Type = types.StringValue(normalizeTypeCase(data.Type.ValueString(), zone.Type))
 
-	data.Masters = normalizeEmptyString(data.Masters, zone.Masters)
+	data.Masters = normalizeMasters(data.Masters, zone.Masters)
 	data.Account = normalizeEmptyString(data.Account, zone.Account)
 	data.Description = normalizeEmptyString(data.Description, zone.Description)
 
+	// Tags are only tracked once configured
+	if !data.Tags.IsNull() {
+		tags, err := r.client.GetZoneTags(ctx, int64(zoneID))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Zone Tags",
+				fmt.Sprintf("Could not read tags of zone ID %d: %s", zoneID, err.Error()),
+			)
+			return
+		}
+		var diags diag.Diagnostics
+		data.Tags, diags = types.MapValueFrom(ctx, types.StringType, tags)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	// Likewise NOTIFY targets
+	if !data.AlsoNotify.IsNull() {
+		targets, err := r.client.GetZoneAlsoNotify(ctx, int64(zoneID))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Zone NOTIFY Targets",
+				fmt.Sprintf("Could not read ALSO-NOTIFY targets of zone ID %d: %s", zoneID, err.Error()),
+			)
+			return
+		}
+		var diags diag.Diagnostics
+		data.AlsoNotify, diags = types.SetValueFrom(ctx, types.StringType, normalizeTransferACL(ctx, metadataAlsoNotify, data.AlsoNotify, targets))
+		resp.Diagnostics.Append(diags...)
+	}
+
+	// Likewise the default record TTL
+	if !data.DefaultRecordTTL.IsNull() {
+		ttl, ok, err := r.client.GetZoneDefaultTTL(ctx, int64(zoneID))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Zone Default Record TTL",
+				fmt.Sprintf("Could not read the default record TTL of zone ID %d: %s", zoneID, err.Error()),
+			)
+			return
+		}
+		switch {
+		case !ok:
+			data.DefaultRecordTTL = TTLValue{StringValue: types.StringNull()}
+		case ttl != data.DefaultRecordTTL.ValueSeconds():
+			data.DefaultRecordTTL = NewTTLValue(ttl)
+		}
+	}
+
+	r.readNameservers(ctx, &data, zoneID, &resp.Diagnostics)
+	r.readHostmaster(ctx, &data, zoneID, &resp.Diagnostics)
+	r.readRecordCount(ctx, &data, zoneID, &resp.Diagnostics)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -379,14 +825,257 @@ func (r *ZoneResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	// Match the API response to what the user configured
 	data.Type = types.StringValue(normalizeTypeCase(data.Type.ValueString(), zone.Type))
 
-	data.Masters = normalizeEmptyString(data.Masters, zone.Masters)
+	data.Masters = normalizeMasters(data.Masters, zone.Masters)
 	data.Account = normalizeEmptyString(data.Account, zone.Account)
 	data.Description = normalizeEmptyString(data.Description, zone.Description)
 
+	var prior ZoneResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if data.VerifyAccount.ValueBool() && (!data.Account.Equal(prior.Account) || !prior.VerifyAccount.ValueBool()) {
+		warnUnknownAccount(ctx, r.client, data.Account, &resp.Diagnostics)
+	}
+	if !data.Tags.Equal(prior.Tags) {
+		if err := r.client.SetZoneTags(ctx, int64(zoneID), zoneTags(ctx, data.Tags, &resp.Diagnostics)); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Setting Zone Tags",
+				fmt.Sprintf("Could not set tags of zone ID %d: %s", zoneID, err.Error()),
+			)
+			return
+		}
+	}
+	if !data.AlsoNotify.Equal(prior.AlsoNotify) {
+		if err := r.client.SetZoneAlsoNotify(ctx, int64(zoneID), zoneAlsoNotify(ctx, data.AlsoNotify, &resp.Diagnostics)); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Setting Zone NOTIFY Targets",
+				fmt.Sprintf("Could not set ALSO-NOTIFY targets of zone ID %d: %s", zoneID, err.Error()),
+			)
+			return
+		}
+	}
+
+	if data.DefaultRecordTTL.IsNull() != prior.DefaultRecordTTL.IsNull() || data.DefaultRecordTTL.ValueSeconds() != prior.DefaultRecordTTL.ValueSeconds() {
+		var err error
+		if data.DefaultRecordTTL.IsNull() {
+			err = r.client.DeleteZoneDefaultTTL(ctx, int64(zoneID))
+		} else {
+			err = r.client.SetZoneDefaultTTL(ctx, int64(zoneID), data.DefaultRecordTTL.ValueSeconds())
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Setting Zone Default Record TTL",
+				fmt.Sprintf("Could not set the default record TTL of zone ID %d: %s", zoneID, err.Error()),
+			)
+			return
+		}
+	}
+	if !data.HostmasterEmail.IsUnknown() && !data.HostmasterEmail.IsNull() && !strings.EqualFold(data.HostmasterEmail.ValueString(), prior.HostmasterEmail.ValueString()) {
+		if err := setZoneHostmaster(ctx, r.client, zoneID, data.Type.ValueString(), data.HostmasterEmail.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Setting Zone Hostmaster",
+				fmt.Sprintf("Could not set the hostmaster email of zone ID %d: %s", zoneID, err.Error()),
+			)
+			return
+		}
+	}
+
+	r.readNameservers(ctx, &data, zoneID, &resp.Diagnostics)
+	r.readHostmaster(ctx, &data, zoneID, &resp.Diagnostics)
+	r.readRecordCount(ctx, &data, zoneID, &resp.Diagnostics)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// warnUnknownAccount warns when account is set but names no Poweradmin user.
+// Lookup failures, e.g. for API keys not allowed to list users, are only
+// logged.
+func warnUnknownAccount(ctx context.Context, client *Client, account types.String, diags *diag.Diagnostics) {
+	if account.IsNull() || account.IsUnknown() || account.ValueString() == "" {
+		return
+	}
+	_, err := client.FindUserByUsername(ctx, account.ValueString())
+	switch {
+	case errors.Is(err, errUserNotFound):
+		diags.AddAttributeWarning(
+			path.Root("account"),
+			"Unknown Zone Account",
+			fmt.Sprintf("No Poweradmin user is named %q, so permissions based on the zone account will not apply to anyone. Check the account for typos.", account.ValueString()),
+		)
+	case err != nil:
+		tflog.Debug(ctx, "Could not verify zone account", map[string]interface{}{
+			"account": account.ValueString(),
+			"error":   err.Error(),
+		})
+	}
+}
+
+// addPostCreateError reports a step of Create that failed after the zone was
+// created; what says which part of the zone is missing. The zone is still
+// saved to state, where Terraform marks it tainted, so it is not orphaned.
+func addPostCreateError(diags *diag.Diagnostics, summary string, data *ZoneResourceModel, zoneID int, what string, err error) {
+	diags.AddError(summary, fmt.Sprintf("Zone %s was created with ID %d, but %s: %s", data.Name.ValueString(), zoneID, what, err.Error()))
+}
+
+// readTemplateRRSets sets template_rrsets from the RRSets of a zone just
+// created from a template. On error the attribute is left null so the zone
+// is still saved to state.
+func (r *ZoneResource) readTemplateRRSets(ctx context.Context, data *ZoneResourceModel, zoneID int, diags *diag.Diagnostics) {
+	rrsets, err := r.client.ListRRSets(ctx, int64(zoneID), "")
+	if err != nil {
+		addPostCreateError(diags, "Error Reading Template Records", data, zoneID, "the records its template generated could not be read", err)
+		return
+	}
+	var d diag.Diagnostics
+	data.TemplateRRSets, d = types.ListValueFrom(ctx, rrsetDataObjectType, rrsetDataModels(int64(zoneID), rrsets))
+	diags.Append(d...)
+}
+
+// readNameservers sets the nameservers attribute from the zone's apex NS
+// RRSet. On error the attribute is left null so the state can still be saved.
+func (r *ZoneResource) readNameservers(ctx context.Context, data *ZoneResourceModel, zoneID int, diags *diag.Diagnostics) {
+	data.Nameservers = types.ListNull(types.StringType)
+	nameservers, err := zoneNameservers(ctx, r.client, zoneID)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Zone Nameservers",
+			fmt.Sprintf("Could not read the NS records of zone ID %d: %s", zoneID, err.Error()),
+		)
+		return
+	}
+	var d diag.Diagnostics
+	data.Nameservers, d = types.ListValueFrom(ctx, types.StringType, nameservers)
+	diags.Append(d...)
+}
+
+// readRecordCount sets record_count from the zone's RRSets. On error the
+// attribute is left null so the state can still be saved.
+func (r *ZoneResource) readRecordCount(ctx context.Context, data *ZoneResourceModel, zoneID int, diags *diag.Diagnostics) {
+	data.RecordCount = types.Int64Null()
+	count, err := zoneRecordCount(ctx, r.client, zoneID)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Zone Record Count",
+			fmt.Sprintf("Could not list the records of zone ID %d: %s", zoneID, err.Error()),
+		)
+		return
+	}
+	data.RecordCount = types.Int64Value(count)
+}
+
+// readHostmaster sets hostmaster_email from the RNAME of the zone's apex SOA,
+// keeping the configured spelling when it names the same address. The
+// attribute is null while the zone has no SOA, and on error.
+func (r *ZoneResource) readHostmaster(ctx context.Context, data *ZoneResourceModel, zoneID int, diags *diag.Diagnostics) {
+	configured := data.HostmasterEmail
+	data.HostmasterEmail = types.StringNull()
+	rrset, soa, err := zoneSOA(ctx, r.client, int64(zoneID))
+	if err != nil {
+		diags.AddError(
+			"Error Reading Zone Hostmaster",
+			fmt.Sprintf("Could not read the SOA record of zone ID %d: %s", zoneID, err.Error()),
+		)
+		return
+	}
+	if rrset == nil {
+		return
+	}
+	email := hostmasterToEmail(soa.Hostmaster)
+	if strings.EqualFold(strings.TrimSuffix(configured.ValueString(), "."), email) {
+		data.HostmasterEmail = configured
+		return
+	}
+	data.HostmasterEmail = types.StringValue(email)
+}
+
+// setZoneHostmaster rewrites the RNAME of the zone's apex SOA to the given
+// email address, keeping the rest of the SOA.
+func setZoneHostmaster(ctx context.Context, client *Client, zoneID int, zoneType, email string) error {
+	if strings.EqualFold(zoneType, "SLAVE") {
+		return fmt.Errorf("the SOA of a SLAVE zone is transferred from the masters and cannot be changed")
+	}
+	rname, err := emailToHostmaster(email)
+	if err != nil {
+		return err
+	}
+	rrset, soa, err := zoneSOA(ctx, client, int64(zoneID))
+	if err != nil {
+		return err
+	}
+	if rrset == nil {
+		return fmt.Errorf("zone %d has no SOA record", zoneID)
+	}
+	if strings.EqualFold(soa.Hostmaster, rname) {
+		return nil
+	}
+	soa.Hostmaster = rname
+	_, err = client.UpdateRRSet(ctx, int64(zoneID), map[string]interface{}{
+		"name": rrset.Name,
+		"type": "SOA",
+		"ttl":  rrset.TTL,
+		"records": []map[string]interface{}{
+			{"content": soa.String(), "disabled": false, "priority": 0},
+		},
+	})
+	return err
+}
+
+// zoneNameservers returns the targets of the enabled records of a zone's
+// apex NS RRSet, sorted and without the trailing dot.
+func zoneNameservers(ctx context.Context, client *Client, zoneID int) ([]string, error) {
+	rrsets, err := client.ListRRSetsMatching(ctx, int64(zoneID), RRSetFilter{Type: "NS", Name: "@"})
+	if err != nil {
+		return nil, err
+	}
+	nameservers := []string{}
+	for _, rrset := range rrsets {
+		for _, record := range rrset.Records {
+			if !record.Disabled {
+				nameservers = append(nameservers, strings.TrimSuffix(record.Content, "."))
+			}
+		}
+	}
+	slices.Sort(nameservers)
+	return slices.Compact(nameservers), nil
+}
+
+// zoneRecordCount returns the number of records in the zone, counting every
+// record of every RRSet.
+func zoneRecordCount(ctx context.Context, client *Client, zoneID int) (int64, error) {
+	rrsets, err := client.ListRRSets(ctx, int64(zoneID), "")
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	for _, rrset := range rrsets {
+		count += int64(len(rrset.Records))
+	}
+	return count, nil
+}
+
+// zoneTags converts the tags attribute to a map; null means no tags.
+func zoneTags(ctx context.Context, v types.Map, diags *diag.Diagnostics) map[string]string {
+	tags := map[string]string{}
+	if v.IsNull() || v.IsUnknown() {
+		return tags
+	}
+	diags.Append(v.ElementsAs(ctx, &tags, false)...)
+	return tags
+}
+
+// zoneAlsoNotify converts the also_notify attribute to a list; null means no
+// extra NOTIFY targets.
+func zoneAlsoNotify(ctx context.Context, v types.Set, diags *diag.Diagnostics) []string {
+	var targets []string
+	if v.IsNull() || v.IsUnknown() {
+		return targets
+	}
+	diags.Append(v.ElementsAs(ctx, &targets, false)...)
+	return targets
+}
+
 func (r *ZoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data ZoneResourceModel
 
@@ -4,9 +4,16 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -23,6 +30,9 @@ func TestAccZoneResource(t *testing.T) {
 					resource.TestCheckResourceAttr("poweradmin_zone.test", "type", "MASTER"),
 					resource.TestCheckResourceAttr("poweradmin_zone.test", "description", "Test zone"),
 					resource.TestCheckResourceAttrSet("poweradmin_zone.test", "id"),
+					resource.TestCheckResourceAttrSet("poweradmin_zone.test", "nameservers.#"),
+					resource.TestCheckResourceAttrSet("poweradmin_zone.test", "hostmaster_email"),
+					resource.TestCheckResourceAttrSet("poweradmin_zone.test", "record_count"),
 				),
 			},
 			// ImportState testing
@@ -82,3 +92,289 @@ resource "poweradmin_zone" "test" {
 }
 `, name, masters)
 }
+
+func TestValidateZoneName(t *testing.T) {
+	valid := []string{
+		"example.com",
+		"sub.example.co.uk",
+		"_msdcs.example.com",
+		"2.0.192.in-addr.arpa",
+		"0/26.2.0.192.in-addr.arpa",
+		"8.b.d.0.1.0.0.2.ip6.arpa",
+		"münchen.example",
+		"xn--mnchen-3ya.example",
+	}
+	for _, name := range valid {
+		if err := validateZoneName(name); err != nil {
+			t.Errorf("validateZoneName(%q) = %v, want nil", name, err)
+		}
+	}
+
+	invalid := []string{
+		"",
+		"https://example.com",
+		"example.com/path",
+		"example.com:8080",
+		"admin@example.com",
+		"example .com",
+		".example.com",
+		"example.com.",
+		"example..com",
+		"-example.com",
+		"example-.com",
+		"exa!mple.com",
+		strings.Repeat("a", 64) + ".com",
+		strings.Repeat(strings.Repeat("a", 60)+".", 5) + "com",
+	}
+	for _, name := range invalid {
+		if err := validateZoneName(name); err == nil {
+			t.Errorf("validateZoneName(%q) = nil, want error", name)
+		}
+	}
+}
+
+func TestValidateMastersForType(t *testing.T) {
+	tests := []struct {
+		masters  string
+		zoneType string
+		want     bool
+	}{
+		{"192.0.2.1", "SLAVE", true},
+		{"192.0.2.1", "slave", true},
+		{"", "SLAVE", false},
+		{" ", "SLAVE", false},
+		{"", "MASTER", true},
+		{"", "NATIVE", true},
+		{"192.0.2.1", "MASTER", false},
+		{"192.0.2.1", "NATIVE", false},
+	}
+	for _, tt := range tests {
+		var diags diag.Diagnostics
+		if got := validateMastersForType(tt.masters, tt.zoneType, &diags); got != tt.want || diags.HasError() == tt.want {
+			t.Errorf("validateMastersForType(%q, %q) = %v with diagnostics %v, want %v", tt.masters, tt.zoneType, got, diags, tt.want)
+		}
+	}
+}
+
+func TestWaitForTransfer(t *testing.T) {
+	defer func(interval time.Duration) { transferPollInterval = interval }(transferPollInterval)
+	transferPollInterval = time.Millisecond
+
+	tests := []struct {
+		name       string
+		serialFrom int // poll on which the serial appears; 0 = never
+		soaRecord  bool
+		wantErr    bool
+	}{
+		{"serial after a few polls", 3, false, false},
+		{"soa record without serial", 0, true, false},
+		{"masters unreachable", 0, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			polls := 0
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/api/v2/zones/7":
+					polls++
+					zone := Zone{ID: 7, Name: "slave.example.com", Type: "SLAVE"}
+					if tt.serialFrom > 0 && polls >= tt.serialFrom {
+						zone.SOASerial = 2026101601
+					}
+					respondJSON(t, w, ZoneResponse{Zone: zone})
+				case "/api/v2/zones/7/records":
+					records := []Record{}
+					if tt.soaRecord {
+						records = append(records, Record{ID: "1", Name: "@", Type: "SOA"})
+					}
+					respondJSON(t, w, RecordListResponse{Records: records})
+				default:
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+			})
+
+			err := waitForTransfer(context.Background(), client, 7, 50*time.Millisecond)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("waitForTransfer() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.serialFrom > 0 && polls != tt.serialFrom {
+				t.Errorf("expected %d polls, got %d", tt.serialFrom, polls)
+			}
+		})
+	}
+}
+
+func TestZoneNameservers(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/zones/3":
+			respondJSON(t, w, ZoneResponse{Zone: Zone{ID: 3, Name: "example.com"}})
+		case "/api/v2/zones/3/rrsets":
+			if got := r.URL.Query().Get("name"); got != "example.com" {
+				t.Errorf("expected name=example.com, got %q", got)
+			}
+			respondJSON(t, w, RRSetListResponse{RRSets: []RRSet{
+				{Name: "example.com", Type: "NS", TTL: 86400, Records: []RRSetRecord{
+					{Content: "ns2.example.net."},
+					{Content: "ns1.example.net."},
+					{Content: "ns3.example.net.", Disabled: true},
+				}},
+				// A delegation is not one of the zone's own nameservers
+				{Name: "sub.example.com", Type: "NS", TTL: 86400, Records: []RRSetRecord{{Content: "ns.sub.example.com."}}},
+			}})
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	got, err := zoneNameservers(context.Background(), client, 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"ns1.example.net", "ns2.example.net"}; strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("zoneNameservers() = %v, want %v", got, want)
+	}
+}
+
+func TestZoneRecordCount(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/zones/3/rrsets" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		respondJSON(t, w, RRSetListResponse{RRSets: []RRSet{
+			{Name: "example.com", Type: "SOA", TTL: 86400, Records: []RRSetRecord{{Content: "ns1.example.net. hostmaster.example.com. 1 10800 3600 604800 3600"}}},
+			{Name: "example.com", Type: "NS", TTL: 86400, Records: []RRSetRecord{{Content: "ns1.example.net."}, {Content: "ns2.example.net."}}},
+			{Name: "www.example.com", Type: "A", TTL: 300, Records: []RRSetRecord{{Content: "192.0.2.1"}, {Content: "192.0.2.2", Disabled: true}}},
+		}})
+	})
+
+	got, err := zoneRecordCount(context.Background(), client, 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != 5 {
+		t.Errorf("zoneRecordCount() = %d, want 5", got)
+	}
+}
+
+func TestReadTemplateRRSets(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/zones/3/rrsets" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		respondJSON(t, w, RRSetListResponse{RRSets: []RRSet{
+			{Name: "example.com", Type: "NS", TTL: 86400, Records: []RRSetRecord{{Content: "ns1.example.net."}, {Content: "ns2.example.net."}}},
+			{Name: "mail.example.com", Type: "MX", TTL: 3600, Records: []RRSetRecord{{Content: "mx.example.net.", Priority: 10}}},
+		}})
+	})
+	r := &ZoneResource{client: client}
+	data := ZoneResourceModel{Name: NewDNSNameValue("example.com")}
+
+	var diags diag.Diagnostics
+	r.readTemplateRRSets(context.Background(), &data, 3, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	var got []RRSetDataModel
+	if diags := data.TemplateRRSets.ElementsAs(context.Background(), &got, false); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(got) != 2 || got[1].ImportID.ValueString() != "3/mail.example.com/MX" || got[1].Records[0].Priority.ValueInt64() != 10 || len(got[0].Records) != 2 {
+		t.Errorf("unexpected template RRSets: %+v", got)
+	}
+}
+
+func TestSetZoneHostmaster(t *testing.T) {
+	soa := "ns1.example.com. hostmaster.example.com. 2024010101 10800 3600 604800 3600"
+	var written map[string]interface{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v2/zones/3":
+			respondJSON(t, w, ZoneResponse{Zone: Zone{ID: 3, Name: "example.com"}})
+		case r.URL.Path == "/api/v2/zones/3/rrsets" && r.Method == http.MethodGet:
+			respondJSON(t, w, RRSetListResponse{RRSets: []RRSet{
+				{Name: "example.com", Type: "SOA", TTL: 86400, Records: []RRSetRecord{{Content: soa}}},
+			}})
+		case r.URL.Path == "/api/v2/zones/3/rrsets" && r.Method == http.MethodPut:
+			if err := json.NewDecoder(r.Body).Decode(&written); err != nil {
+				t.Fatalf("failed to decode body: %v", err)
+			}
+			respondJSON(t, w, nil)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+	ctx := context.Background()
+
+	// An unchanged RNAME is not written again
+	if err := setZoneHostmaster(ctx, client, 3, "MASTER", "Hostmaster@example.com"); err != nil || written != nil {
+		t.Fatalf("expected no write for the current hostmaster, got %v (err %v)", written, err)
+	}
+
+	if err := setZoneHostmaster(ctx, client, 3, "MASTER", "dns.admin@example.net"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	records, _ := written["records"].([]interface{})
+	if len(records) != 1 || written["ttl"] != float64(86400) {
+		t.Fatalf("expected the SOA RRSet to be rewritten whole, got %v", written)
+	}
+	want := `ns1.example.com. dns\.admin.example.net. 2024010101 10800 3600 604800 3600`
+	if got := records[0].(map[string]interface{})["content"]; got != want {
+		t.Errorf("expected SOA content %q, got %q", want, got)
+	}
+
+	if err := setZoneHostmaster(ctx, client, 3, "SLAVE", "dns@example.net"); err == nil {
+		t.Error("expected an error for a SLAVE zone")
+	}
+}
+
+func TestWarnUnknownAccount(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		account     types.String
+		status      int
+		wantWarning bool
+	}{
+		{"existing user", types.StringValue("customer-001"), http.StatusOK, false},
+		{"typo", types.StringValue("customer-01"), http.StatusOK, true},
+		{"no account", types.StringNull(), http.StatusOK, false},
+		// Keys that may not list users cannot tell, so stay quiet
+		{"lookup refused", types.StringValue("customer-01"), http.StatusForbidden, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// A fresh client each, since the user listing is kept per run
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/v2/users" {
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				if tt.status != http.StatusOK {
+					respondError(t, w, tt.status, "Insufficient permissions")
+					return
+				}
+				respondJSON(t, w, UserListResponse{Users: []User{{UserID: 2, Username: "customer-001"}}})
+			})
+			var diags diag.Diagnostics
+			warnUnknownAccount(ctx, client, tt.account, &diags)
+			if got := diags.WarningsCount() > 0; got != tt.wantWarning || diags.HasError() {
+				t.Errorf("expected warning %t, got %v", tt.wantWarning, diags)
+			}
+		})
+	}
+}
+
+func TestParseTransferTimeout(t *testing.T) {
+	if got, err := parseTransferTimeout(types.StringNull()); err != nil || got != defaultTransferTimeout {
+		t.Errorf("expected default timeout, got %s (err %v)", got, err)
+	}
+	if got, err := parseTransferTimeout(types.StringValue("90s")); err != nil || got != 90*time.Second {
+		t.Errorf("expected 90s, got %s (err %v)", got, err)
+	}
+	for _, bad := range []string{"soon", "0s", "-1m"} {
+		if _, err := parseTransferTimeout(types.StringValue(bad)); err == nil {
+			t.Errorf("expected error for transfer_timeout %q", bad)
+		}
+	}
+}
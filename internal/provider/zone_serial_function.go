@@ -0,0 +1,104 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ZoneSerialFunction{}
+
+func NewZoneSerialFunction() function.Function {
+	return &ZoneSerialFunction{}
+}
+
+// ZoneSerialFunction builds a date-based YYYYMMDDnn SOA serial.
+type ZoneSerialFunction struct{}
+
+// zoneSerialNow is overridden in tests.
+var zoneSerialNow = time.Now
+
+func (f *ZoneSerialFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "zone_serial"
+}
+
+func (f *ZoneSerialFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build a date-based SOA serial",
+		MarkdownDescription: "Returns a `YYYYMMDDnn` serial, where `nn` is the two-digit revision (0-99). " +
+			"Pass the date (`YYYY-MM-DD` or an RFC 3339 timestamp) to keep the serial stable between runs; " +
+			"without it the current UTC date is used, so the result changes every day.",
+		Parameters: []function.Parameter{
+			function.Int64Parameter{
+				Name:                "revision",
+				MarkdownDescription: "Change number within the day (0-99)",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:                "date",
+			MarkdownDescription: "Optional date of the change, e.g. `2026-10-16`",
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *ZoneSerialFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var revision int64
+	var dates []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &revision, &dates))
+	if resp.Error != nil {
+		return
+	}
+	if len(dates) > 1 {
+		resp.Error = function.NewArgumentFuncError(1, "at most one date may be given")
+		return
+	}
+
+	date := zoneSerialNow().UTC()
+	if len(dates) == 1 {
+		parsed, err := parseZoneSerialDate(dates[0])
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(1, err.Error())
+			return
+		}
+		date = parsed
+	}
+
+	serial, err := buildZoneSerial(date, revision)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, serial))
+}
+
+// parseZoneSerialDate accepts a calendar date or an RFC 3339 timestamp, the
+// latter converted to UTC so plantimestamp() output can be passed directly.
+func parseZoneSerialDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q must be a YYYY-MM-DD date or an RFC 3339 timestamp", value)
+	}
+	return t.UTC(), nil
+}
+
+// buildZoneSerial renders date and revision as a YYYYMMDDnn serial.
+func buildZoneSerial(date time.Time, revision int64) (int64, error) {
+	if revision < 0 || revision > 99 {
+		return 0, fmt.Errorf("revision must be between 0 and 99, got %d", revision)
+	}
+	return int64(date.Year())*1000000 + int64(date.Month())*10000 + int64(date.Day())*100 + revision, nil
+}
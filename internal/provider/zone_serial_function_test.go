@@ -0,0 +1,61 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestZoneSerialFunctionRun(t *testing.T) {
+	origNow := zoneSerialNow
+	zoneSerialNow = func() time.Time { return time.Date(2026, 3, 7, 23, 30, 0, 0, time.FixedZone("", -5*3600)) }
+	defer func() { zoneSerialNow = origNow }()
+
+	tests := []struct {
+		name     string
+		revision int64
+		dates    []string
+		want     int64
+		wantErr  bool
+	}{
+		{"current date in UTC", 1, nil, 2026030801, false},
+		{"explicit date", 0, []string{"2026-10-16"}, 2026101600, false},
+		{"timestamp converted to UTC", 99, []string{"2026-10-16T22:00:00-04:00"}, 2026101799, false},
+		{"revision too large", 100, nil, 0, true},
+		{"negative revision", -1, nil, 0, true},
+		{"bad date", 1, []string{"16/10/2026"}, 0, true},
+		{"too many dates", 1, []string{"2026-10-16", "2026-10-17"}, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			elemTypes := make([]attr.Type, len(tt.dates))
+			elems := make([]attr.Value, len(tt.dates))
+			for i, d := range tt.dates {
+				elemTypes[i] = types.StringType
+				elems[i] = types.StringValue(d)
+			}
+			req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{
+				types.Int64Value(tt.revision), types.TupleValueMust(elemTypes, elems),
+			})}
+			resp := function.RunResponse{Result: function.NewResultData(types.Int64Unknown())}
+			NewZoneSerialFunction().Run(context.Background(), req, &resp)
+
+			if (resp.Error != nil) != tt.wantErr {
+				t.Fatalf("Run() error = %v, wantErr %v", resp.Error, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := resp.Result.Value().(types.Int64).ValueInt64(); got != tt.want {
+				t.Errorf("Run() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
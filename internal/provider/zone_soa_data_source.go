@@ -0,0 +1,169 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ZoneSOADataSource{}
+
+func NewZoneSOADataSource() datasource.DataSource {
+	return &ZoneSOADataSource{}
+}
+
+// ZoneSOADataSource defines the data source implementation.
+type ZoneSOADataSource struct {
+	client *Client
+}
+
+// ZoneSOADataSourceModel describes the data source data model.
+type ZoneSOADataSourceModel struct {
+	ZoneID          types.Int64  `tfsdk:"zone_id"`
+	Content         types.String `tfsdk:"content"`
+	TTL             types.Int64  `tfsdk:"ttl"`
+	PrimaryNS       types.String `tfsdk:"primary_ns"`
+	Hostmaster      types.String `tfsdk:"hostmaster"`
+	HostmasterEmail types.String `tfsdk:"hostmaster_email"`
+	Serial          types.Int64  `tfsdk:"serial"`
+	Refresh         types.Int64  `tfsdk:"refresh"`
+	Retry           types.Int64  `tfsdk:"retry"`
+	Expire          types.Int64  `tfsdk:"expire"`
+	Minimum         types.Int64  `tfsdk:"minimum"`
+}
+
+func (d *ZoneSOADataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_zone_soa"
+}
+
+func (d *ZoneSOADataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Returns the SOA record of a zone split into its fields (primary nameserver, hostmaster, serial and timers), " +
+			"so checks and outputs do not need to parse the record content.",
+
+		Attributes: map[string]schema.Attribute{
+			"zone_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the zone",
+				Required:            true,
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "The whole SOA record content as stored",
+				Computed:            true,
+			},
+			"ttl": schema.Int64Attribute{
+				MarkdownDescription: "TTL of the SOA record in seconds",
+				Computed:            true,
+			},
+			"primary_ns": schema.StringAttribute{
+				MarkdownDescription: "Primary nameserver (MNAME), without the trailing dot",
+				Computed:            true,
+			},
+			"hostmaster": schema.StringAttribute{
+				MarkdownDescription: "Hostmaster mailbox (RNAME) in DNS form, as in the record, e.g. `hostmaster.example.com.`",
+				Computed:            true,
+			},
+			"hostmaster_email": schema.StringAttribute{
+				MarkdownDescription: "Hostmaster mailbox as an email address, e.g. `hostmaster@example.com`",
+				Computed:            true,
+			},
+			"serial": schema.Int64Attribute{
+				MarkdownDescription: "Serial number of the zone",
+				Computed:            true,
+			},
+			"refresh": schema.Int64Attribute{
+				MarkdownDescription: "Seconds after which secondaries check the primary for a newer serial",
+				Computed:            true,
+			},
+			"retry": schema.Int64Attribute{
+				MarkdownDescription: "Seconds secondaries wait before retrying a failed refresh",
+				Computed:            true,
+			},
+			"expire": schema.Int64Attribute{
+				MarkdownDescription: "Seconds after which secondaries stop answering for the zone when the primary stays unreachable",
+				Computed:            true,
+			},
+			"minimum": schema.Int64Attribute{
+				MarkdownDescription: "Negative caching TTL in seconds",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ZoneSOADataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ZoneSOADataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ZoneSOADataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check for unknown values - data sources cannot be read until all inputs are known
+	if data.ZoneID.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Unknown configuration value",
+			"The zone_id value is unknown at plan time. Data sources cannot be read until all configuration values are known.",
+		)
+		return
+	}
+
+	zoneID := data.ZoneID.ValueInt64()
+	rrset, soa, err := zoneSOA(ctx, d.client, zoneID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read the SOA record of zone %d, got error: %s", zoneID, err))
+		return
+	}
+	if rrset == nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("zone_id"),
+			"No SOA Record",
+			fmt.Sprintf("Zone %d has no SOA record. SLAVE zones only get one with their first transfer from the masters.", zoneID),
+		)
+		return
+	}
+
+	data.Content = types.StringValue(rrset.Records[0].Content)
+	data.TTL = types.Int64Value(rrset.TTL)
+	data.PrimaryNS = types.StringValue(strings.TrimSuffix(soa.PrimaryNS, "."))
+	data.Hostmaster = types.StringValue(soa.Hostmaster)
+	data.HostmasterEmail = types.StringValue(hostmasterToEmail(soa.Hostmaster))
+	data.Serial = types.Int64Value(soa.Serial)
+	data.Refresh = types.Int64Value(soa.Refresh)
+	data.Retry = types.Int64Value(soa.Retry)
+	data.Expire = types.Int64Value(soa.Expire)
+	data.Minimum = types.Int64Value(soa.Minimum)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,39 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccZoneSOADataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+resource "poweradmin_zone" "test" {
+  name             = "test-soa-datasource.example.com"
+  type             = "MASTER"
+  hostmaster_email = "dns.admin@example.com"
+}
+
+data "poweradmin_zone_soa" "test" {
+  zone_id = poweradmin_zone.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.poweradmin_zone_soa.test", "hostmaster", `dns\.admin.example.com.`),
+					resource.TestCheckResourceAttr("data.poweradmin_zone_soa.test", "hostmaster_email", "dns.admin@example.com"),
+					resource.TestCheckResourceAttrSet("data.poweradmin_zone_soa.test", "primary_ns"),
+					resource.TestCheckResourceAttrSet("data.poweradmin_zone_soa.test", "serial"),
+					resource.TestCheckResourceAttrSet("data.poweradmin_zone_soa.test", "minimum"),
+				),
+			},
+		},
+	})
+}
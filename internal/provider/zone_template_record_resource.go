@@ -71,6 +71,9 @@ func (r *ZoneTemplateRecordResource) Schema(ctx context.Context, req resource.Sc
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			// A plain string rather than DNSNameType: the name is a pattern
+			// whose placeholders, e.g. [ZONE], are substituted case-sensitively,
+			// so folding case would hide a real change.
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Record name. Supports the `[ZONE]` placeholder.",
 				Required:            true,
@@ -0,0 +1,391 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ZoneTransferACLResource{}
+var _ resource.ResourceWithImportState = &ZoneTransferACLResource{}
+var _ resource.ResourceWithValidateConfig = &ZoneTransferACLResource{}
+
+// Zone metadata kinds managed by poweradmin_zone_transfer_acl.
+const (
+	metadataAllowAXFRFrom = "ALLOW-AXFR-FROM"
+	metadataAlsoNotify    = "ALSO-NOTIFY"
+)
+
+func NewZoneTransferACLResource() resource.Resource {
+	return &ZoneTransferACLResource{}
+}
+
+// ZoneTransferACLResource manages who may transfer a zone and which extra
+// hosts are notified of changes, via the ALLOW-AXFR-FROM and ALSO-NOTIFY
+// zone metadata kinds.
+type ZoneTransferACLResource struct {
+	client *Client
+}
+
+// ZoneTransferACLResourceModel describes the resource data model.
+type ZoneTransferACLResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	ZoneID        types.Int64  `tfsdk:"zone_id"`
+	AllowAXFRFrom types.Set    `tfsdk:"allow_axfr_from"`
+	AlsoNotify    types.Set    `tfsdk:"also_notify"`
+}
+
+// transferACLKinds pairs each metadata kind with its attribute.
+var transferACLKinds = []struct {
+	kind string
+	attr string
+}{
+	{metadataAllowAXFRFrom, "allow_axfr_from"},
+	{metadataAlsoNotify, "also_notify"},
+}
+
+func (r *ZoneTransferACLResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_zone_transfer_acl"
+}
+
+func (r *ZoneTransferACLResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages zone transfer settings of a zone: the hosts allowed to AXFR it (`ALLOW-AXFR-FROM`) and additional hosts notified on change (`ALSO-NOTIFY`). " +
+			"Each attribute owns its metadata kind entirely; leaving one unset removes that kind from the zone. Use one resource per zone, and do not combine `also_notify` with the attribute of the same name on `poweradmin_zone`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier, equal to the zone ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"zone_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the zone",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"allow_axfr_from": schema.SetAttribute{
+				MarkdownDescription: "IP addresses or CIDR ranges allowed to transfer the zone (e.g. `192.0.2.0/24`, `2001:db8::1`). `AUTO-NS` allows the zone's own nameservers.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"also_notify": schema.SetAttribute{
+				MarkdownDescription: "IP addresses, optionally with a port (`192.0.2.1:5300`, `[2001:db8::1]:5300`), to send NOTIFY messages to in addition to the zone's nameservers.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *ZoneTransferACLResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig checks every entry parses as the address form its kind accepts.
+func (r *ZoneTransferACLResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ZoneTransferACLResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, k := range transferACLKinds {
+		set := data.attrSet(k.kind)
+		if set.IsNull() || set.IsUnknown() {
+			continue
+		}
+		var entries []types.String
+		resp.Diagnostics.Append(set.ElementsAs(ctx, &entries, false)...)
+		for _, entry := range entries {
+			if entry.IsNull() || entry.IsUnknown() {
+				continue
+			}
+			if _, err := canonicalTransferACLEntry(k.kind, entry.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root(k.attr), "Invalid Transfer ACL Entry", err.Error())
+			}
+		}
+	}
+}
+
+func (r *ZoneTransferACLResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ZoneTransferACLResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating zone transfer ACL", map[string]interface{}{
+		"zone_id": data.ZoneID.ValueInt64(),
+	})
+
+	if !r.write(ctx, data, &resp.Diagnostics) {
+		return
+	}
+
+	data.ID = types.StringValue(strconv.FormatInt(data.ZoneID.ValueInt64(), 10))
+
+	tflog.Trace(ctx, "Created zone transfer ACL", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneTransferACLResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ZoneTransferACLResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneID := data.ZoneID.ValueInt64()
+
+	// A missing kind also reads as 404, so check the zone itself first
+	if _, err := r.client.GetZone(ctx, int(zoneID)); err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Zone Transfer ACL",
+			fmt.Sprintf("Could not read zone %d: %s", zoneID, err),
+		)
+		return
+	}
+
+	for _, k := range transferACLKinds {
+		values, err := r.client.GetZoneMetadata(ctx, zoneID, k.kind)
+		if err != nil && !IsNotFoundError(err) {
+			resp.Diagnostics.AddError(
+				"Error Reading Zone Transfer ACL",
+				fmt.Sprintf("Could not read %s of zone %d: %s", k.kind, zoneID, err),
+			)
+			return
+		}
+
+		prior := data.attrSet(k.kind)
+		if len(values) == 0 && prior.IsNull() {
+			continue
+		}
+		set, diags := types.SetValueFrom(ctx, types.StringType, normalizeTransferACL(ctx, k.kind, prior, values))
+		resp.Diagnostics.Append(diags...)
+		data.setAttr(k.kind, set)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneTransferACLResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ZoneTransferACLResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating zone transfer ACL", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	if !r.write(ctx, data, &resp.Diagnostics) {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneTransferACLResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ZoneTransferACLResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting zone transfer ACL", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	for _, k := range transferACLKinds {
+		err := r.client.DeleteZoneMetadata(ctx, data.ZoneID.ValueInt64(), k.kind)
+		// Already gone, or the zone was deleted with it
+		if err != nil && !IsNotFoundError(err) {
+			resp.Diagnostics.AddError(
+				"Error Deleting Zone Transfer ACL",
+				fmt.Sprintf("Could not remove %s from zone %d: %s", k.kind, data.ZoneID.ValueInt64(), err),
+			)
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Deleted zone transfer ACL", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *ZoneTransferACLResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format: zone_id
+	// Example: terraform import poweradmin_zone_transfer_acl.example 123
+	zoneID, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil || zoneID <= 0 {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Import ID must be a zone ID, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone_id"), zoneID)...)
+}
+
+// write sets each metadata kind to its configured values, removing kinds
+// that are unset or empty; returns false when it added an error.
+func (r *ZoneTransferACLResource) write(ctx context.Context, data ZoneTransferACLResourceModel, diags *diag.Diagnostics) bool {
+	zoneID := data.ZoneID.ValueInt64()
+	for _, k := range transferACLKinds {
+		var values []string
+		if set := data.attrSet(k.kind); !set.IsNull() && !set.IsUnknown() {
+			diags.Append(set.ElementsAs(ctx, &values, false)...)
+			if diags.HasError() {
+				return false
+			}
+		}
+
+		var err error
+		if len(values) == 0 {
+			err = r.client.DeleteZoneMetadata(ctx, zoneID, k.kind)
+			if IsNotFoundError(err) {
+				err = nil
+			}
+		} else {
+			err = r.client.SetZoneMetadata(ctx, zoneID, k.kind, values)
+		}
+		if err != nil {
+			diags.AddError(
+				"Error Writing Zone Transfer ACL",
+				fmt.Sprintf("Could not set %s on zone %d: %s", k.kind, zoneID, err),
+			)
+			return false
+		}
+	}
+	return true
+}
+
+func (m *ZoneTransferACLResourceModel) attrSet(kind string) types.Set {
+	if kind == metadataAlsoNotify {
+		return m.AlsoNotify
+	}
+	return m.AllowAXFRFrom
+}
+
+func (m *ZoneTransferACLResourceModel) setAttr(kind string, set types.Set) {
+	if kind == metadataAlsoNotify {
+		m.AlsoNotify = set
+		return
+	}
+	m.AllowAXFRFrom = set
+}
+
+// canonicalTransferACLEntry parses an entry of the given metadata kind and
+// returns a canonical form for comparison: a masked prefix for
+// ALLOW-AXFR-FROM and an address:port (default 53) for ALSO-NOTIFY.
+func canonicalTransferACLEntry(kind, entry string) (string, error) {
+	if kind == metadataAlsoNotify {
+		if ap, err := netip.ParseAddrPort(entry); err == nil {
+			return netip.AddrPortFrom(ap.Addr().Unmap(), ap.Port()).String(), nil
+		}
+		if addr, err := netip.ParseAddr(entry); err == nil {
+			return netip.AddrPortFrom(addr.Unmap(), 53).String(), nil
+		}
+		return "", fmt.Errorf("%q is not an IP address or address:port (IPv6 with a port must be bracketed, e.g. [2001:db8::1]:5300)", entry)
+	}
+
+	if strings.EqualFold(entry, "AUTO-NS") {
+		return "AUTO-NS", nil
+	}
+	if prefix, err := netip.ParsePrefix(entry); err == nil {
+		return prefix.Masked().String(), nil
+	}
+	if addr, err := netip.ParseAddr(entry); err == nil {
+		addr = addr.Unmap()
+		return netip.PrefixFrom(addr, addr.BitLen()).String(), nil
+	}
+	return "", fmt.Errorf("%q is not an IP address, CIDR range or AUTO-NS", entry)
+}
+
+// normalizeTransferACL keeps the configured spelling of entries the server
+// returned in another but equivalent form (e.g. 192.0.2.1 as 192.0.2.1/32).
+func normalizeTransferACL(ctx context.Context, kind string, configured types.Set, fromAPI []string) []string {
+	var prior []string
+	if !configured.IsNull() && !configured.IsUnknown() {
+		configured.ElementsAs(ctx, &prior, false)
+	}
+	result := make([]string, len(fromAPI))
+	for i, entry := range fromAPI {
+		result[i] = entry
+		want, err := canonicalTransferACLEntry(kind, entry)
+		if err != nil {
+			continue
+		}
+		for _, p := range prior {
+			if got, err := canonicalTransferACLEntry(kind, p); err == nil && got == want {
+				result[i] = p
+				break
+			}
+		}
+	}
+	return result
+}
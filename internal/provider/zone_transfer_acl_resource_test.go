@@ -0,0 +1,104 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestCanonicalTransferACLEntry(t *testing.T) {
+	tests := []struct {
+		name    string
+		kind    string
+		entry   string
+		want    string
+		wantErr bool
+	}{
+		{"axfr host", metadataAllowAXFRFrom, "192.0.2.1", "192.0.2.1/32", false},
+		{"axfr range masked", metadataAllowAXFRFrom, "192.0.2.7/24", "192.0.2.0/24", false},
+		{"axfr ipv6 host", metadataAllowAXFRFrom, "2001:db8::1", "2001:db8::1/128", false},
+		{"axfr auto-ns", metadataAllowAXFRFrom, "auto-ns", "AUTO-NS", false},
+		{"axfr hostname rejected", metadataAllowAXFRFrom, "ns1.example.com", "", true},
+		{"notify host defaults port", metadataAlsoNotify, "192.0.2.1", "192.0.2.1:53", false},
+		{"notify host with port", metadataAlsoNotify, "192.0.2.1:5300", "192.0.2.1:5300", false},
+		{"notify ipv6 with port", metadataAlsoNotify, "[2001:db8::1]:5300", "[2001:db8::1]:5300", false},
+		{"notify cidr rejected", metadataAlsoNotify, "192.0.2.0/24", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := canonicalTransferACLEntry(tt.kind, tt.entry)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("canonicalTransferACLEntry(%q, %q) error = %v, wantErr %v", tt.kind, tt.entry, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("canonicalTransferACLEntry(%q, %q) = %q, want %q", tt.kind, tt.entry, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeTransferACL(t *testing.T) {
+	ctx := context.Background()
+	configured, _ := types.SetValueFrom(ctx, types.StringType, []string{"192.0.2.1", "198.51.100.0/24"})
+
+	got := normalizeTransferACL(ctx, metadataAllowAXFRFrom, configured, []string{"192.0.2.1/32", "203.0.113.0/24"})
+
+	if got[0] != "192.0.2.1" {
+		t.Errorf("expected configured spelling kept, got %q", got[0])
+	}
+	if got[1] != "203.0.113.0/24" {
+		t.Errorf("expected external entry to surface, got %q", got[1])
+	}
+}
+
+func TestAccZoneTransferACLResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccZoneTransferACLResourceConfig(`["192.0.2.0/24", "2001:db8::1"]`, `["192.0.2.53"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("poweradmin_zone_transfer_acl.test", "allow_axfr_from.#", "2"),
+					resource.TestCheckResourceAttr("poweradmin_zone_transfer_acl.test", "also_notify.#", "1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "poweradmin_zone_transfer_acl.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update testing
+			{
+				Config: testAccZoneTransferACLResourceConfig(`["198.51.100.10"]`, `[]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("poweradmin_zone_transfer_acl.test", "allow_axfr_from.#", "1"),
+					resource.TestCheckResourceAttr("poweradmin_zone_transfer_acl.test", "also_notify.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccZoneTransferACLResourceConfig(allowAXFRFrom, alsoNotify string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "poweradmin_zone" "test" {
+  name = "test-transfer-acl.example.com"
+  type = "MASTER"
+}
+
+resource "poweradmin_zone_transfer_acl" "test" {
+  zone_id         = poweradmin_zone.test.id
+  allow_axfr_from = %s
+  also_notify     = %s
+}
+`, allowAXFRFrom, alsoNotify)
+}
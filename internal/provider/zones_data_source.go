@@ -0,0 +1,202 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ZonesDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &ZonesDataSource{}
+
+func NewZonesDataSource() datasource.DataSource {
+	return &ZonesDataSource{}
+}
+
+// ZonesDataSource defines the data source implementation.
+type ZonesDataSource struct {
+	client *Client
+}
+
+// ZoneSummaryModel describes a zone entry returned by the list endpoint.
+type ZoneSummaryModel struct {
+	ID           types.Int64  `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Type         types.String `tfsdk:"type"`
+	Masters      types.String `tfsdk:"masters"`
+	Account      types.String `tfsdk:"account"`
+	Description  types.String `tfsdk:"description"`
+	DNSSECSigned types.Bool   `tfsdk:"dnssec_signed"`
+}
+
+// ZonesDataSourceModel describes the data source data model.
+type ZonesDataSourceModel struct {
+	Account types.String       `tfsdk:"account"`
+	OwnerID types.Int64        `tfsdk:"owner_id"`
+	Type    types.String       `tfsdk:"type"`
+	DNSSEC  types.Bool         `tfsdk:"dnssec"`
+	Zones   []ZoneSummaryModel `tfsdk:"zones"`
+}
+
+func (d *ZonesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_zones"
+}
+
+func (d *ZonesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the zones visible to the authenticated caller, optionally narrowed by account, owning user, type and DNSSEC status. " +
+			"Filters are passed to the server, but the provider applies them itself on the full listing, since the API may ignore them. " +
+			"`owner_id` costs one owners lookup per zone left after the other filters, so combine it with `account` or `type` on large installations.",
+
+		Attributes: map[string]schema.Attribute{
+			"account": schema.StringAttribute{
+				MarkdownDescription: "Only list zones with this account",
+				Optional:            true,
+			},
+			"owner_id": schema.Int64Attribute{
+				MarkdownDescription: "Only list zones owned by the user with this ID, checked with one owners lookup per zone",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only list zones of this type (MASTER, SLAVE, or NATIVE)",
+				Optional:            true,
+			},
+			"dnssec": schema.BoolAttribute{
+				MarkdownDescription: "Only list DNSSEC-signed zones (`true`) or unsigned zones (`false`)",
+				Optional:            true,
+			},
+			"zones": schema.ListNestedAttribute{
+				MarkdownDescription: "Zones matching the filters",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Zone ID",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Zone name",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Zone type (MASTER, SLAVE, or NATIVE)",
+							Computed:            true,
+						},
+						"masters": schema.StringAttribute{
+							MarkdownDescription: "Comma-separated list of master nameservers (for SLAVE zones)",
+							Computed:            true,
+						},
+						"account": schema.StringAttribute{
+							MarkdownDescription: "Account name for the zone",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the zone",
+							Computed:            true,
+						},
+						"dnssec_signed": schema.BoolAttribute{
+							MarkdownDescription: "Whether the zone is DNSSEC-signed",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the type and owner filters.
+func (d *ZonesDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data ZonesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !data.Type.IsNull() && !data.Type.IsUnknown() {
+		switch strings.ToUpper(data.Type.ValueString()) {
+		case "MASTER", "SLAVE", "NATIVE":
+		default:
+			resp.Diagnostics.AddAttributeError(path.Root("type"), "Invalid Zone Type",
+				fmt.Sprintf("Zone type must be MASTER, SLAVE, or NATIVE, got: %s", data.Type.ValueString()))
+		}
+	}
+	if !data.OwnerID.IsNull() && !data.OwnerID.IsUnknown() && data.OwnerID.ValueInt64() <= 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("owner_id"), "Invalid Owner ID",
+			fmt.Sprintf("Owner ID must be a positive user ID, got: %d", data.OwnerID.ValueInt64()))
+	}
+}
+
+func (d *ZonesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ZonesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ZonesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := ZoneFilter{
+		Account: data.Account.ValueString(),
+		Owner:   int(data.OwnerID.ValueInt64()),
+		Type:    data.Type.ValueString(),
+	}
+	if !data.DNSSEC.IsNull() {
+		signed := data.DNSSEC.ValueBool()
+		filter.DNSSECSigned = &signed
+	}
+
+	zones, err := d.client.ListZonesMatching(ctx, filter)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Zones",
+			fmt.Sprintf("Could not list zones: %s", err.Error()),
+		)
+		return
+	}
+
+	models := make([]ZoneSummaryModel, len(zones))
+	for i, z := range zones {
+		models[i] = ZoneSummaryModel{
+			ID:           types.Int64Value(int64(z.ID)),
+			Name:         types.StringValue(z.Name),
+			Type:         types.StringValue(z.Type),
+			Masters:      types.StringValue(z.Masters),
+			Account:      types.StringValue(z.Account),
+			Description:  types.StringValue(z.Description),
+			DNSSECSigned: types.BoolValue(z.DNSSECSigned),
+		}
+	}
+	data.Zones = models
+
+	tflog.Trace(ctx, "Read zones data source", map[string]interface{}{
+		"count": len(models),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,94 @@
+// Copyright Poweradmin Development Team 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestListZonesMatching(t *testing.T) {
+	zones := []Zone{
+		{ID: 1, Name: "a.example.com", Type: "MASTER", Account: "tenant", DNSSECSigned: true},
+		{ID: 2, Name: "b.example.com", Type: "SLAVE", Account: "tenant"},
+		{ID: 3, Name: "c.example.com", Type: "MASTER", Account: "other", DNSSECSigned: true},
+		{ID: 4, Name: "d.example.com", Type: "MASTER", Account: "tenant"},
+	}
+	signed := true
+	tests := []struct {
+		name      string
+		filter    ZoneFilter
+		wantQuery string
+		want      []int
+	}{
+		{"no filter", ZoneFilter{}, "", []int{1, 2, 3, 4}},
+		{"account", ZoneFilter{Account: "tenant"}, "account=tenant", []int{1, 2, 4}},
+		{"type is case-insensitive", ZoneFilter{Type: "master"}, "type=MASTER", []int{1, 3, 4}},
+		{"dnssec", ZoneFilter{DNSSECSigned: &signed}, "dnssec=true", []int{1, 3}},
+		{"owner", ZoneFilter{Owner: 7}, "owner=7", []int{1, 4}},
+		{"combined", ZoneFilter{Account: "tenant", Type: "MASTER", Owner: 7}, "account=tenant&owner=7&type=MASTER", []int{1, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/api/v2/zones":
+					if r.URL.RawQuery != tt.wantQuery {
+						t.Errorf("expected query %q, got %q", tt.wantQuery, r.URL.RawQuery)
+					}
+					// Answer like an API version that ignores the filters
+					respondJSON(t, w, ZoneListResponse{Zones: zones})
+				case "/api/v2/zones/1/owners", "/api/v2/zones/4/owners":
+					respondJSON(t, w, ZoneOwnerListResponse{Owners: []ZoneOwner{{UserID: 7, Username: "tenant-admin"}}})
+				default:
+					respondJSON(t, w, ZoneOwnerListResponse{Owners: []ZoneOwner{{UserID: 1, Username: "admin"}}})
+				}
+			})
+
+			got, err := client.ListZonesMatching(context.Background(), tt.filter)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var ids []int
+			for _, zone := range got {
+				ids = append(ids, zone.ID)
+			}
+			if !reflect.DeepEqual(ids, tt.want) {
+				t.Errorf("expected zones %v, got %v", tt.want, ids)
+			}
+		})
+	}
+}
+
+func TestAccZonesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+resource "poweradmin_zone" "test" {
+  name    = "zones-ds-test.example.com"
+  type    = "MASTER"
+  account = "zones-ds-test"
+}
+
+data "poweradmin_zones" "test" {
+  account = poweradmin_zone.test.account
+  type    = "master"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.poweradmin_zones.test", "zones.#", "1"),
+					resource.TestCheckResourceAttr("data.poweradmin_zones.test", "zones.0.name", "zones-ds-test.example.com"),
+					resource.TestCheckResourceAttr("data.poweradmin_zones.test", "zones.0.type", "MASTER"),
+				),
+			},
+		},
+	})
+}